@@ -0,0 +1,157 @@
+package batch
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2"
+)
+
+// Updater coalesces data changes and refreshes of a chart
+// Changes within BeginUpdate and EndUpdate are applied once at the end of the batch.
+// With a maximum rate, changes that follow the previous update too closely are merged into one delayed update.
+// The updates are applied one at a time, even if changes are requested from several goroutines.
+// The updater is not locked while an update is applied, so the update may request further changes.
+type Updater struct {
+	mu             sync.Mutex
+	depth          int
+	pendingData    bool
+	pendingRefresh bool
+	scheduled      bool
+	running        bool
+	changes        []func()
+	minInterval    time.Duration
+	last           time.Time
+	dataChange     func()
+	refresh        func()
+	now            func() time.Time
+	schedule       func(d time.Duration, f func())
+}
+
+// NewUpdater creates an Updater that calls dataChange for data changes and refresh for refreshes
+// dataChange is expected to include a refresh
+func NewUpdater(dataChange func(), refresh func()) (u *Updater) {
+	u = &Updater{
+		dataChange: dataChange,
+		refresh:    refresh,
+		now:        time.Now,
+		schedule: func(d time.Duration, f func()) {
+			time.AfterFunc(d, func() { RunOnMain(f) })
+		},
+	}
+	return
+}
+
+// SetMaxRate limits the updates to fps per second; fps = 0 removes the limit
+func (u *Updater) SetMaxRate(fps float64) (err error) {
+	if fps < 0 {
+		err = errors.New("invalid refresh rate")
+		return
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.minInterval = 0
+	if fps > 0 {
+		u.minInterval = time.Duration(float64(time.Second) / fps)
+	}
+	return
+}
+
+// BeginUpdate starts a batch; batches can be nested
+func (u *Updater) BeginUpdate() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.depth++
+}
+
+// EndUpdate ends a batch and applies the pending changes once the outermost batch has ended
+func (u *Updater) EndUpdate() {
+	u.mu.Lock()
+	if u.depth == 0 {
+		u.mu.Unlock()
+		return
+	}
+	u.depth--
+	u.flush()
+}
+
+// DataChange requests a data change
+func (u *Updater) DataChange() {
+	u.mu.Lock()
+	u.pendingData = true
+	u.flush()
+}
+
+// Change runs f and requests a data change in one step
+// f prepares the data change and must not request updates itself
+// While an update is applied, f is deferred until the update has finished, so that both never run at the same time
+func (u *Updater) Change(f func()) {
+	u.mu.Lock()
+	if u.running {
+		u.changes = append(u.changes, f)
+	} else {
+		f()
+	}
+	u.pendingData = true
+	u.flush()
+}
+
+// Refresh requests a refresh
+func (u *Updater) Refresh() {
+	u.mu.Lock()
+	u.pendingRefresh = true
+	u.flush()
+}
+
+// flush applies the pending changes unless a batch is open, an update is running or the last update was too recent
+// The updater must be locked; it is unlocked before the update is applied and when flush returns
+// Changes that are requested while an update is applied are applied after it
+func (u *Updater) flush() {
+	for {
+		if u.depth > 0 || u.scheduled || u.running || (!u.pendingData && !u.pendingRefresh) {
+			u.mu.Unlock()
+			return
+		}
+		if u.minInterval > 0 {
+			now := u.now()
+			if wait := u.minInterval - now.Sub(u.last); wait > 0 {
+				u.scheduled = true
+				u.mu.Unlock()
+				u.schedule(wait, func() {
+					u.mu.Lock()
+					u.scheduled = false
+					u.flush()
+				})
+				return
+			}
+			u.last = now
+		}
+		data := u.pendingData
+		u.pendingData = false
+		u.pendingRefresh = false
+		u.running = true
+		u.mu.Unlock()
+		if data {
+			u.dataChange()
+		} else {
+			u.refresh()
+		}
+		u.mu.Lock()
+		u.running = false
+		for _, f := range u.changes {
+			f()
+		}
+		u.changes = nil
+	}
+}
+
+// RunOnMain runs f on the Fyne main goroutine without waiting
+// Without a running app f is called directly
+func RunOnMain(f func()) {
+	if fyne.CurrentApp() == nil {
+		f()
+		return
+	}
+	fyne.Do(f)
+}
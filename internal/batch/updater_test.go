@@ -0,0 +1,127 @@
+package batch
+
+import (
+	"testing"
+	"time"
+)
+
+type updateCounter struct {
+	data      int
+	refresh   int
+	scheduled []func()
+	t         time.Time
+}
+
+func testUpdater(c *updateCounter) (u *Updater) {
+	u = NewUpdater(func() { c.data++ }, func() { c.refresh++ })
+	u.now = func() time.Time { return c.t }
+	u.schedule = func(d time.Duration, f func()) {
+		c.scheduled = append(c.scheduled, f)
+	}
+	return
+}
+
+func TestUpdaterBatch(t *testing.T) {
+	c := &updateCounter{}
+	u := testUpdater(c)
+	u.BeginUpdate()
+	u.DataChange()
+	u.Refresh()
+	u.BeginUpdate()
+	u.DataChange()
+	u.EndUpdate()
+	if c.data != 0 || c.refresh != 0 {
+		t.Errorf("update within batch, have %d data changes and %d refreshes", c.data, c.refresh)
+	}
+	u.EndUpdate()
+	if c.data != 1 || c.refresh != 0 {
+		t.Errorf("wrong updates after batch, exp 1 data change and 0 refreshes, have %d and %d", c.data, c.refresh)
+	}
+	u.Refresh()
+	if c.refresh != 1 {
+		t.Errorf("wrong refreshes, exp 1, have %d", c.refresh)
+	}
+	u.EndUpdate()
+	if c.data != 1 || c.refresh != 1 {
+		t.Errorf("unbalanced EndUpdate caused an update")
+	}
+}
+
+func TestUpdaterMaxRate(t *testing.T) {
+	c := &updateCounter{t: time.Unix(100, 0)}
+	u := testUpdater(c)
+	err := u.SetMaxRate(-1)
+	if err == nil {
+		t.Errorf("no error for negative rate")
+	}
+	err = u.SetMaxRate(10)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+	u.DataChange()
+	c.t = c.t.Add(20 * time.Millisecond)
+	u.DataChange()
+	u.Refresh()
+	u.DataChange()
+	if c.data != 1 || len(c.scheduled) != 1 {
+		t.Errorf("burst not merged, exp 1 data change and 1 scheduled update, have %d and %d", c.data, len(c.scheduled))
+	}
+	c.t = c.t.Add(100 * time.Millisecond)
+	c.scheduled[0]()
+	if c.data != 2 || c.refresh != 0 {
+		t.Errorf("wrong updates after delay, exp 2 data changes and 0 refreshes, have %d and %d", c.data, c.refresh)
+	}
+}
+
+func TestUpdaterReentrant(t *testing.T) {
+	c := &updateCounter{}
+	var u *Updater
+	// the data change requests a refresh, as a data listener that updates another chart would
+	u = NewUpdater(func() {
+		c.data++
+		if c.data == 1 {
+			u.Refresh()
+		}
+	}, func() { c.refresh++ })
+	done := make(chan bool)
+	go func() {
+		u.DataChange()
+		done <- true
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("update requested within an update blocked")
+	}
+	if c.data != 1 || c.refresh != 1 {
+		t.Errorf("wrong updates, exp 1 data change and 1 refresh, have %d and %d", c.data, c.refresh)
+	}
+}
+
+func TestUpdaterChangeDuringUpdate(t *testing.T) {
+	c := &updateCounter{}
+	var u *Updater
+	updating := false
+	changed := false
+	u = NewUpdater(func() {
+		updating = true
+		c.data++
+		if c.data == 1 {
+			// a change that is requested while the update is applied waits for the update
+			u.Change(func() {
+				changed = true
+				if updating {
+					t.Errorf("change prepared during update")
+				}
+			})
+			if changed {
+				t.Errorf("change not deferred")
+			}
+		}
+		updating = false
+	}, func() { c.refresh++ })
+	u.DataChange()
+	if !changed || c.data != 2 {
+		t.Errorf("wrong updates, exp change and 2 data changes, have %t and %d", changed, c.data)
+	}
+}
@@ -0,0 +1,91 @@
+package bind
+
+import (
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// ListBinding keeps the data of a series in sync with an untyped list
+type ListBinding struct {
+	list     binding.UntypedList
+	listener binding.DataListener
+	convert  func(item any) (v any, err error)
+	apply    func(vals []any, reset bool) (err error)
+	applied  []any
+}
+
+// NewListBinding applies the current items of list and subscribes to its changes
+// convert turns a list item into the value that is passed to apply; the values must be comparable
+// apply either appends vals to the series or, if reset is true, replaces all data of the series with vals
+// An error is returned if the current items cannot be converted or applied
+func NewListBinding(list binding.UntypedList, convert func(item any) (v any, err error),
+	apply func(vals []any, reset bool) (err error)) (lb *ListBinding, err error) {
+	lb = &ListBinding{
+		list:    list,
+		convert: convert,
+		apply:   apply,
+	}
+	err = lb.update()
+	if err != nil {
+		lb = nil
+		return
+	}
+	lb.listener = binding.NewDataListener(func() { lb.update() })
+	list.AddListener(lb.listener)
+	return
+}
+
+// Unbind stops listening to the list
+func (lb *ListBinding) Unbind() {
+	if lb.listener == nil {
+		return
+	}
+	lb.list.RemoveListener(lb.listener)
+	lb.listener = nil
+}
+
+// update converts the items of the list and applies the change since the last update
+// If an item is invalid, the series keeps its data
+func (lb *ListBinding) update() (err error) {
+	items, err := lb.list.Get()
+	if err != nil {
+		return
+	}
+	vals := make([]any, 0, len(items))
+	for i := range items {
+		var v any
+		v, err = lb.convert(items[i])
+		if err != nil {
+			return
+		}
+		vals = append(vals, v)
+	}
+	added, reset := appendedValues(lb.applied, vals)
+	if !reset && len(added) == 0 {
+		return
+	}
+	err = lb.apply(added, reset)
+	if err != nil {
+		return
+	}
+	lb.applied = vals
+	return
+}
+
+// appendedValues gives the values that have been appended to old
+// reset is true if old is not a prefix of vals; then added contains all vals
+func appendedValues(old []any, vals []any) (added []any, reset bool) {
+	if len(vals) < len(old) {
+		added = vals
+		reset = true
+		return
+	}
+	for i := range old {
+		if old[i] != vals[i] {
+			added = vals
+			reset = true
+			return
+		}
+	}
+	added = vals[len(old):]
+	return
+}
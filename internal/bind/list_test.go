@@ -0,0 +1,88 @@
+package bind
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+)
+
+func TestAppendedValues(t *testing.T) {
+	var tests = []struct {
+		old      []any
+		vals     []any
+		expAdded []any
+		expReset bool
+	}{
+		{[]any{}, []any{1, 2}, []any{1, 2}, false},
+		{[]any{1, 2}, []any{1, 2, 3}, []any{3}, false},
+		{[]any{1, 2}, []any{1, 2}, []any{}, false},
+		{[]any{1, 2}, []any{1}, []any{1}, true},
+		{[]any{1, 2}, []any{1, 4, 3}, []any{1, 4, 3}, true},
+		{[]any{1, 2}, []any{}, []any{}, true},
+	}
+	for i, tt := range tests {
+		added, reset := appendedValues(tt.old, tt.vals)
+		if reset != tt.expReset {
+			t.Errorf("wrong reset, set %d, exp %t, have %t", i, tt.expReset, reset)
+		}
+		if len(added) != len(tt.expAdded) {
+			t.Errorf("wrong number of added values, set %d, exp %d, have %d", i, len(tt.expAdded), len(added))
+			continue
+		}
+		for j := range added {
+			if added[j] != tt.expAdded[j] {
+				t.Errorf("wrong added value, set %d, value %d, exp %v, have %v", i, j, tt.expAdded[j], added[j])
+			}
+		}
+	}
+}
+
+func TestListBindingUpdate(t *testing.T) {
+	test.NewTempApp(t)
+	// the series accepts only positive values and keeps its data if a change is invalid
+	ser := []any{}
+	list := binding.NewUntypedList()
+	lb := &ListBinding{
+		list:    list,
+		convert: func(item any) (v any, err error) { v = item; return },
+		apply: func(vals []any, reset bool) (err error) {
+			for i := range vals {
+				if vals[i].(int) < 0 {
+					err = errors.New("invalid value")
+					return
+				}
+			}
+			if reset {
+				ser = []any{}
+			}
+			ser = append(ser, vals...)
+			return
+		},
+	}
+	var tests = []struct {
+		items []any
+		exp   []any
+	}{
+		{[]any{1, 2}, []any{1, 2}},
+		{[]any{1, -1}, []any{1, 2}},
+		{[]any{1, 2, 3}, []any{1, 2, 3}},
+		{[]any{1, 2, 3, -4}, []any{1, 2, 3}},
+		{[]any{5}, []any{5}},
+	}
+	for i, tt := range tests {
+		err := list.Set(tt.items)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		lb.update()
+		if !slices.Equal(ser, tt.exp) {
+			t.Errorf("wrong data, set %d, exp %v, have %v", i, tt.exp, ser)
+		}
+		if !slices.Equal(lb.applied, ser) {
+			t.Errorf("applied values differ from data, set %d, exp %v, have %v", i, ser, lb.applied)
+		}
+	}
+}
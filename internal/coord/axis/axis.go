@@ -12,6 +12,7 @@ import (
 	"fyne.io/fyne/v2/theme"
 	"github.com/disintegration/imaging"
 	"github.com/s-daehling/fyne-charts/internal/renderer"
+	"github.com/s-daehling/fyne-charts/pkg/data"
 	"github.com/s-daehling/fyne-charts/pkg/style"
 )
 
@@ -32,6 +33,7 @@ type axisTick struct {
 	nLine          float64
 	label          *canvas.Image
 	labelText      *canvas.Text // the text label
+	labelRot       float64      // counter-clockwise rotation of the label image in degrees
 	line           *canvas.Line // the tick line
 	hasSupportLine bool         // if true, a orthogonal support line is drawn at the coordLine coordinate, ranging from min to max value of the opposite axis
 	supportLine    *canvas.Line // the support line
@@ -61,6 +63,9 @@ type Axis struct {
 	labelStyle      style.ChartTextStyle
 	space           float32
 	style           style.AxisStyle
+	centeredCTicks  bool
+	polygonGrid     bool
+	tTickFormat     string // time layout of the labels of temporal ticks
 }
 
 func EmptyAxis(name string, typ AxisType) (ax *Axis) {
@@ -95,7 +100,9 @@ func EmptyAxis(name string, typ AxisType) (ax *Axis) {
 
 func (ax *Axis) Objects() (canObj []fyne.CanvasObject) {
 	if ax.typ == PolarPhiAxis {
-		canObj = append(canObj, ax.circle)
+		if !ax.polygonGrid {
+			canObj = append(canObj, ax.circle)
+		}
 	} else {
 		canObj = append(canObj, ax.line)
 	}
@@ -149,7 +156,7 @@ func (ax *Axis) Ticks() (ts []renderer.Tick) {
 			if ax.ticks[i].hasSupportLine {
 				if ax.typ == CartesianHorAxis || ax.typ == CartesianVertAxis || ax.typ == PolarPhiAxis {
 					t.SupLine = ax.ticks[i].supportLine
-				} else {
+				} else if !ax.polygonGrid {
 					t.SupCircle = ax.ticks[i].supportCircle
 				}
 			}
@@ -161,6 +168,21 @@ func (ax *Axis) Ticks() (ts []renderer.Tick) {
 	return
 }
 
+// SupportNs gives the n of all ticks within the range of the axis that have a support line
+func (ax *Axis) SupportNs() (ns []float64) {
+	for i := range ax.ticks {
+		if ax.ticks[i].hasSupportLine && ax.ticks[i].nLine >= ax.nMin && ax.ticks[i].nLine <= ax.nMax {
+			ns = append(ns, ax.ticks[i].nLine)
+		}
+	}
+	return
+}
+
+// SetPolygonGrid defines whether the circles of a polar axis are replaced by polygons drawn by the chart
+func (ax *Axis) SetPolygonGrid(polygon bool) {
+	ax.polygonGrid = polygon
+}
+
 func (ax *Axis) maxTickWidth() (maxWidth float32) {
 	maxWidth = 0
 	for i := range ax.ticks {
@@ -175,6 +197,12 @@ func (ax *Axis) SetSpace(space float32) {
 	ax.space = space
 }
 
+// Space gives the size of the axis in pixels
+func (ax *Axis) Space() (space float32) {
+	space = ax.space
+	return
+}
+
 func (ax *Axis) Hide() {
 	ax.visible = false
 	ax.arrowOne.Hide()
@@ -222,6 +250,14 @@ func (ax *Axis) RefreshTheme() {
 		ax.ticks[i].supportCircle.StrokeColor = theme.Color(ax.style.SupportLineColorName)
 		ax.ticks[i].supportLine.StrokeColor = theme.Color(ax.style.SupportLineColorName)
 	}
+	// the label is drawn as image, which is only captured again if the theme changes its color or size
+	col := theme.Color(ax.labelStyle.ColorName)
+	size := theme.Size(ax.labelStyle.SizeName)
+	if ax.labelText.Color != col || ax.labelText.TextSize != size {
+		ax.labelText.Color = col
+		ax.labelText.TextSize = size
+		ax.SetLabel(ax.name)
+	}
 }
 
 func (ax *Axis) SetAxisStyle(s style.AxisStyle) {
@@ -254,6 +290,11 @@ func (ax *Axis) SetAxisStyle(s style.AxisStyle) {
 	}
 }
 
+func (ax *Axis) Style() (s style.AxisStyle) {
+	s = ax.style
+	return
+}
+
 func (ax *Axis) SetLabel(l string) {
 	ax.name = l
 	ax.labelText.Text = l
@@ -293,6 +334,20 @@ func (ax *Axis) Label() (l *canvas.Image) {
 	return
 }
 
+// ImageTexts gives the texts behind the label and tick label images of the axis
+func (ax *Axis) ImageTexts() (its []renderer.ImageText) {
+	rot := 0.0
+	if ax.typ == CartesianVertAxis || ax.typ == PolarPhiAxis {
+		rot = 90
+	}
+	its = append(its, renderer.ImageText{Image: ax.label, Text: ax.labelText, Rot: rot})
+	for i := range ax.ticks {
+		its = append(its, renderer.ImageText{Image: ax.ticks[i].label, Text: ax.ticks[i].labelText,
+			Rot: ax.ticks[i].labelRot})
+	}
+	return
+}
+
 func (ax *Axis) AddLabelToContainer(cont *fyne.Container) {
 	if ax.labelStyle.Alignment != fyne.TextAlignLeading {
 		cont.Add(layout.NewSpacer())
@@ -328,6 +383,35 @@ func (ax *Axis) AutoTicks() (a bool) {
 	return
 }
 
+// AutoSupportLine reports whether automatic ticks have support lines
+func (ax *Axis) AutoSupportLine() (b bool) {
+	b = ax.autoSupportLine
+	return
+}
+
+// Name gives the label text of the axis
+func (ax *Axis) Name() (l string) {
+	l = ax.name
+	return
+}
+
+// NTicks gives the current ticks of a numerical axis
+func (ax *Axis) NTicks() (ts []data.NumericalTick) {
+	for i := range ax.ticks {
+		ts = append(ts, data.NumericalTick{N: ax.ticks[i].n, SupportLine: ax.ticks[i].hasSupportLine})
+	}
+	return
+}
+
+// TTicks gives the current ticks of a temporal axis and the time layout of their labels
+func (ax *Axis) TTicks() (ts []data.TemporalTick, format string) {
+	for i := range ax.ticks {
+		ts = append(ts, data.TemporalTick{T: ax.ticks[i].t, SupportLine: ax.ticks[i].hasSupportLine})
+	}
+	format = ax.tTickFormat
+	return
+}
+
 func (ax *Axis) adjustNumberOfTicks(n int) {
 	//adjust size of ticks
 	if n < len(ax.ticks) {
@@ -47,7 +47,9 @@ func (ax *Axis) SetCTicks(cs []data.CategoricalTick) {
 		ax.ticks[i].label.Image = c.Capture()
 		ax.ticks[i].label.Resize(ax.ticks[i].labelText.MinSize())
 		ax.ticks[i].label.SetMinSize(ax.ticks[i].labelText.MinSize())
+		ax.ticks[i].labelRot = 0
 		if rot > 1 {
+			ax.ticks[i].labelRot = rot
 			ax.ticks[i].label.Image = imaging.Rotate(ax.ticks[i].label.Image, rot, color.RGBA{A: 0x00})
 			cos := float32(math.Cos(2 * math.Pi * rot / float64(360)))
 			sin := float32(math.Sin(2 * math.Pi * rot / float64(360)))
@@ -76,9 +78,17 @@ func (ax *Axis) ConvertCTickstoN() {
 	for i := range ax.ticks {
 		ax.ticks[i].nLabel = ax.CtoN(ax.ticks[i].c)
 		ax.ticks[i].nLine = ax.CtoN(ax.ticks[i].c) - 0.5*catSize
+		if ax.centeredCTicks {
+			ax.ticks[i].nLine = ax.ticks[i].nLabel
+		}
 	}
 }
 
+// SetCTicksCentered defines whether tick and support lines are drawn at the center of a category instead of its border
+func (ax *Axis) SetCTicksCentered(centered bool) {
+	ax.centeredCTicks = centered
+}
+
 func (ax *Axis) CtoN(c string) (n float64) {
 	numCats := len(ax.cs)
 	pos := -1
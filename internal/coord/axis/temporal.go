@@ -33,6 +33,7 @@ func (ax *Axis) TRange() (min time.Time, max time.Time) {
 
 func (ax *Axis) SetTTicks(ts []data.TemporalTick, format string) {
 	ax.adjustNumberOfTicks(len(ts))
+	ax.tTickFormat = format
 	for i := range ts {
 		ax.ticks[i].t = ts[i].T
 		ax.ticks[i].labelText.Text = ts[i].T.Format(format)
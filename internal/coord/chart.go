@@ -2,10 +2,14 @@ package coord
 
 import (
 	"fmt"
+	"image"
 	"image/color"
 	"math"
+	"reflect"
 	"strconv"
+	"sync"
 
+	"github.com/s-daehling/fyne-charts/internal/batch"
 	"github.com/s-daehling/fyne-charts/internal/coord/axis"
 	"github.com/s-daehling/fyne-charts/internal/coord/series"
 	"github.com/s-daehling/fyne-charts/internal/interact"
@@ -42,6 +46,7 @@ type BaseChart struct {
 	toAx              *axis.Axis
 	series            []series.Series
 	overlay           *interact.Overlay
+	menu              *interact.ContextMenu
 	tooltip           *interact.Tooltip
 	changed           bool
 	autoFromRange     bool
@@ -54,6 +59,10 @@ type BaseChart struct {
 	fromType          FromType
 	rast              *canvas.Raster
 	rasterSeries      []series.Series
+	rastImg           *image.RGBA
+	rastDirty         bool
+	rastTheme         fyne.Theme
+	rastVariant       fyne.ThemeVariant
 	render            fyne.WidgetRenderer
 	mainCont          *fyne.Container
 	hLabelCont        *fyne.Container
@@ -64,12 +73,21 @@ type BaseChart struct {
 	lLegendCont       *fyne.Container
 	bLegendCont       *fyne.Container
 	tLegendCont       *fyne.Container
+	leader            *BaseChart
+	followers         []*BaseChart
+	radar             *radarGrid
+	rowAxis           bool
+	updater           *batch.Updater
+	expVariant        fyne.ThemeVariant
+	expVariantSet     bool
+	mu                sync.Mutex
 }
 
 func EmptyBaseChart(pType PlaneType, fType FromType) (base *BaseChart) {
 	base = &BaseChart{
 		title:             canvas.NewText("", theme.Color(theme.ColorNameForeground)),
 		tooltip:           interact.NewTooltip(),
+		menu:              interact.NewContextMenu(),
 		changed:           false,
 		autoFromRange:     true,
 		autoToRange:       true,
@@ -88,6 +106,7 @@ func EmptyBaseChart(pType PlaneType, fType FromType) (base *BaseChart) {
 		bLegendCont:       container.NewStack(),
 		tLegendCont:       container.NewStack(),
 	}
+	base.updater = batch.NewUpdater(base.dataChange, base.refresh)
 	base.mainCont = container.NewBorder(
 		container.NewVBox(
 			base.title,
@@ -106,13 +125,13 @@ func EmptyBaseChart(pType PlaneType, fType FromType) (base *BaseChart) {
 	if pType == CartesianPlane {
 		base.fromAx = axis.EmptyAxis("", axis.CartesianHorAxis)
 		base.toAx = axis.EmptyAxis("", axis.CartesianVertAxis)
-		base.rast = canvas.NewRasterWithPixels(base.PixelGenCartesian)
+		base.rast = canvas.NewRaster(base.rasterGenerator(base.PixelGenCartesian))
 		base.vLabelCont.Add(base.toAx.Label())
 		base.hLabelCont.Add(base.fromAx.Label())
 	} else {
 		base.fromAx = axis.EmptyAxis("", axis.PolarPhiAxis)
 		base.toAx = axis.EmptyAxis("", axis.PolarRAxis)
-		base.rast = canvas.NewRasterWithPixels(base.PixelGenPolar)
+		base.rast = canvas.NewRaster(base.rasterGenerator(base.PixelGenPolar))
 		base.vLabelCont.Add(base.fromAx.Label())
 		base.hLabelCont.Add(base.toAx.Label())
 	}
@@ -203,7 +222,8 @@ func (base *BaseChart) CartesianObjects() (canObj []fyne.CanvasObject) {
 		for i := range tt.Entries {
 			canObj = append(canObj, tt.Entries[i])
 		}
-
+	}
+	if base.tooltipVisible || base.menu.Enabled() {
 		// add overlay
 		canObj = append(canObj, base.overlay)
 	}
@@ -277,7 +297,8 @@ func (base *BaseChart) PolarObjects() (canObj []fyne.CanvasObject) {
 		for i := range tt.Entries {
 			canObj = append(canObj, tt.Entries[i])
 		}
-
+	}
+	if base.tooltipVisible || base.menu.Enabled() {
 		// add overlay
 		canObj = append(canObj, base.overlay)
 	}
@@ -296,6 +317,7 @@ func (base *BaseChart) PolarNodes() (ns []renderer.PolarNode) {
 func (base *BaseChart) PolarEdges() (es []renderer.PolarEdge) {
 	phiMin, phiMax := base.fromAx.NRange()
 	rMin, rMax := base.toAx.NRange()
+	es = append(es, base.radarEdges()...)
 	for i := range base.series {
 		es = append(es, base.series[i].PolarEdges(phiMin, phiMax, rMin, rMax)...)
 	}
@@ -305,6 +327,7 @@ func (base *BaseChart) PolarEdges() (es []renderer.PolarEdge) {
 func (base *BaseChart) PolarTexts() (ts []renderer.PolarText) {
 	phiMin, phiMax := base.fromAx.NRange()
 	rMin, rMax := base.toAx.NRange()
+	ts = append(ts, base.radarTexts()...)
 	for i := range base.series {
 		ts = append(ts, base.series[i].PolarTexts(phiMin, phiMax, rMin, rMax)...)
 	}
@@ -374,18 +397,8 @@ func (base *BaseChart) SetTitleStyle(ts style.ChartTextStyle) {
 
 func (base *BaseChart) MouseIn(pX, pY, w, h, absX, absY float32) {
 	if base.planeType == CartesianPlane {
-		x, y, _ := base.PositionToCartesianCoordinates(pX, pY, w, h)
 		base.tooltip.MouseIn(pX, pY)
-		text := ""
-		switch base.fromType {
-		case Numerical:
-			text = fmt.Sprintf("x: %s, y: %s", strconv.FormatFloat(x, 'f', base.fromAx.NTipPrecision(), 64), strconv.FormatFloat(y, 'f', base.toAx.NTipPrecision(), 64))
-		case Temporal:
-			text = fmt.Sprintf("t: %s, y: %s", base.fromAx.NtoT(x).Format(base.fromAx.TTipFormat()), strconv.FormatFloat(y, 'f', base.toAx.NTipPrecision(), 64))
-		case Categorical:
-			text = fmt.Sprintf("c: %s, y: %s", base.fromAx.NtoC(x), strconv.FormatFloat(y, 'f', base.toAx.NTipPrecision(), 64))
-		}
-		base.tooltip.SetEntries([]string{text})
+		base.tooltip.SetEntries(base.cartesianTooltipEntries(pX, pY, w, h))
 	} else {
 		phi, r, _, _, _ := base.PositionToPolarCoordinates(pX, pY, w, h)
 		base.tooltip.MouseIn(pX, pY)
@@ -405,19 +418,9 @@ func (base *BaseChart) MouseIn(pX, pY, w, h, absX, absY float32) {
 
 func (base *BaseChart) MouseMove(pX, pY, w, h, absX, absY float32) {
 	if base.planeType == CartesianPlane {
-		x, y, _ := base.PositionToCartesianCoordinates(pX, pY, w, h)
 		c := base.tooltip.MouseMove(pX, pY)
 		if c > 3 {
-			text := ""
-			switch base.fromType {
-			case Numerical:
-				text = fmt.Sprintf("x: %s, y: %s", strconv.FormatFloat(x, 'f', base.fromAx.NTipPrecision(), 64), strconv.FormatFloat(y, 'f', base.toAx.NTipPrecision(), 64))
-			case Temporal:
-				text = fmt.Sprintf("t: %s, y: %s", base.fromAx.NtoT(x).Format(base.fromAx.TTipFormat()), strconv.FormatFloat(y, 'f', base.toAx.NTipPrecision(), 64))
-			case Categorical:
-				text = fmt.Sprintf("c: %s, y: %s", base.fromAx.NtoC(x), strconv.FormatFloat(y, 'f', base.toAx.NTipPrecision(), 64))
-			}
-			base.tooltip.SetEntries([]string{text})
+			base.tooltip.SetEntries(base.cartesianTooltipEntries(pX, pY, w, h))
 			base.Refresh()
 		}
 	} else {
@@ -439,11 +442,96 @@ func (base *BaseChart) MouseMove(pX, pY, w, h, absX, absY float32) {
 	}
 }
 
+// cartesianTooltipEntries gives the coordinates at the mouse position followed by the raw data point of each
+// point series that is closest to the mouse position in from-direction, if it is within a few pixels
+func (base *BaseChart) cartesianTooltipEntries(pX, pY, w, h float32) (ent []string) {
+	x, y, _ := base.PositionToCartesianCoordinates(pX, pY, w, h)
+	ent = append(ent, base.cartesianTipText(x, y))
+	xMin, xMax := base.fromAx.NRange()
+	pixels := w
+	if base.transposed {
+		pixels = h
+	}
+	if pixels <= 0 {
+		return
+	}
+	tolerance := 5 * (xMax - xMin) / float64(pixels)
+	base.Lock()
+	defer base.Unlock()
+	for i := range base.series {
+		ps, ok := base.series[i].(*series.PointSeries)
+		if !ok {
+			continue
+		}
+		if found, n, val := ps.NearestPoint(x); found && math.Abs(n-x) <= tolerance {
+			ent = append(ent, ps.Name()+": "+base.cartesianTipText(n, val))
+		}
+	}
+	return
+}
+
+func (base *BaseChart) cartesianTipText(x float64, y float64) (text string) {
+	switch base.fromType {
+	case Numerical:
+		text = fmt.Sprintf("x: %s, y: %s", strconv.FormatFloat(x, 'f', base.fromAx.NTipPrecision(), 64), strconv.FormatFloat(y, 'f', base.toAx.NTipPrecision(), 64))
+	case Temporal:
+		text = fmt.Sprintf("t: %s, y: %s", base.fromAx.NtoT(x).Format(base.fromAx.TTipFormat()), strconv.FormatFloat(y, 'f', base.toAx.NTipPrecision(), 64))
+	case Categorical:
+		text = fmt.Sprintf("c: %s, y: %s", base.fromAx.NtoC(x), strconv.FormatFloat(y, 'f', base.toAx.NTipPrecision(), 64))
+	}
+	return
+}
+
 func (base *BaseChart) MouseOut() {
 	base.tooltip.MouseOut()
 	base.Refresh()
 }
 
+// rasterGenerator creates the raster image pixel by pixel with pixelGen while the chart is locked
+// The image is cached and only created again if the size has changed or the raster has been invalidated
+func (base *BaseChart) rasterGenerator(pixelGen func(pX, pY, w, h int) color.Color) (gen func(w, h int) image.Image) {
+	gen = func(w, h int) image.Image {
+		base.Lock()
+		defer base.Unlock()
+		if !base.rastDirty && base.rastImg != nil && base.rastImg.Rect.Dx() == w && base.rastImg.Rect.Dy() == h {
+			return base.rastImg
+		}
+		img := image.NewRGBA(image.Rect(0, 0, w, h))
+		if len(base.rasterSeries) > 0 {
+			for y := 0; y < h; y++ {
+				for x := 0; x < w; x++ {
+					img.Set(x, y, pixelGen(x, y, w, h))
+				}
+			}
+		}
+		base.rastImg = img
+		base.rastDirty = false
+		return img
+	}
+	return
+}
+
+// invalidateRaster makes sure that the raster image is created again the next time it is drawn
+// The chart must be locked
+func (base *BaseChart) invalidateRaster() {
+	base.rastDirty = true
+}
+
+// rasterThemeChanged reports whether the theme or its variant have changed since the last call
+// Themes that cannot be compared are always reported as changed
+func (base *BaseChart) rasterThemeChanged() (changed bool) {
+	app := fyne.CurrentApp()
+	if app == nil {
+		return
+	}
+	th := theme.Current()
+	variant := app.Settings().ThemeVariant()
+	changed = variant != base.rastVariant || th == nil || !reflect.TypeOf(th).Comparable() || th != base.rastTheme
+	base.rastTheme = th
+	base.rastVariant = variant
+	return
+}
+
 func (base *BaseChart) PixelGenCartesian(pX, pY, w, h int) (col color.Color) {
 	col = color.RGBA{0x00, 0x00, 0x00, 0x00}
 	if len(base.rasterSeries) == 0 {
@@ -454,7 +542,7 @@ func (base *BaseChart) PixelGenCartesian(pX, pY, w, h int) (col color.Color) {
 		return
 	}
 	for i := range base.rasterSeries {
-		serCol := base.series[i].RasterColorCartesian(x, y)
+		serCol := base.rasterSeries[i].RasterColorCartesian(x, y)
 		r, g, b, _ := serCol.RGBA()
 		if r > 0 || g > 0 || b > 0 {
 			col = serCol
@@ -474,7 +562,7 @@ func (base *BaseChart) PixelGenPolar(pX, pY, w, h int) (col color.Color) {
 		return
 	}
 	for i := range base.rasterSeries {
-		serCol := base.series[i].RasterColorPolar(phi, r, x, y)
+		serCol := base.rasterSeries[i].RasterColorPolar(phi, r, x, y)
 		r, g, b, _ := serCol.RGBA()
 		if r > 0 || g > 0 || b > 0 {
 			col = serCol
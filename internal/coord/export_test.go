@@ -0,0 +1,300 @@
+package coord
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/xml"
+	"fmt"
+	"image/color"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/internal/coord/series"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+func TestExportImage(t *testing.T) {
+	a := test.NewTempApp(t)
+	var tests = []struct {
+		size    fyne.Size
+		scale   float32
+		variant fyne.ThemeVariant
+		expW    int
+		expH    int
+		expErr  bool
+	}{
+		{fyne.NewSize(400, 300), 1, theme.VariantLight, 400, 300, false},
+		{fyne.NewSize(400, 300), 2, theme.VariantDark, 800, 600, false},
+		{fyne.NewSize(0, 300), 1, theme.VariantLight, 0, 0, true},
+		{fyne.NewSize(400, 300), 0, theme.VariantLight, 0, 0, true},
+	}
+	base := EmptyBaseChart(CartesianPlane, Numerical)
+	ps := series.EmptyPointSeries("points", theme.ColorNamePrimary)
+	err := base.AddLineSeries(ps, true)
+	if err == nil {
+		err = ps.AddNumericalData([]data.NumericalPoint{{N: 0, Val: 1}, {N: 5, Val: 3}, {N: 10, Val: 2}})
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// the theme of the test app does not depend on the variant
+	a.Settings().SetTheme(theme.DefaultTheme())
+	prevTheme := a.Settings().Theme()
+	themeChanged := false
+	a.Settings().AddListener(func(fyne.Settings) { themeChanged = true })
+	w := test.NewWindow(base.MainContainer())
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 150))
+	liveSize := base.MainContainer().Size()
+	for i, tt := range tests {
+		base.SetExportThemeVariant(tt.variant)
+		img, err := base.ExportImage(tt.size, tt.scale)
+		if tt.expErr {
+			if err == nil {
+				t.Errorf("expected error, set %d", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error, set %d: %s", i, err)
+			continue
+		}
+		if img.Bounds().Dx() != tt.expW || img.Bounds().Dy() != tt.expH {
+			t.Errorf("wrong image size, set %d, exp %dx%d, have %v", i, tt.expW, tt.expH, img.Bounds())
+		}
+		exp := theme.DefaultTheme().Color(theme.ColorNameBackground, tt.variant)
+		if !sameColor(img.At(0, 0), exp) {
+			t.Errorf("wrong background, set %d, exp %v, have %v", i, exp, img.At(0, 0))
+		}
+		if themeChanged || a.Settings().Theme() != prevTheme {
+			t.Errorf("theme of the app changed, set %d", i)
+		}
+		if w.Content() != base.MainContainer() || base.MainContainer().Size() != liveSize {
+			t.Errorf("chart in window changed, set %d", i)
+		}
+	}
+}
+
+func TestWritePNG(t *testing.T) {
+	test.NewTempApp(t)
+	base := EmptyBaseChart(PolarPlane, Numerical)
+	path := filepath.Join(t.TempDir(), "chart.png")
+	err := base.WritePNG(path, fyne.NewSize(200, 200), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer f.Close()
+	img, err := png.Decode(f)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if img.Bounds().Dx() != 200 || img.Bounds().Dy() != 200 {
+		t.Errorf("wrong image size, exp 200x200, have %v", img.Bounds())
+	}
+}
+
+// vectorChart gives a line chart with title and axis labels
+func vectorChart(tb testing.TB) (base *BaseChart) {
+	base = EmptyBaseChart(CartesianPlane, Numerical)
+	base.SetTitle("Title")
+	base.SetFromAxisLabel("x-axis")
+	base.SetToAxisLabel("y-axis")
+	ps := series.EmptyPointSeries("points", theme.ColorNamePrimary)
+	err := base.AddLineSeries(ps, true)
+	if err == nil {
+		err = ps.AddNumericalData([]data.NumericalPoint{{N: 0, Val: 1}, {N: 5, Val: 3}, {N: 10, Val: 2}})
+	}
+	if err != nil {
+		tb.Fatalf("unexpected error: %s", err)
+	}
+	return
+}
+
+func TestWriteSVG(t *testing.T) {
+	a := test.NewTempApp(t)
+	a.Settings().SetTheme(theme.DefaultTheme())
+	base := vectorChart(t)
+	base.SetExportThemeVariant(theme.VariantLight)
+	var buf bytes.Buffer
+	err := base.WriteSVG(&buf, fyne.NewSize(400, 300))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	texts := make(map[string]bool)
+	elements := make(map[string]int)
+	strokes := make(map[string]bool)
+	dec := xml.NewDecoder(&buf)
+	inText := false
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("invalid svg: %s", err)
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			elements[tok.Name.Local]++
+			inText = tok.Name.Local == "text"
+			for _, attr := range tok.Attr {
+				if attr.Name.Local == "stroke" {
+					strokes[attr.Value] = true
+				}
+			}
+		case xml.CharData:
+			if inText {
+				texts[string(tok)] = true
+			}
+		case xml.EndElement:
+			inText = false
+		}
+	}
+	for _, s := range []string{"Title", "x-axis", "y-axis", "points", "0.0", "10.0"} {
+		if !texts[s] {
+			t.Errorf("text %q missing", s)
+		}
+	}
+	// background, lines of the series and the axes and dots of the series
+	for _, e := range []string{"svg", "rect", "line", "ellipse"} {
+		if elements[e] == 0 {
+			t.Errorf("no %s elements", e)
+		}
+	}
+	// the raster is empty without area series
+	if elements["image"] != 0 {
+		t.Errorf("wrong number of images, exp 0, have %d", elements["image"])
+	}
+	r, g, b, _ := rgba(theme.DefaultTheme().Color(theme.ColorNamePrimary, theme.VariantLight))
+	if col := fmt.Sprintf("#%02x%02x%02x", r, g, b); !strokes[col] {
+		t.Errorf("series color %s missing", col)
+	}
+}
+
+func TestWriteSVGAreas(t *testing.T) {
+	test.NewTempApp(t)
+	var tests = []struct {
+		pType PlaneType
+		area  bool
+	}{
+		{CartesianPlane, true},
+		{PolarPlane, true},
+		{PolarPlane, false},
+	}
+	for i, tt := range tests {
+		base := EmptyBaseChart(tt.pType, Numerical)
+		ps := series.EmptyPointSeries("points", theme.ColorNamePrimary)
+		var err error
+		if tt.area {
+			err = base.AddAreaSeries(ps, false)
+		} else {
+			err = ps.SetNumericalBarWidth(1)
+			if err == nil {
+				err = base.AddBarSeries(ps)
+			}
+		}
+		if err == nil {
+			err = ps.AddNumericalData([]data.NumericalPoint{{N: 1, Val: 1}, {N: 3, Val: 3}, {N: 5, Val: 2}})
+		}
+		var buf bytes.Buffer
+		if err == nil {
+			err = base.WriteSVG(&buf, fyne.NewSize(400, 300))
+		}
+		if err != nil {
+			t.Errorf("unexpected error, set %d: %s", i, err)
+			continue
+		}
+		// areas and bars on the raster are written as paths instead of an image
+		doc := buf.String()
+		if strings.Count(doc, "<path") == 0 || strings.Contains(doc, "<image") {
+			t.Errorf("expected paths and no image, set %d", i)
+		}
+	}
+}
+
+func TestWritePDF(t *testing.T) {
+	test.NewTempApp(t)
+	base := vectorChart(t)
+	base.SetToAxisLabel("R² · y")
+	var buf bytes.Buffer
+	err := base.WritePDF(&buf, fyne.NewSize(400, 300))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	doc := buf.String()
+	if !strings.HasPrefix(doc, "%PDF-1.4") || !strings.HasSuffix(doc, "%%EOF\n") {
+		t.Errorf("invalid header or trailer")
+	}
+	for _, s := range []string{"/MediaBox [0 0 400 300]", "/Subtype /Type0", "/Encoding /Identity-H", "/FontFile2",
+		"/ToUnicode"} {
+		if !strings.Contains(doc, s) {
+			t.Errorf("%q missing", s)
+		}
+	}
+	// texts are written as glyphs of the embedded fonts, which map back to all characters of the texts
+	if strings.Contains(doc, ") Tj") {
+		t.Errorf("text written with a standard font")
+	}
+	streams := pdfStreams(t, doc)
+	for _, r := range "Titlex-axisR² · y" {
+		if !strings.Contains(streams, fmt.Sprintf("> <%04X>", r)) {
+			t.Errorf("character %q missing in the fonts", r)
+		}
+	}
+	i := strings.LastIndex(doc, "startxref\n")
+	if i < 0 {
+		t.Fatalf("startxref missing")
+	}
+	offset, err := strconv.Atoi(strings.Fields(doc[i+len("startxref\n"):])[0])
+	if err != nil || !strings.HasPrefix(doc[offset:], "xref") {
+		t.Errorf("startxref does not point to the xref table")
+	}
+}
+
+// pdfStreams gives the content of the compressed streams of doc
+func pdfStreams(tb testing.TB, doc string) (content string) {
+	var b strings.Builder
+	for _, part := range strings.Split(doc, "/FlateDecode")[1:] {
+		start := strings.Index(part, "stream\n")
+		end := strings.Index(part, "\nendstream")
+		if start < 0 || end < start {
+			continue
+		}
+		r, err := zlib.NewReader(strings.NewReader(part[start+len("stream\n") : end]))
+		if err != nil {
+			tb.Fatalf("invalid stream: %s", err)
+		}
+		data, err := io.ReadAll(r)
+		if err != nil {
+			tb.Fatalf("invalid stream: %s", err)
+		}
+		b.Write(data)
+	}
+	content = b.String()
+	return
+}
+
+func rgba(col color.Color) (r uint8, g uint8, b uint8, a uint8) {
+	c := color.NRGBAModel.Convert(col).(color.NRGBA)
+	r, g, b, a = c.R, c.G, c.B, c.A
+	return
+}
+
+func sameColor(a color.Color, b color.Color) (same bool) {
+	r1, g1, b1, a1 := a.RGBA()
+	r2, g2, b2, a2 := b.RGBA()
+	same = r1 == r2 && g1 == g2 && b1 == b2 && a1 == a2
+	return
+}
@@ -0,0 +1,59 @@
+package coord
+
+import (
+	"github.com/s-daehling/fyne-charts/internal/coord/series"
+)
+
+func (base *BaseChart) AddGanttSeries(gs *series.GanttSeries) (err error) {
+	err = base.addSeriesIfNotExist(gs)
+	return
+}
+
+// ganttRows gives the rows of all gantt series of the chart in order of first appearance
+func (base *BaseChart) ganttRows() (rows []string) {
+	for i := range base.series {
+		gs, ok := base.series[i].(*series.GanttSeries)
+		if !ok {
+			continue
+		}
+		srows := gs.Rows()
+		for j := range srows {
+			exist := false
+			for k := range rows {
+				if rows[k] == srows[j] {
+					exist = true
+					break
+				}
+			}
+			if !exist {
+				rows = append(rows, srows[j])
+			}
+		}
+	}
+	return
+}
+
+// updateRowAxis turns the y-axis into a categorical axis with one category per gantt row if the chart contains gantt series.
+// The first row is placed at the top; a range or origin of the y-axis that has been set by the user is kept
+func (base *BaseChart) updateRowAxis() {
+	rows := base.ganttRows()
+	if len(rows) == 0 {
+		if base.rowAxis {
+			base.rowAxis = false
+			base.toAx.SetCRange(nil)
+		}
+		return
+	}
+	base.rowAxis = true
+	cs := make([]string, 0, len(rows))
+	for i := len(rows) - 1; i >= 0; i-- {
+		cs = append(cs, rows[i])
+	}
+	base.toAx.SetCRange(cs)
+	if base.autoToRange {
+		base.toAx.SetNRange(0, float64(len(cs)))
+	}
+	if base.autoOrigin {
+		base.toAx.AutoNOrigin()
+	}
+}
@@ -0,0 +1,51 @@
+package coord
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/internal/coord/series"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+func TestRowAxisRange(t *testing.T) {
+	test.NewTempApp(t)
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	base := EmptyBaseChart(CartesianPlane, Temporal)
+	gs := series.EmptyGanttSeries("schedule", theme.ColorNamePrimary)
+	err := base.AddGanttSeries(gs)
+	if err == nil {
+		err = gs.AddTasks([]data.GanttTask{
+			{Row: "a", Name: "1", TStart: t0, TEnd: t0.Add(time.Hour)},
+			{Row: "b", Name: "2", TStart: t0, TEnd: t0.Add(time.Hour)},
+		})
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if min, max := base.toAx.NRange(); min != 0 || max != 2 {
+		t.Errorf("wrong automatic range, exp 0-2, have %f-%f", min, max)
+	}
+
+	// a range set by the user is kept on data changes
+	err = base.SetToRange(1, 2)
+	if err == nil {
+		err = gs.AddTasks([]data.GanttTask{{Row: "c", Name: "3", TStart: t0, TEnd: t0.Add(time.Hour)}})
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if min, max := base.toAx.NRange(); min != 1 || max != 2 {
+		t.Errorf("user range not kept, exp 1-2, have %f-%f", min, max)
+	}
+	if origin := base.toAx.NOrigin(); origin != 1 {
+		t.Errorf("wrong origin, exp 1, have %f", origin)
+	}
+
+	base.SetAutoToRange()
+	if min, max := base.toAx.NRange(); min != 0 || max != 3 {
+		t.Errorf("wrong automatic range, exp 0-3, have %f-%f", min, max)
+	}
+}
@@ -0,0 +1,132 @@
+package coord
+
+import (
+	"image"
+	"math"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"github.com/s-daehling/fyne-charts/internal/coord/series"
+	dataio "github.com/s-daehling/fyne-charts/pkg/data/io"
+)
+
+// SetContextMenu enables or disables the context menu on right-click or long-press
+func (base *BaseChart) SetContextMenu(enabled bool) {
+	base.menu.SetEnabled(enabled)
+	base.Refresh()
+}
+
+// AddContextMenuItem appends an item after the standard actions of the context menu
+func (base *BaseChart) AddContextMenuItem(item *fyne.MenuItem) {
+	base.menu.AddItem(item)
+}
+
+// SetContextMenuImageCopier defines how copy image puts the chart image on the clipboard
+func (base *BaseChart) SetContextMenuImageCopier(f func(img image.Image) (err error)) {
+	base.menu.SetImageCopier(f)
+}
+
+// ShowContextMenu shows the context menu at the absolute position pos on the canvas of obj
+func (base *BaseChart) ShowContextMenu(obj fyne.CanvasObject, pos fyne.Position) {
+	base.menu.Show(base, base.mainCont, obj, pos)
+}
+
+// DataCSV gives the data of all series as CSV with one block per series, separated by an empty line
+// Each block starts with a comment line with the name of the series, followed by the data as written by WriteCSV of
+// package pkg/data/io with default options, so that each block can be read again by ReadCSV with comment '#'
+// The series of a stacked series are written as separate blocks
+// Indicator and regression series are left out, because they are computed from other series
+func (base *BaseChart) DataCSV() (s string) {
+	var b strings.Builder
+	all := math.Inf(1)
+	base.Lock()
+	for i := range base.series {
+		switch ser := base.series[i].(type) {
+		case *series.PointSeries:
+			base.writePointSeriesCSV(&b, ser)
+		case *series.StackedSeries:
+			for _, ps := range ser.Stack() {
+				base.writePointSeriesCSV(&b, ps)
+			}
+		case *series.CandleStickSeries:
+			switch base.fromType {
+			case Numerical:
+				writeCSVBlock(&b, ser.Name(), ser.NumericalData(-all, all))
+			case Temporal:
+				writeCSVBlock(&b, ser.Name(), ser.TemporalData(-all, all))
+			}
+		case *series.BoxSeries:
+			switch base.fromType {
+			case Numerical:
+				writeCSVBlock(&b, ser.Name(), ser.NumericalData(-all, all))
+			case Temporal:
+				writeCSVBlock(&b, ser.Name(), ser.TemporalData(-all, all))
+			case Categorical:
+				writeCSVBlock(&b, ser.Name(), ser.CategoricalData(-all, all))
+			}
+		case *series.ViolinSeries:
+			switch base.fromType {
+			case Numerical:
+				writeCSVBlock(&b, ser.Name(), ser.NumericalData(-all, all))
+			case Categorical:
+				writeCSVBlock(&b, ser.Name(), ser.CategoricalData(-all, all))
+			}
+		}
+	}
+	base.Unlock()
+	s = b.String()
+	return
+}
+
+// CSVSupported reports whether DataCSV writes the data of all series of the chart
+// The data of gantt, radar and KDE series cannot be written as CSV
+func (base *BaseChart) CSVSupported() (b bool) {
+	base.mu.Lock()
+	defer base.mu.Unlock()
+	for i := range base.series {
+		switch base.series[i].(type) {
+		case *series.GanttSeries, *series.RadarSeries, *series.KDESeries:
+			return
+		}
+	}
+	b = true
+	return
+}
+
+func (base *BaseChart) writePointSeriesCSV(b *strings.Builder, ps *series.PointSeries) {
+	all := math.Inf(1)
+	switch base.fromType {
+	case Numerical:
+		writeCSVBlock(b, ps.Name(), ps.NumericalData(-all, all))
+	case Temporal:
+		writeCSVBlock(b, ps.Name(), ps.TemporalData(-all, all))
+	case Categorical:
+		writeCSVBlock(b, ps.Name(), ps.CategoricalData(-all, all))
+	}
+}
+
+// writeCSVBlock writes the data of one series as comment line with its name and a CSV document of the io package
+func writeCSVBlock[T dataio.Record](b *strings.Builder, name string, rs []T) {
+	if b.Len() > 0 {
+		b.WriteString("\n")
+	}
+	b.WriteString("# " + strings.ReplaceAll(name, "\n", " ") + "\n")
+	dataio.WriteCSV(b, rs, dataio.DefaultOptions())
+}
+
+// Zoomed reports whether the range of an axis has been set
+func (base *BaseChart) Zoomed() (b bool) {
+	b = !base.autoFromRange || !base.autoToRange
+	return
+}
+
+// ResetZoom returns to the automatic ranges of both axes
+func (base *BaseChart) ResetZoom() {
+	base.SetAutoFromRange()
+	base.SetAutoToRange()
+}
+
+func (base *BaseChart) LegendVisible() (b bool) {
+	b = base.legend.Visible()
+	return
+}
@@ -0,0 +1,218 @@
+package coord
+
+import (
+	"image"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/internal/coord/series"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+	dataio "github.com/s-daehling/fyne-charts/pkg/data/io"
+)
+
+// menuLabels gives the labels of the items of m; separators are given as "-"
+func menuLabels(m *fyne.Menu) (ls []string) {
+	for _, item := range m.Items {
+		if item.IsSeparator {
+			ls = append(ls, "-")
+			continue
+		}
+		ls = append(ls, item.Label)
+	}
+	return
+}
+
+func menuItem(m *fyne.Menu, label string) (item *fyne.MenuItem) {
+	for _, it := range m.Items {
+		if it.Label == label {
+			item = it
+			return
+		}
+	}
+	return
+}
+
+func TestContextMenu(t *testing.T) {
+	test.NewTempApp(t)
+	base := vectorChart(t)
+	w := test.NewWindow(base.MainContainer())
+	defer w.Close()
+	w.Resize(fyne.NewSize(400, 300))
+
+	if base.menu.Menu(base, base.mainCont) != nil {
+		t.Errorf("menu without enabling it")
+	}
+	base.Lock()
+	hasOverlay := slices.Contains(base.CartesianObjects(), fyne.CanvasObject(base.overlay))
+	base.Unlock()
+	if hasOverlay {
+		t.Errorf("overlay added without tooltip or menu")
+	}
+
+	base.SetContextMenu(true)
+	tapped := false
+	base.AddContextMenuItem(fyne.NewMenuItem("Custom", func() { tapped = true }))
+	m := base.menu.Menu(base, base.mainCont)
+	exp := []string{"Copy as SVG", "Save as PNG...", "Save as SVG...", "Copy data as CSV", "-", "Reset zoom",
+		"Hide legend", "-", "Custom"}
+	if !slices.Equal(menuLabels(m), exp) {
+		t.Errorf("wrong items, exp %v, have %v", exp, menuLabels(m))
+	}
+	if !menuItem(m, "Reset zoom").Disabled {
+		t.Errorf("reset zoom enabled without zoom")
+	}
+	menuItem(m, "Custom").Action()
+	if !tapped {
+		t.Errorf("custom item not called")
+	}
+
+	// zoom in, reset the zoom and hide the legend with the menu
+	err := base.SetFromNRange(2, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	m = base.menu.Menu(base, base.mainCont)
+	if menuItem(m, "Reset zoom").Disabled {
+		t.Errorf("reset zoom disabled after zoom")
+	}
+	menuItem(m, "Reset zoom").Action()
+	if base.Zoomed() {
+		t.Errorf("zoom not reset")
+	}
+	menuItem(m, "Hide legend").Action()
+	if base.LegendVisible() {
+		t.Errorf("legend not hidden")
+	}
+	m = base.menu.Menu(base, base.mainCont)
+	if menuItem(m, "Show legend") == nil {
+		t.Errorf("show legend missing after hiding the legend")
+	}
+
+	cb := fyne.CurrentApp().Clipboard()
+	menuItem(m, "Copy data as CSV").Action()
+	if cb.Content() != "# points\nN,Val\n0,1\n5,3\n10,2\n" {
+		t.Errorf("wrong csv, have %q", cb.Content())
+	}
+	menuItem(m, "Copy as SVG").Action()
+	if !strings.HasPrefix(cb.Content(), "<?xml") || !strings.Contains(cb.Content(), "<svg") {
+		t.Errorf("svg not copied")
+	}
+
+	// copy image is only offered with an image copier
+	var copied image.Image
+	base.SetContextMenuImageCopier(func(img image.Image) (err error) {
+		copied = img
+		return
+	})
+	m = base.menu.Menu(base, base.mainCont)
+	if menuItem(m, "Copy as SVG") != nil || menuItem(m, "Copy image") == nil {
+		t.Fatalf("copy image not offered with image copier, have %v", menuLabels(m))
+	}
+	menuItem(m, "Copy image").Action()
+	if copied == nil || copied.Bounds().Dx() == 0 {
+		t.Errorf("image not copied")
+	}
+
+	// the overlay shows the menu on secondary taps
+	test.TapSecondaryAt(base.overlay, fyne.NewPos(10, 10))
+	if w.Canvas().Overlays().Top() == nil {
+		t.Errorf("menu not shown on secondary tap")
+	}
+}
+
+func TestDataCSV(t *testing.T) {
+	test.NewTempApp(t)
+	t0 := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	base := EmptyBaseChart(CartesianPlane, Temporal)
+	stack := series.EmptyStackedSeries("stack")
+	a := series.EmptyPointSeries("a", theme.ColorNamePrimary)
+	b := series.EmptyPointSeries("b", theme.ColorNameError)
+	err := base.AddStackedBarSeries(stack)
+	if err == nil {
+		err = stack.AddPointSeries(a)
+	}
+	if err == nil {
+		err = stack.AddPointSeries(b)
+	}
+	if err == nil {
+		err = a.AddTemporalData([]data.TemporalPoint{{T: t0, Val: 1.5}})
+	}
+	if err == nil {
+		err = b.AddTemporalData([]data.TemporalPoint{{T: t0, Val: 2}})
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// the series of the stack are written as separate blocks
+	exp := "# a\nT,Val\n2025-01-02T03:04:05Z,1.5\n\n# b\nT,Val\n2025-01-02T03:04:05Z,2\n"
+	if csv := base.DataCSV(); csv != exp {
+		t.Errorf("wrong csv, exp %q, have %q", exp, csv)
+	}
+
+	// candles and boxes are written with all their values in one row
+	cs := series.EmptyCandleStickSeries("prices")
+	bs := series.EmptyBoxSeries("box", theme.ColorNamePrimary)
+	candles := []data.TemporalCandleStick{{TStart: t0, TEnd: t0.Add(time.Hour), Open: 1, Close: 2, Low: 0.5, High: 3,
+		Volume: 10}}
+	boxes := []data.TemporalBox{{T: t0, Minimum: 1, FirstQuartile: 2, Median: 3, ThirdQuartile: 4, Maximum: 5,
+		Outlier: []float64{9, 10}}}
+	err = base.AddCandleStickSeries(cs)
+	if err == nil {
+		err = cs.AddTemporalData(candles)
+	}
+	if err == nil {
+		err = base.AddBoxSeries(bs)
+	}
+	if err == nil {
+		err = bs.AddTemporalData(boxes)
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	exp += "\n# prices\nTStart,TEnd,Open,Close,Low,High,Volume\n" +
+		"2025-01-02T03:04:05Z,2025-01-02T04:04:05Z,1,2,0.5,3,10\n" +
+		"\n# box\nT,Maximum,ThirdQuartile,Median,FirstQuartile,Minimum,Outlier\n2025-01-02T03:04:05Z,5,4,3,2,1,9;10\n"
+	csv := base.DataCSV()
+	if csv != exp {
+		t.Errorf("wrong csv, exp %q, have %q", exp, csv)
+	}
+	// each block can be read by the io package
+	opts := dataio.DefaultOptions()
+	opts.Comment = '#'
+	blocks := strings.Split(csv, "\n\n")
+	readCandles, err := dataio.ReadCSV[data.TemporalCandleStick](strings.NewReader(blocks[2]), opts)
+	if err != nil || !reflect.DeepEqual(readCandles, candles) {
+		t.Errorf("candles not read again, err %v, have %v", err, readCandles)
+	}
+	readBoxes, err := dataio.ReadCSV[data.TemporalBox](strings.NewReader(blocks[3]), opts)
+	if err != nil || !reflect.DeepEqual(readBoxes, boxes) {
+		t.Errorf("boxes not read again, err %v, have %v", err, readBoxes)
+	}
+	if !base.CSVSupported() {
+		t.Errorf("csv not supported")
+	}
+}
+
+func TestContextMenuWithoutCSV(t *testing.T) {
+	test.NewTempApp(t)
+	base := EmptyBaseChart(CartesianPlane, Temporal)
+	err := base.AddGanttSeries(series.EmptyGanttSeries("tasks", theme.ColorNamePrimary))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	base.SetContextMenu(true)
+	base.MainContainer().Resize(fyne.NewSize(400, 300))
+	// the data of gantt series cannot be written as csv, so the action is left out
+	if base.CSVSupported() {
+		t.Errorf("csv supported with gantt series")
+	}
+	if m := base.menu.Menu(base, base.mainCont); menuItem(m, "Copy data as CSV") != nil {
+		t.Errorf("copy data offered with gantt series")
+	}
+}
@@ -0,0 +1,76 @@
+package coord
+
+import (
+	"sync"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/internal/coord/series"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+// TestConcurrentAddDeleteRender is meant to be run with the race detector
+func TestConcurrentAddDeleteRender(t *testing.T) {
+	test.NewTempApp(t)
+	var tests = []struct {
+		pType PlaneType
+		area  bool
+	}{
+		{CartesianPlane, false},
+		{CartesianPlane, true},
+		{PolarPlane, true},
+	}
+	for i, tt := range tests {
+		base := EmptyBaseChart(tt.pType, Numerical)
+		w := test.NewWindow(base.MainContainer())
+		w.Resize(fyne.NewSize(400, 300))
+		ps := series.EmptyPointSeries("points", theme.ColorNameForeground)
+		ss := series.EmptyPointSeries("stream", theme.ColorNamePrimary)
+		err := ss.SetNumericalStream(20, 0)
+		if err == nil {
+			if tt.area {
+				err = base.AddAreaSeries(ps, true)
+			} else {
+				err = base.AddLineSeries(ps, true)
+			}
+		}
+		if err == nil {
+			err = base.AddScatterSeries(ss)
+		}
+		if err != nil {
+			t.Errorf("unexpected error, set %d: %s", i, err)
+			w.Close()
+			continue
+		}
+		var wg sync.WaitGroup
+		for g := range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := range 30 {
+					n := float64(g*100 + j)
+					ps.AddNumericalData([]data.NumericalPoint{{N: n, Val: n}})
+					if g == 0 {
+						ss.StreamNumericalData([]data.NumericalPoint{{N: n, Val: n}})
+					}
+					if j%10 == 9 {
+						ps.DeleteNumericalDataInRange(n-5, n+1)
+					}
+				}
+			}()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 30 {
+				base.Refresh()
+				base.Raster().Generator(40, 30)
+			}
+		}()
+		wg.Wait()
+		ps.Clear()
+		w.Close()
+	}
+}
@@ -0,0 +1,193 @@
+package coord
+
+import (
+	"errors"
+	"strconv"
+
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/internal/coord/series"
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+)
+
+type radarGrid struct {
+	polygon bool
+	ranges  []series.AxisRange
+	rings   []*canvas.Line
+	labels  []*canvas.Text
+}
+
+// MakeRadar turns a polar categorical chart into a radar chart with spokes at the center of each category
+func (base *BaseChart) MakeRadar() {
+	if base.planeType != PolarPlane || base.fromType != Categorical {
+		return
+	}
+	base.radar = &radarGrid{}
+	base.fromAx.SetCTicksCentered(true)
+	base.DataChange()
+}
+
+// SetRadarPolygonGrid defines whether the grid of a radar chart is drawn as polygons or as circles
+func (base *BaseChart) SetRadarPolygonGrid(polygon bool) {
+	if base.radar == nil {
+		return
+	}
+	base.radar.polygon = polygon
+	base.fromAx.SetPolygonGrid(polygon)
+	base.toAx.SetPolygonGrid(polygon)
+	base.Refresh()
+}
+
+// SetRadarAxisRange sets an individual range for the spoke of category c
+func (base *BaseChart) SetRadarAxisRange(c string, min float64, max float64) (err error) {
+	if base.radar == nil {
+		return
+	}
+	if min >= max {
+		err = errors.New("invalid range")
+		return
+	}
+	exist := false
+	for i := range base.radar.ranges {
+		if base.radar.ranges[i].C == c {
+			base.radar.ranges[i].Min = min
+			base.radar.ranges[i].Max = max
+			exist = true
+			break
+		}
+	}
+	if !exist {
+		base.radar.ranges = append(base.radar.ranges, series.AxisRange{C: c, Min: min, Max: max})
+	}
+	base.updateRadarRanges()
+	base.DataChange()
+	return
+}
+
+// SetRadarAutoAxisRange removes an individual range of the spoke of category c; the spoke uses the r-axis again
+func (base *BaseChart) SetRadarAutoAxisRange(c string) {
+	if base.radar == nil {
+		return
+	}
+	for i := range base.radar.ranges {
+		if base.radar.ranges[i].C == c {
+			base.radar.ranges = append(base.radar.ranges[:i], base.radar.ranges[i+1:]...)
+			break
+		}
+	}
+	base.updateRadarRanges()
+	base.DataChange()
+}
+
+func (base *BaseChart) updateRadarRanges() {
+	for i := range base.series {
+		if rs, ok := base.series[i].(*series.RadarSeries); ok {
+			rs.SetAxisRanges(base.radar.ranges)
+		}
+	}
+}
+
+func (base *BaseChart) AddRadarLineSeries(rs *series.RadarSeries, showDot bool) (err error) {
+	rs.MakeLine(showDot)
+	if base.radar != nil {
+		rs.SetAxisRanges(base.radar.ranges)
+	}
+	err = base.addSeriesIfNotExist(rs)
+	return
+}
+
+func (base *BaseChart) AddRadarAreaSeries(rs *series.RadarSeries, showDot bool) (err error) {
+	rs.MakeArea(showDot)
+	if base.radar != nil {
+		rs.SetAxisRanges(base.radar.ranges)
+	}
+	err = base.addSeriesIfNotExist(rs)
+	return
+}
+
+// radarEdges gives the polygon grid lines of a radar chart; one polygon per r-axis support line and one at the origin
+func (base *BaseChart) radarEdges() (es []renderer.PolarEdge) {
+	cs := base.fromAx.CRange()
+	if base.radar == nil || !base.radar.polygon || len(cs) < 3 {
+		return
+	}
+	rs := []float64{}
+	if base.toAx.Visible() {
+		rs = append(rs, base.toAx.SupportNs()...)
+	}
+	rOrigin := base.toAx.NOrigin()
+	numRings := len(rs)
+	if base.fromAx.Visible() {
+		rs = append(rs, rOrigin)
+	}
+	for len(base.radar.rings) < len(rs)*len(cs) {
+		base.radar.rings = append(base.radar.rings, canvas.NewLine(theme.Color(theme.ColorNameForeground)))
+	}
+	supStyle := base.toAx.Style()
+	axStyle := base.fromAx.Style()
+	for i := range rs {
+		for j := range cs {
+			l := base.radar.rings[(i*len(cs))+j]
+			if i < numRings {
+				l.StrokeColor = theme.Color(supStyle.SupportLineColorName)
+				l.StrokeWidth = supStyle.SupportLineWidth
+			} else {
+				l.StrokeColor = theme.Color(axStyle.LineColorName)
+				l.StrokeWidth = axStyle.LineWidth
+			}
+			es = append(es, renderer.PolarEdge{
+				Phi1: base.fromAx.CtoN(cs[j]),
+				R1:   rs[i],
+				Phi2: base.fromAx.CtoN(cs[(j+1)%len(cs)]),
+				R2:   rs[i],
+				Line: l,
+			})
+		}
+	}
+	return
+}
+
+// radarTexts gives the scale labels of all spokes with an individual range
+func (base *BaseChart) radarTexts() (ts []renderer.PolarText) {
+	if base.radar == nil || len(base.radar.ranges) == 0 || !base.toAx.Visible() {
+		return
+	}
+	cs := base.fromAx.CRange()
+	rOrigin := base.toAx.NOrigin()
+	rs := append(base.toAx.SupportNs(), rOrigin)
+	tickStyle := base.toAx.Style()
+	k := 0
+	for i := range base.radar.ranges {
+		exist := false
+		for j := range cs {
+			if cs[j] == base.radar.ranges[i].C {
+				exist = true
+				break
+			}
+		}
+		if !exist {
+			continue
+		}
+		for j := range rs {
+			if rs[j] <= 0 || rs[j] > rOrigin {
+				continue
+			}
+			if k >= len(base.radar.labels) {
+				base.radar.labels = append(base.radar.labels, canvas.NewText("", theme.Color(theme.ColorNameForeground)))
+			}
+			t := base.radar.labels[k]
+			k++
+			min := base.radar.ranges[i].Min
+			max := base.radar.ranges[i].Max
+			t.Text = strconv.FormatFloat(min+((rs[j]/rOrigin)*(max-min)), 'g', 4, 64)
+			t.Color = theme.Color(tickStyle.TickColorName)
+			t.TextSize = theme.Size(tickStyle.TickSizeName)
+			ts = append(ts, renderer.PolarText{
+				Phi:  base.fromAx.CtoN(base.radar.ranges[i].C),
+				R:    rs[j],
+				Text: t,
+			})
+		}
+	}
+	return
+}
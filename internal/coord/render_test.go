@@ -0,0 +1,211 @@
+package coord
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/internal/coord/series"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+// scatterChart gives a chart with one scatter series of n points that are not downsampled
+func scatterChart(tb testing.TB, n int) (base *BaseChart, w fyne.Window) {
+	base = EmptyBaseChart(CartesianPlane, Numerical)
+	w = test.NewWindow(base.MainContainer())
+	w.Resize(fyne.NewSize(800, 600))
+	ps := series.EmptyPointSeries("points", theme.ColorNameForeground)
+	err := base.AddScatterSeries(ps)
+	if err == nil {
+		err = ps.SetDownsampling(series.DownsampleNone)
+	}
+	if err == nil {
+		input := make([]data.NumericalPoint, n)
+		for i := range input {
+			input[i] = data.NumericalPoint{N: float64(i), Val: float64(i % 100)}
+		}
+		err = ps.AddNumericalData(input)
+	}
+	if err != nil {
+		tb.Fatalf("unexpected error: %s", err)
+	}
+	return
+}
+
+// candleChart gives a chart with one candlestick series of n candles
+func candleChart(tb testing.TB, n int) (base *BaseChart, w fyne.Window) {
+	base = EmptyBaseChart(CartesianPlane, Numerical)
+	w = test.NewWindow(base.MainContainer())
+	w.Resize(fyne.NewSize(800, 600))
+	cs := series.EmptyCandleStickSeries("candles")
+	err := base.AddCandleStickSeries(cs)
+	if err == nil {
+		input := make([]data.NumericalCandleStick, n)
+		for i := range input {
+			v := float64(i % 100)
+			input[i] = data.NumericalCandleStick{NStart: float64(i), NEnd: float64(i) + 0.8, Open: v, Close: v + 1,
+				Low: v - 1, High: v + 2}
+		}
+		err = cs.AddNumericalData(input)
+	}
+	if err != nil {
+		tb.Fatalf("unexpected error: %s", err)
+	}
+	return
+}
+
+func TestViewportCulling(t *testing.T) {
+	test.NewTempApp(t)
+	var tests = []struct {
+		min      float64
+		max      float64
+		expNodes int
+	}{
+		{0, 9999, 10000},
+		{100, 199, 100},
+		{9990, 20000, 10},
+	}
+	base, w := scatterChart(t, 10000)
+	defer w.Close()
+	for i, tt := range tests {
+		err := base.SetFromNRange(tt.min, tt.max)
+		if err != nil {
+			t.Errorf("unexpected error, set %d: %s", i, err)
+			continue
+		}
+		base.Lock()
+		nodes := base.CartesianNodes()
+		objects := len(base.CartesianObjects())
+		base.Unlock()
+		if len(nodes) != tt.expNodes {
+			t.Errorf("wrong number of nodes, set %d, exp %d, have %d", i, tt.expNodes, len(nodes))
+		}
+		// the drawn nodes, one neighbour on each side at most and the axes
+		if objects > tt.expNodes+200 {
+			t.Errorf("too many objects, set %d, nodes %d, objects %d", i, tt.expNodes, objects)
+		}
+	}
+}
+
+func TestViewportCullingCandles(t *testing.T) {
+	test.NewTempApp(t)
+	var tests = []struct {
+		min      float64
+		max      float64
+		expRects int
+	}{
+		{0, 10000, 10000},
+		{100, 200, 100},
+		{9990, 20000, 10},
+	}
+	base, w := candleChart(t, 10000)
+	defer w.Close()
+	for i, tt := range tests {
+		err := base.SetFromNRange(tt.min, tt.max)
+		if err != nil {
+			t.Errorf("unexpected error, set %d: %s", i, err)
+			continue
+		}
+		base.Lock()
+		rects := base.CartesianRects()
+		objects := len(base.CartesianObjects())
+		base.Unlock()
+		if len(rects) != tt.expRects {
+			t.Errorf("wrong number of rects, set %d, exp %d, have %d", i, tt.expRects, len(rects))
+		}
+		// a rect and two lines for each drawn candle and the axes
+		if objects > 3*tt.expRects+200 {
+			t.Errorf("too many objects, set %d, rects %d, objects %d", i, tt.expRects, objects)
+		}
+	}
+}
+
+func benchmarkRender(b *testing.B, chart func(tb testing.TB, n int) (base *BaseChart, w fyne.Window), n int,
+	window float64) {
+	test.NewTempApp(b)
+	base, w := chart(b, n)
+	defer w.Close()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// pan through the data, so that other points are drawn in every iteration
+		min := float64(i%10) * float64(n) / 10
+		base.SetFromNRange(min, min+window)
+	}
+}
+
+func BenchmarkRenderFull100k(b *testing.B) {
+	benchmarkRender(b, scatterChart, 100000, 100000)
+}
+
+func BenchmarkRenderZoomed100k(b *testing.B) {
+	benchmarkRender(b, scatterChart, 100000, 1000)
+}
+
+func BenchmarkRenderZoomed1M(b *testing.B) {
+	benchmarkRender(b, scatterChart, 1000000, 1000)
+}
+
+func BenchmarkRenderCandlesFull100k(b *testing.B) {
+	benchmarkRender(b, candleChart, 100000, 100000)
+}
+
+func BenchmarkRenderCandlesZoomed100k(b *testing.B) {
+	benchmarkRender(b, candleChart, 100000, 1000)
+}
+
+func TestRasterCache(t *testing.T) {
+	test.NewTempApp(t)
+	base := EmptyBaseChart(CartesianPlane, Numerical)
+	w := test.NewWindow(base.MainContainer())
+	defer w.Close()
+	w.Resize(fyne.NewSize(400, 300))
+	// the scatter series is added first, so the area series is not the first series of the chart
+	scatter := series.EmptyPointSeries("scatter", theme.ColorNameForeground)
+	area := series.EmptyPointSeries("area", theme.ColorNamePrimary)
+	err := base.AddScatterSeries(scatter)
+	if err == nil {
+		err = base.AddAreaSeries(area, false)
+	}
+	if err == nil {
+		err = area.AddNumericalData([]data.NumericalPoint{{N: 0, Val: 0}, {N: 10, Val: 10}})
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	gen := base.Raster().Generator
+	img := gen(40, 30)
+	filled := false
+	for y := 0; y < 30 && !filled; y++ {
+		for x := 0; x < 40 && !filled; x++ {
+			_, _, _, a := img.At(x, y).RGBA()
+			filled = a > 0
+		}
+	}
+	if !filled {
+		t.Errorf("area series not drawn")
+	}
+	if gen(40, 30) != img {
+		t.Errorf("raster created again without changes")
+	}
+	base.Refresh()
+	if gen(40, 30) != img {
+		t.Errorf("raster created again after refresh without changes")
+	}
+	if gen(50, 30) == img {
+		t.Errorf("raster not created again after size change")
+	}
+	img = gen(50, 30)
+	err = area.AddNumericalData([]data.NumericalPoint{{N: 20, Val: 5}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if gen(50, 30) == img {
+		t.Errorf("raster not created again after data change")
+	}
+	img = gen(50, 30)
+	area.SetColor(theme.ColorNameError)
+	if gen(50, 30) == img {
+		t.Errorf("raster not created again after color change")
+	}
+}
@@ -8,17 +8,22 @@ import (
 )
 
 func (base *BaseChart) addSeriesIfNotExist(ser series.Series) (err error) {
+	base.mu.Lock()
 	for i := range base.series {
 		if base.series[i].Name() == ser.Name() {
+			base.mu.Unlock()
 			err = errors.New("series already exists")
 			return
 		}
 	}
+	base.mu.Unlock()
 	err = ser.BindToChart(base)
 	if err != nil {
 		return
 	}
+	base.mu.Lock()
 	base.series = append(base.series, ser)
+	base.mu.Unlock()
 	base.DataChange()
 	return
 }
@@ -33,6 +38,14 @@ func (base *BaseChart) RemoveLegendEntry(name string, super string) {
 	base.refreshAxisLabels()
 }
 
+// VisibleFromNRange gives the current range of the from-axis in n
+func (base *BaseChart) VisibleFromNRange() (min float64, max float64) {
+	base.mu.Lock()
+	min, max = base.fromAx.NRange()
+	base.mu.Unlock()
+	return
+}
+
 func (base *BaseChart) AddBarSeries(ls *series.PointSeries) (err error) {
 	ls.MakeBar()
 	err = base.addSeriesIfNotExist(ls)
@@ -73,20 +86,46 @@ func (base *BaseChart) AddBoxSeries(bs *series.BoxSeries) (err error) {
 	return
 }
 
+func (base *BaseChart) AddViolinSeries(vs *series.ViolinSeries) (err error) {
+	err = base.addSeriesIfNotExist(vs)
+	return
+}
+
+func (base *BaseChart) AddKDESeries(ks *series.KDESeries) (err error) {
+	err = base.addSeriesIfNotExist(ks)
+	return
+}
+
 func (base *BaseChart) AddStackedBarSeries(sbs *series.StackedSeries) (err error) {
 	err = base.addSeriesIfNotExist(sbs)
 	return
 }
 
+func (base *BaseChart) AddIndicatorSeries(is *series.IndicatorSeries) (err error) {
+	err = base.addSeriesIfNotExist(is)
+	return
+}
+
+func (base *BaseChart) AddRegressionSeries(rs *series.RegressionSeries) (err error) {
+	err = base.addSeriesIfNotExist(rs)
+	return
+}
+
 func (base *BaseChart) RemoveSeries(name string) {
 	newSeries := make([]series.Series, 0)
+	released := make([]series.Series, 0)
+	base.mu.Lock()
 	for i := range base.series {
 		if base.series[i].Name() != name {
 			newSeries = append(newSeries, base.series[i])
 		} else {
-			base.series[i].Release()
+			released = append(released, base.series[i])
 		}
 	}
 	base.series = newSeries
+	base.mu.Unlock()
+	for i := range released {
+		released[i].Release()
+	}
 	base.DataChange()
 }
@@ -345,6 +345,8 @@ func (ser *BoxSeries) NumberOfPoints() (n int) {
 
 // Show makes all elements of the bar series visible
 func (ser *BoxSeries) Show() {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
 	ser.visible = true
 	for i := range ser.data {
 		ser.data[i].show()
@@ -354,6 +356,8 @@ func (ser *BoxSeries) Show() {
 
 // Hide hides all elements of the bar series
 func (ser *BoxSeries) Hide() {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
 	ser.visible = false
 	for i := range ser.data {
 		ser.data[i].hide()
@@ -371,6 +375,8 @@ func (ser *BoxSeries) toggleView() {
 
 // SetColor changes the color of the bar series
 func (ser *BoxSeries) SetColor(colName fyne.ThemeColorName) {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
 	ser.colName = colName
 	ser.col = theme.Color(ser.colName)
 	ser.legendEntry.SetColor(colName)
@@ -384,6 +390,8 @@ func (ser *BoxSeries) SetColor(colName fyne.ThemeColorName) {
 // Standard value is 1
 // The provided width must be greater than zero for this method to take effect
 func (ser *BoxSeries) SetLineWidth(lw float32) {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
 	if lw < 0 {
 		return
 	}
@@ -397,6 +405,8 @@ func (ser *BoxSeries) SetLineWidth(lw float32) {
 // Standard value is 5
 // The provided size must be greater than zero for this method to take effect
 func (ser *BoxSeries) SetOutlierSize(os float32) {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
 	if os < 0 {
 		return
 	}
@@ -406,10 +416,10 @@ func (ser *BoxSeries) SetOutlierSize(os float32) {
 }
 
 func (ser *BoxSeries) Clear() {
+	ser.mu.Lock()
 	ser.data = []*boxPoint{}
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.mu.Unlock()
+	ser.dataChanged()
 }
 
 // DeleteDataInRange deletes all boxes with a x-coordinate greater than min and smaller than max
@@ -419,6 +429,7 @@ func (ser *BoxSeries) DeleteNumericalDataInRange(min float64, max float64) (c in
 	if min > max {
 		return
 	}
+	ser.mu.Lock()
 	finalData := []*boxPoint{}
 	for i := range ser.data {
 		if ser.data[i].n > min && ser.data[i].n < max {
@@ -428,13 +439,13 @@ func (ser *BoxSeries) DeleteNumericalDataInRange(min float64, max float64) (c in
 		}
 	}
 	if c == 0 {
+		ser.mu.Unlock()
 		return
 	}
 	ser.data = nil
 	ser.data = finalData
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.mu.Unlock()
+	ser.dataChanged()
 	return
 }
 
@@ -451,6 +462,7 @@ func (ser *BoxSeries) AddNumericalData(input []data.NumericalBox) (err error) {
 			return
 		}
 	}
+	ser.mu.Lock()
 	for i := range input {
 		bPoint := emptyBoxPoint(len(input[i].Outlier), ser.col)
 		bPoint.n = input[i].N
@@ -462,9 +474,8 @@ func (ser *BoxSeries) AddNumericalData(input []data.NumericalBox) (err error) {
 		bPoint.outlier = append(bPoint.outlier, input[i].Outlier...)
 		ser.data = append(ser.data, bPoint)
 	}
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.mu.Unlock()
+	ser.dataChanged()
 	return
 }
 
@@ -475,6 +486,7 @@ func (ser *BoxSeries) DeleteTemporalDataInRange(min time.Time, max time.Time) (c
 	if min.After(max) {
 		return
 	}
+	ser.mu.Lock()
 	finalData := []*boxPoint{}
 	for i := range ser.data {
 		if ser.data[i].t.After(min) && ser.data[i].t.Before(max) {
@@ -484,13 +496,13 @@ func (ser *BoxSeries) DeleteTemporalDataInRange(min time.Time, max time.Time) (c
 		}
 	}
 	if c == 0 {
+		ser.mu.Unlock()
 		return
 	}
 	ser.data = nil
 	ser.data = finalData
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.mu.Unlock()
+	ser.dataChanged()
 	return
 }
 
@@ -507,6 +519,7 @@ func (ser *BoxSeries) AddTemporalData(input []data.TemporalBox) (err error) {
 			return
 		}
 	}
+	ser.mu.Lock()
 	for i := range input {
 		bPoint := emptyBoxPoint(len(input[i].Outlier), ser.col)
 		bPoint.t = input[i].T
@@ -518,9 +531,8 @@ func (ser *BoxSeries) AddTemporalData(input []data.TemporalBox) (err error) {
 		bPoint.outlier = append(bPoint.outlier, input[i].Outlier...)
 		ser.data = append(ser.data, bPoint)
 	}
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.mu.Unlock()
+	ser.dataChanged()
 	return
 }
 
@@ -531,6 +543,7 @@ func (ser *BoxSeries) DeleteCategoricalDataInRange(cat []string) (c int) {
 	if len(cat) == 0 {
 		return
 	}
+	ser.mu.Lock()
 	finalData := []*boxPoint{}
 	for i := range ser.data {
 		del := false
@@ -547,13 +560,13 @@ func (ser *BoxSeries) DeleteCategoricalDataInRange(cat []string) (c int) {
 		}
 	}
 	if c == 0 {
+		ser.mu.Unlock()
 		return
 	}
 	ser.data = nil
 	ser.data = finalData
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.mu.Unlock()
+	ser.dataChanged()
 	return
 }
 
@@ -571,6 +584,7 @@ func (ser *BoxSeries) AddCategoricalData(input []data.CategoricalBox) (err error
 			return
 		}
 	}
+	ser.mu.Lock()
 	for i := range input {
 		catExist := false
 		for j := range ser.data {
@@ -592,8 +606,40 @@ func (ser *BoxSeries) AddCategoricalData(input []data.CategoricalBox) (err error
 		bPoint.outlier = append(bPoint.outlier, input[i].Outlier...)
 		ser.data = append(ser.data, bPoint)
 	}
-	if ser.cont != nil {
-		ser.cont.DataChange()
+	ser.mu.Unlock()
+	ser.dataChanged()
+	return
+}
+
+// NumericalData gives the boxes with n between nMin and nMax; the series must be locked
+func (ser *BoxSeries) NumericalData(nMin float64, nMax float64) (bs []data.NumericalBox) {
+	for _, p := range ser.data {
+		if inNRange(p.n, nMin, nMax) {
+			bs = append(bs, data.NumericalBox{N: p.n, Maximum: p.max, ThirdQuartile: p.thirdQuart, Median: p.median,
+				FirstQuartile: p.firstQuart, Minimum: p.min, Outlier: append([]float64(nil), p.outlier...)})
+		}
+	}
+	return
+}
+
+// TemporalData gives the boxes with n between nMin and nMax; the series must be locked
+func (ser *BoxSeries) TemporalData(nMin float64, nMax float64) (bs []data.TemporalBox) {
+	for _, p := range ser.data {
+		if inNRange(p.n, nMin, nMax) {
+			bs = append(bs, data.TemporalBox{T: p.t, Maximum: p.max, ThirdQuartile: p.thirdQuart, Median: p.median,
+				FirstQuartile: p.firstQuart, Minimum: p.min, Outlier: append([]float64(nil), p.outlier...)})
+		}
+	}
+	return
+}
+
+// CategoricalData gives the boxes with n between nMin and nMax; the series must be locked
+func (ser *BoxSeries) CategoricalData(nMin float64, nMax float64) (bs []data.CategoricalBox) {
+	for _, p := range ser.data {
+		if inNRange(p.n, nMin, nMax) {
+			bs = append(bs, data.CategoricalBox{C: p.c, Maximum: p.max, ThirdQuartile: p.thirdQuart, Median: p.median,
+				FirstQuartile: p.firstQuart, Minimum: p.min, Outlier: append([]float64(nil), p.outlier...)})
+		}
 	}
 	return
 }
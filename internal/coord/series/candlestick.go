@@ -2,7 +2,6 @@ package series
 
 import (
 	"errors"
-	"image/color"
 	"time"
 
 	"github.com/s-daehling/fyne-charts/internal/renderer"
@@ -21,21 +20,49 @@ type candleStickPoint struct {
 	close     float64
 	high      float64
 	low       float64
+	volume    float64
 	upperLine *canvas.Line
 	lowerLine *canvas.Line
 	candle    *canvas.Rectangle
+	attached  bool
+	view      int
 }
 
 func emptyCandleStickPoint() (point *candleStickPoint) {
-	point = &candleStickPoint{
-		upperLine: canvas.NewLine(theme.Color(theme.ColorNameForeground)),
-		lowerLine: canvas.NewLine(theme.Color(theme.ColorNameForeground)),
-		candle:    canvas.NewRectangle(color.Black),
-	}
-	point.candle.CornerRadius = 2
+	point = &candleStickPoint{}
 	return
 }
 
+// attach takes the canvas objects that are needed to draw the candle from the pool
+func (point *candleStickPoint) attach(lw float32, visible bool) {
+	point.upperLine = pool.line()
+	point.lowerLine = pool.line()
+	point.candle = pool.rect()
+	point.upperLine.StrokeColor = theme.Color(theme.ColorNameForeground)
+	point.lowerLine.StrokeColor = theme.Color(theme.ColorNameForeground)
+	point.candle.StrokeWidth = 0
+	point.candle.CornerRadius = 2
+	point.attached = true
+	point.setLineWidth(lw)
+	if visible {
+		point.show()
+	} else {
+		point.hide()
+	}
+}
+
+// detach returns the canvas objects of the candle to the pool
+func (point *candleStickPoint) detach() {
+	if !point.attached {
+		return
+	}
+	pool.putCandle(point)
+	point.upperLine = nil
+	point.lowerLine = nil
+	point.candle = nil
+	point.attached = false
+}
+
 func (point *candleStickPoint) refresh() {
 	point.upperLine.Refresh()
 	point.lowerLine.Refresh()
@@ -117,11 +144,14 @@ func (point *candleStickPoint) cartesianRects(xMin float64, xMax float64, yMin f
 
 type CandleStickSeries struct {
 	baseSeries
-	data []*candleStickPoint
+	data      []*candleStickPoint
+	attached  []*candleStickPoint
+	view      int
+	lineWidth float32
 }
 
 func EmptyCandleStickSeries(name string) (ser *CandleStickSeries) {
-	ser = &CandleStickSeries{}
+	ser = &CandleStickSeries{lineWidth: 1}
 	ser.baseSeries = emptyBaseSeries(name, theme.ColorNameForeground, ser.toggleView)
 	// ser.legendButton.UseGradient(color.RGBA{R: 0xff, G: 0x00, B: 0x00, A: 0xff}, color.RGBA{R: 0x00, G: 0x88, B: 0x00, A: 0xff})
 	return
@@ -196,42 +226,48 @@ func (ser *CandleStickSeries) ConvertTtoN(tToN func(t time.Time) (n float64)) {
 
 func (ser *CandleStickSeries) CartesianEdges(xMin float64, xMax float64, yMin float64,
 	yMax float64) (es []renderer.CartesianEdge) {
-	for i := range ser.data {
-		es = append(es, ser.data[i].cartesianEdges(xMin, xMax, yMin, yMax)...)
+	cs := ser.viewCandles(xMin, xMax)
+	for i := range cs {
+		es = append(es, cs[i].cartesianEdges(xMin, xMax, yMin, yMax)...)
 	}
 	return
 }
 
 func (ser *CandleStickSeries) CartesianRects(xMin float64, xMax float64, yMin float64,
 	yMax float64) (fs []renderer.CartesianRect) {
-	for i := range ser.data {
-		fs = append(fs, ser.data[i].cartesianRects(xMin, xMax, yMin, yMax)...)
+	cs := ser.viewCandles(xMin, xMax)
+	for i := range cs {
+		fs = append(fs, cs[i].cartesianRects(xMin, xMax, yMin, yMax)...)
 	}
 	return
 }
 
 func (ser *CandleStickSeries) RefreshTheme() {
 	ser.col = theme.Color(ser.colName)
-	for i := range ser.data {
-		ser.data[i].upperLine.StrokeColor = theme.Color(theme.ColorNameForeground)
-		ser.data[i].lowerLine.StrokeColor = theme.Color(theme.ColorNameForeground)
+	for i := range ser.attached {
+		ser.attached[i].upperLine.StrokeColor = theme.Color(theme.ColorNameForeground)
+		ser.attached[i].lowerLine.StrokeColor = theme.Color(theme.ColorNameForeground)
 	}
 }
 
 // Show makes all elements of the series visible
 func (ser *CandleStickSeries) Show() {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
 	ser.visible = true
-	for i := range ser.data {
-		ser.data[i].show()
+	for i := range ser.attached {
+		ser.attached[i].show()
 	}
 	ser.legendEntry.Show()
 }
 
 // Hide hides all elements of the series
 func (ser *CandleStickSeries) Hide() {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
 	ser.visible = false
-	for i := range ser.data {
-		ser.data[i].hide()
+	for i := range ser.attached {
+		ser.attached[i].hide()
 	}
 	ser.legendEntry.Hide()
 }
@@ -248,20 +284,32 @@ func (ser *CandleStickSeries) toggleView() {
 // Standard value is 1
 // The provided width must be greater than zero for this method to take effect
 func (ser *CandleStickSeries) SetLineWidth(lw float32) {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
 	if lw < 0 {
 		return
 	}
-	for i := range ser.data {
-		ser.data[i].setLineWidth(lw)
-		ser.data[i].refresh()
+	ser.lineWidth = lw
+	for i := range ser.attached {
+		ser.attached[i].setLineWidth(lw)
+		ser.attached[i].refresh()
 	}
 }
 
+// Release returns the canvas objects of all candles to the pool when the series is removed from its chart
+func (ser *CandleStickSeries) Release() {
+	ser.baseSeries.Release()
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
+	ser.detachAll()
+}
+
 func (ser *CandleStickSeries) Clear() {
+	ser.mu.Lock()
+	ser.detachAll()
 	ser.data = []*candleStickPoint{}
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.mu.Unlock()
+	ser.dataChanged()
 }
 
 // DeleteDataInRange deletes all candles with a nEnd greater than min and a nStart smaller than max
@@ -271,6 +319,7 @@ func (ser *CandleStickSeries) DeleteNumericalDataInRange(min float64, max float6
 	if min > max {
 		return
 	}
+	ser.mu.Lock()
 	finalData := []*candleStickPoint{}
 	for i := range ser.data {
 		if ser.data[i].nStart > min && ser.data[i].nEnd < max {
@@ -280,13 +329,13 @@ func (ser *CandleStickSeries) DeleteNumericalDataInRange(min float64, max float6
 		}
 	}
 	if c == 0 {
+		ser.mu.Unlock()
 		return
 	}
 	ser.data = nil
 	ser.data = finalData
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.mu.Unlock()
+	ser.dataChanged()
 	return
 }
 
@@ -302,6 +351,7 @@ func (ser *CandleStickSeries) AddNumericalData(input []data.NumericalCandleStick
 			return
 		}
 	}
+	ser.mu.Lock()
 	for i := range input {
 		csPoint := emptyCandleStickPoint()
 		csPoint.nStart = input[i].NStart
@@ -310,11 +360,11 @@ func (ser *CandleStickSeries) AddNumericalData(input []data.NumericalCandleStick
 		csPoint.close = input[i].Close
 		csPoint.high = input[i].High
 		csPoint.low = input[i].Low
+		csPoint.volume = input[i].Volume
 		ser.data = append(ser.data, csPoint)
 	}
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.mu.Unlock()
+	ser.dataChanged()
 	return
 }
 
@@ -325,6 +375,7 @@ func (ser *CandleStickSeries) DeleteTemporalDataInRange(min time.Time, max time.
 	if min.After(max) {
 		return
 	}
+	ser.mu.Lock()
 	finalData := []*candleStickPoint{}
 	for i := range ser.data {
 		if ser.data[i].tStart.After(min) && ser.data[i].tEnd.Before(max) {
@@ -334,13 +385,13 @@ func (ser *CandleStickSeries) DeleteTemporalDataInRange(min time.Time, max time.
 		}
 	}
 	if c == 0 {
+		ser.mu.Unlock()
 		return
 	}
 	ser.data = nil
 	ser.data = finalData
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.mu.Unlock()
+	ser.dataChanged()
 	return
 }
 
@@ -356,6 +407,7 @@ func (ser *CandleStickSeries) AddTemporalData(input []data.TemporalCandleStick)
 			return
 		}
 	}
+	ser.mu.Lock()
 	for i := range input {
 		csPoint := emptyCandleStickPoint()
 		csPoint.tStart = input[i].TStart
@@ -364,10 +416,32 @@ func (ser *CandleStickSeries) AddTemporalData(input []data.TemporalCandleStick)
 		csPoint.close = input[i].Close
 		csPoint.high = input[i].High
 		csPoint.low = input[i].Low
+		csPoint.volume = input[i].Volume
 		ser.data = append(ser.data, csPoint)
 	}
-	if ser.cont != nil {
-		ser.cont.DataChange()
+	ser.mu.Unlock()
+	ser.dataChanged()
+	return
+}
+
+// NumericalData gives the candles that overlap the range from nMin to nMax; the series must be locked
+func (ser *CandleStickSeries) NumericalData(nMin float64, nMax float64) (cs []data.NumericalCandleStick) {
+	for _, p := range ser.data {
+		if p.nEnd >= nMin && p.nStart <= nMax {
+			cs = append(cs, data.NumericalCandleStick{NStart: p.nStart, NEnd: p.nEnd, Open: p.open, Close: p.close,
+				Low: p.low, High: p.high, Volume: p.volume})
+		}
+	}
+	return
+}
+
+// TemporalData gives the candles that overlap the range from nMin to nMax; the series must be locked
+func (ser *CandleStickSeries) TemporalData(nMin float64, nMax float64) (cs []data.TemporalCandleStick) {
+	for _, p := range ser.data {
+		if p.nEnd >= nMin && p.nStart <= nMax {
+			cs = append(cs, data.TemporalCandleStick{TStart: p.tStart, TEnd: p.tEnd, Open: p.open, Close: p.close,
+				Low: p.low, High: p.high, Volume: p.volume})
+		}
 	}
 	return
 }
@@ -42,15 +42,21 @@ type dataPoint struct {
 	showFromValBaseLine bool
 	showFromPrevLine    bool
 	showBar             bool
+	attached            bool
+	view                int
 }
 
-func emptyDataPoint(col color.Color, showDot bool, showFromBase bool, showFromPrev bool,
+// pointStyle is applied to the canvas objects of a point when they are taken from the pool
+type pointStyle struct {
+	col       color.Color
+	lineWidth float32
+	dotSize   float32
+	visible   bool
+}
+
+func emptyDataPoint(showDot bool, showFromBase bool, showFromPrev bool,
 	showBar bool) (point *dataPoint) {
 	point = &dataPoint{
-		dot:                 canvas.NewCircle(col),
-		fromValBase:         canvas.NewLine(col),
-		fromPrev:            canvas.NewLine(col),
-		bar:                 canvas.NewRectangle(col),
 		showDot:             showDot,
 		showFromValBaseLine: showFromBase,
 		showFromPrevLine:    showFromPrev,
@@ -61,45 +67,109 @@ func emptyDataPoint(col color.Color, showDot bool, showFromBase bool, showFromPr
 		nBarShift:           0,
 		tBarShift:           0,
 	}
-	point.dot.Resize(fyne.NewSize(5, 5))
+	return
+}
+
+// attach takes the canvas objects that are needed to draw the point from the pool
+func (point *dataPoint) attach(st pointStyle) {
+	if point.showDot {
+		point.dot = pool.circle()
+	}
+	if point.showFromValBaseLine {
+		point.fromValBase = pool.line()
+	}
+	if point.showFromPrevLine {
+		point.fromPrev = pool.line()
+	}
+	if point.showBar {
+		point.bar = pool.rect()
+	}
+	point.attached = true
+	point.setColor(st.col)
+	point.setLineWidth(st.lineWidth)
+	point.setDotSize(st.dotSize)
+	if st.visible {
+		point.show()
+	} else {
+		point.hide()
+	}
+}
+
+// detach returns the canvas objects of the point to the pool
+func (point *dataPoint) detach() {
+	if !point.attached {
+		return
+	}
+	pool.put(point)
+	point.dot = nil
+	point.fromValBase = nil
+	point.fromPrev = nil
+	point.bar = nil
+	point.attached = false
+}
+
+func (point *dataPoint) objects() (os []fyne.CanvasObject) {
+	if point.dot != nil {
+		os = append(os, point.dot)
+	}
+	if point.fromValBase != nil {
+		os = append(os, point.fromValBase)
+	}
+	if point.fromPrev != nil {
+		os = append(os, point.fromPrev)
+	}
+	if point.bar != nil {
+		os = append(os, point.bar)
+	}
 	return
 }
 
 func (point *dataPoint) refresh() {
-	point.dot.Refresh()
-	point.fromValBase.Refresh()
-	point.fromPrev.Refresh()
-	point.bar.Refresh()
+	for _, o := range point.objects() {
+		o.Refresh()
+	}
 }
 
 func (point *dataPoint) hide() {
-	point.dot.Hide()
-	point.fromValBase.Hide()
-	point.fromPrev.Hide()
-	point.bar.Hide()
+	for _, o := range point.objects() {
+		o.Hide()
+	}
 }
 
 func (point *dataPoint) show() {
-	point.dot.Show()
-	point.fromValBase.Show()
-	point.fromPrev.Show()
-	point.bar.Show()
+	for _, o := range point.objects() {
+		o.Show()
+	}
 }
 
 func (point *dataPoint) setColor(col color.Color) {
-	point.dot.FillColor = col
-	point.fromValBase.StrokeColor = col
-	point.fromPrev.StrokeColor = col
-	point.bar.FillColor = col
+	if point.dot != nil {
+		point.dot.FillColor = col
+	}
+	if point.fromValBase != nil {
+		point.fromValBase.StrokeColor = col
+	}
+	if point.fromPrev != nil {
+		point.fromPrev.StrokeColor = col
+	}
+	if point.bar != nil {
+		point.bar.FillColor = col
+	}
 }
 
 func (point *dataPoint) setLineWidth(lw float32) {
-	point.fromValBase.StrokeWidth = lw
-	point.fromPrev.StrokeWidth = lw
+	if point.fromValBase != nil {
+		point.fromValBase.StrokeWidth = lw
+	}
+	if point.fromPrev != nil {
+		point.fromPrev.StrokeWidth = lw
+	}
 }
 
 func (point *dataPoint) setDotSize(ds float32) {
-	point.dot.Resize(fyne.NewSize(ds, ds))
+	if point.dot != nil {
+		point.dot.Resize(fyne.NewSize(ds, ds))
+	}
 }
 
 func (point *dataPoint) setValBase(vb float64) {
@@ -267,7 +337,7 @@ func (point *dataPoint) cartesianRects(xMin float64, xMax float64, yMin float64,
 	return
 }
 
-func (point *dataPoint) RasterColorPolar(phi float64, r float64) (col color.Color, useColor bool) {
+func (point *dataPoint) RasterColorPolar(phi float64, r float64, barCol color.Color) (col color.Color, useColor bool) {
 	col = color.RGBA{0x00, 0x00, 0x00, 0x00}
 	useColor = false
 	if !point.showBar || phi < point.n+point.nBarShift-(point.nBarWidth/2) ||
@@ -275,7 +345,7 @@ func (point *dataPoint) RasterColorPolar(phi float64, r float64) (col color.Colo
 		r < point.valBase || r > point.val+point.valBase {
 		return
 	}
-	col = point.bar.FillColor
+	col = barCol
 	useColor = true
 	return
 }
@@ -297,6 +367,23 @@ type PointSeries struct {
 	isStacked           bool
 	valMin              float64
 	valMax              float64
+	streamCap           int
+	streamNWindow       float64
+	streamTWindow       time.Duration
+	streamScroll        bool
+	spare               []*dataPoint
+	streamBuf           []*dataPoint // buffer in which the data moves while points are streamed
+	downsample          DownsampleMethod
+	sampled             []*dataPoint
+	sampleDirty         bool
+	sampleMin           float64
+	sampleMax           float64
+	samplePixels        float32
+	drawnSorted         bool
+	lineWidth           float32
+	dotSize             float32
+	attached            []*dataPoint
+	view                int
 }
 
 func EmptyPointSeries(name string, colName fyne.ThemeColorName) (ser *PointSeries) {
@@ -313,12 +400,18 @@ func EmptyPointSeries(name string, colName fyne.ThemeColorName) (ser *PointSerie
 		showArea:            false,
 		isStacked:           false,
 		sortPoints:          true,
+		downsample:          DownsampleLTTB,
+		lineWidth:           1,
+		dotSize:             5,
 	}
 	ser.baseSeries = emptyBaseSeries(name, colName, ser.toggleView)
 	return
 }
 
 func (ser *PointSeries) MakeBar() {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
+	ser.detachAll()
 	ser.showBar = true
 	for i := range ser.data {
 		ser.data[i].showBar = true
@@ -326,6 +419,9 @@ func (ser *PointSeries) MakeBar() {
 }
 
 func (ser *PointSeries) MakeArea(showDot bool) {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
+	ser.detachAll()
 	ser.showDot = showDot
 	ser.showFromPrevLine = true
 	ser.showArea = true
@@ -336,6 +432,9 @@ func (ser *PointSeries) MakeArea(showDot bool) {
 }
 
 func (ser *PointSeries) MakeLine(showDot bool) {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
+	ser.detachAll()
 	ser.showDot = showDot
 	ser.showFromPrevLine = true
 	for i := range ser.data {
@@ -345,6 +444,9 @@ func (ser *PointSeries) MakeLine(showDot bool) {
 }
 
 func (ser *PointSeries) MakeLollipop() {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
+	ser.detachAll()
 	ser.showDot = true
 	ser.showFromValBaseLine = true
 	for i := range ser.data {
@@ -354,6 +456,9 @@ func (ser *PointSeries) MakeLollipop() {
 }
 
 func (ser *PointSeries) MakeScatter() {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
+	ser.detachAll()
 	ser.showDot = true
 	for i := range ser.data {
 		ser.data[i].showDot = true
@@ -373,6 +478,11 @@ func (ser *PointSeries) TRange() (isEmpty bool, min time.Time, max time.Time) {
 		isEmpty = true
 		return
 	}
+	if ser.streamScroll && ser.streamTWindow > 0 {
+		max = ser.data[len(ser.data)-1].t
+		min = max.Add(-ser.streamTWindow)
+		return
+	}
 	min = ser.data[0].t
 	max = ser.data[0].t
 	if ser.showBar {
@@ -404,6 +514,11 @@ func (ser *PointSeries) NRange() (isEmpty bool, min float64, max float64) {
 		isEmpty = true
 		return
 	}
+	if ser.streamScroll && ser.streamNWindow > 0 {
+		max = ser.data[len(ser.data)-1].n
+		min = max - ser.streamNWindow
+		return
+	}
 	min = ser.data[0].n
 	max = ser.data[0].n
 	if ser.showBar {
@@ -472,6 +587,7 @@ func (ser *PointSeries) ConvertCtoN(cToN func(c string) (n float64)) {
 	for i := range ser.data {
 		ser.data[i].n = cToN(ser.data[i].c)
 	}
+	ser.sampleDirty = true
 }
 
 func (ser *PointSeries) ConvertTtoN(tToN func(t time.Time) (n float64)) {
@@ -482,23 +598,26 @@ func (ser *PointSeries) ConvertTtoN(tToN func(t time.Time) (n float64)) {
 			ser.data[i].nBarShift = tToN(ser.data[i].t.Add(ser.data[i].tBarShift)) - ser.data[i].n
 		}
 	}
+	ser.sampleDirty = true
 }
 
 func (ser *PointSeries) CartesianNodes(xMin float64, xMax float64, yMin float64,
 	yMax float64) (ns []renderer.CartesianNode) {
-	for i := range ser.data {
-		ns = append(ns, ser.data[i].cartesianNodes(xMin, xMax, yMin, yMax)...)
+	ps := ser.viewPoints(xMin, xMax)
+	for i := range ps {
+		ns = append(ns, ps[i].cartesianNodes(xMin, xMax, yMin, yMax)...)
 	}
 	return
 }
 
 func (ser *PointSeries) CartesianEdges(xMin float64, xMax float64, yMin float64,
 	yMax float64) (es []renderer.CartesianEdge) {
-	for i := range ser.data {
+	ps := ser.viewPoints(xMin, xMax)
+	for i := range ps {
 		if i == 0 {
-			es = append(es, ser.data[i].cartesianEdges(true, 0, 0, xMin, xMax, yMin, yMax)...)
+			es = append(es, ps[i].cartesianEdges(true, 0, 0, xMin, xMax, yMin, yMax)...)
 		} else {
-			es = append(es, ser.data[i].cartesianEdges(false, ser.data[i-1].n, ser.data[i-1].val,
+			es = append(es, ps[i].cartesianEdges(false, ps[i-1].n, ps[i-1].val,
 				xMin, xMax, yMin, yMax)...)
 		}
 	}
@@ -507,8 +626,9 @@ func (ser *PointSeries) CartesianEdges(xMin float64, xMax float64, yMin float64,
 
 func (ser *PointSeries) CartesianRects(xMin float64, xMax float64, yMin float64,
 	yMax float64) (fs []renderer.CartesianRect) {
-	for i := range ser.data {
-		fs = append(fs, ser.data[i].cartesianRects(xMin, xMax, yMin, yMax, ser.isStacked)...)
+	ps := ser.viewPoints(xMin, xMax)
+	for i := range ps {
+		fs = append(fs, ps[i].cartesianRects(xMin, xMax, yMin, yMax, ser.isStacked)...)
 	}
 	return
 }
@@ -519,15 +639,16 @@ func (ser *PointSeries) RasterColorCartesian(x float64, y float64) (col color.Co
 		return
 	}
 	// find first data point with x higher
-	for i := range ser.data {
-		if ser.data[i].n > x {
+	ps := ser.drawnPoints()
+	for i := range ps {
+		if ps[i].n > x {
 			if i == 0 {
 				break
 			}
-			x1 := ser.data[i-1].n
-			x2 := ser.data[i].n
-			y1 := ser.data[i-1].val
-			y2 := ser.data[i].val
+			x1 := ps[i-1].n
+			x2 := ps[i].n
+			y1 := ps[i-1].val
+			y2 := ps[i].val
 			// interpolate
 			yS := y1 + (((x - x1) / (x2 - x1)) * (y2 - y1))
 			if yS > ser.valBase && y > ser.valBase && y < yS {
@@ -546,19 +667,21 @@ func (ser *PointSeries) RasterColorCartesian(x float64, y float64) (col color.Co
 
 func (ser *PointSeries) PolarNodes(phiMin float64, phiMax float64, rMin float64,
 	rMax float64) (ns []renderer.PolarNode) {
-	for i := range ser.data {
-		ns = append(ns, ser.data[i].polarNodes(phiMin, phiMax, rMin, rMax)...)
+	ps := ser.viewPoints(phiMin, phiMax)
+	for i := range ps {
+		ns = append(ns, ps[i].polarNodes(phiMin, phiMax, rMin, rMax)...)
 	}
 	return
 }
 
 func (ser *PointSeries) PolarEdges(phiMin float64, phiMax float64, rMin float64,
 	rMax float64) (es []renderer.PolarEdge) {
-	for i := range ser.data {
+	ps := ser.viewPoints(phiMin, phiMax)
+	for i := range ps {
 		if i == 0 {
-			es = append(es, ser.data[i].polarEdges(true, 0, 0, phiMin, phiMax, rMin, rMax)...)
+			es = append(es, ps[i].polarEdges(true, 0, 0, phiMin, phiMax, rMin, rMax)...)
 		} else {
-			es = append(es, ser.data[i].polarEdges(false, ser.data[i-1].n, ser.data[i-1].val,
+			es = append(es, ps[i].polarEdges(false, ps[i-1].n, ps[i-1].val,
 				phiMin, phiMax, rMin, rMax)...)
 		}
 	}
@@ -572,8 +695,9 @@ func (ser *PointSeries) RasterColorPolar(phi float64, r float64, x float64,
 		return
 	}
 	if ser.showBar {
-		for i := range ser.data {
-			pCol, useColor := ser.data[i].RasterColorPolar(phi, r)
+		ps := ser.drawnPoints()
+		for i := range ps {
+			pCol, useColor := ps[i].RasterColorPolar(phi, r, ser.col)
 			if useColor {
 				col = pCol
 				break
@@ -583,15 +707,16 @@ func (ser *PointSeries) RasterColorPolar(phi float64, r float64, x float64,
 		red, green, blue, _ := ser.col.RGBA()
 		colArea := color.RGBA64{R: uint16(red), G: uint16(green), B: uint16(blue), A: 0x8888}
 		// find first data point with x higher
-		for i := range ser.data {
-			if ser.data[i].n > phi {
+		ps := ser.drawnPoints()
+		for i := range ps {
+			if ps[i].n > phi {
 				if i == 0 {
 					break
 				}
-				phi1 := ser.data[i-1].n
-				phi2 := ser.data[i].n
-				r1 := ser.data[i-1].val
-				r2 := ser.data[i].val
+				phi1 := ps[i-1].n
+				phi2 := ps[i].n
+				r1 := ps[i-1].val
+				r2 := ps[i].val
 				R := r1 + (((phi - phi1) / (phi2 - phi1)) * (r2 - r1))
 				if r < R {
 					col = colArea
@@ -603,13 +728,68 @@ func (ser *PointSeries) RasterColorPolar(phi float64, r float64, x float64,
 	return
 }
 
+func (ser *PointSeries) RasterPolygons() (ps []renderer.Polygon) {
+	if !ser.visible || ser.cont == nil {
+		return
+	}
+	points := ser.drawnPoints()
+	if ser.cont.IsPolar() && ser.showBar {
+		for _, point := range points {
+			if !point.showBar {
+				continue
+			}
+			phi1 := point.n + point.nBarShift - (point.nBarWidth / 2)
+			phi2 := point.n + point.nBarShift + (point.nBarWidth / 2)
+			r1, r2 := point.valBase, point.val+point.valBase
+			xs, ys := renderer.ClipPolygon([]float64{phi1, phi2, phi2, phi1}, []float64{r1, r1, r2, r2},
+				math.Inf(-1), math.Inf(1), math.Inf(-1), ser.valMax)
+			if len(xs) > 0 {
+				ps = append(ps, renderer.Polygon{X: xs, Y: ys, Col: ser.col})
+			}
+		}
+		return
+	}
+	if !ser.showArea || len(points) < 2 {
+		return
+	}
+	// the area is closed along the value base line or, in polar charts, at the center
+	base, yMin := ser.valBase, ser.valMin
+	if ser.cont.IsPolar() {
+		base, yMin = 0, math.Inf(-1)
+	}
+	xs := []float64{points[0].n}
+	ys := []float64{base}
+	for _, point := range points {
+		xs = append(xs, point.n)
+		ys = append(ys, point.val)
+	}
+	xs = append(xs, points[len(points)-1].n)
+	ys = append(ys, base)
+	xs, ys = renderer.ClipPolygon(xs, ys, math.Inf(-1), math.Inf(1), yMin, ser.valMax)
+	if len(xs) > 0 {
+		ps = append(ps, renderer.Polygon{X: xs, Y: ys, Col: renderer.FillColor(ser.col, 0x88)})
+	}
+	return
+}
+
 func (ser *PointSeries) RefreshTheme() {
 	ser.col = theme.Color(ser.colName)
-	for i := range ser.data {
-		ser.data[i].setColor(ser.col)
+	for i := range ser.attached {
+		ser.attached[i].setColor(ser.col)
 	}
 }
 
+// pointStyle gives the style that is applied to points when they are drawn
+func (ser *PointSeries) pointStyle() (st pointStyle) {
+	st = pointStyle{
+		col:       ser.col,
+		lineWidth: ser.lineWidth,
+		dotSize:   ser.dotSize,
+		visible:   ser.visible,
+	}
+	return
+}
+
 func (ser *PointSeries) IsPartOfChartRaster() (b bool) {
 	b = false
 	if ser.cont == nil || !ser.visible {
@@ -666,25 +846,31 @@ func (ser *PointSeries) SetValBaseNumerical(vb float64) {
 
 // Show makes all elements of the series visible
 func (ser *PointSeries) Show() {
+	ser.mu.Lock()
 	ser.visible = true
-	for i := range ser.data {
-		ser.data[i].show()
+	for i := range ser.attached {
+		ser.attached[i].show()
 	}
+	cont := ser.cont
+	ser.mu.Unlock()
 	ser.legendEntry.Show()
-	if ser.showBar && ser.cont != nil {
-		ser.cont.DataChange()
+	if ser.showBar && cont != nil {
+		cont.DataChange()
 	}
 }
 
 // Hide hides all elements of the series
 func (ser *PointSeries) Hide() {
+	ser.mu.Lock()
 	ser.visible = false
-	for i := range ser.data {
-		ser.data[i].hide()
+	for i := range ser.attached {
+		ser.attached[i].hide()
 	}
+	cont := ser.cont
+	ser.mu.Unlock()
 	ser.legendEntry.Hide()
-	if ser.showBar && ser.cont != nil {
-		ser.cont.DataChange()
+	if ser.showBar && cont != nil {
+		cont.DataChange()
 	}
 }
 
@@ -700,32 +886,45 @@ func (ser *PointSeries) toggleView() {
 }
 
 func (ser *PointSeries) SetColor(colName fyne.ThemeColorName) {
+	ser.mu.Lock()
 	ser.colName = colName
 	ser.col = theme.Color(ser.colName)
 	ser.legendEntry.SetColor(colName)
-	for i := range ser.data {
-		ser.data[i].setColor(ser.col)
-		ser.data[i].refresh()
+	for i := range ser.attached {
+		ser.attached[i].setColor(ser.col)
+		ser.attached[i].refresh()
+	}
+	inRaster := ser.IsPartOfChartRaster()
+	cont := ser.cont
+	ser.mu.Unlock()
+	if inRaster {
+		cont.RasterRefresh()
 	}
 }
 
 func (ser *PointSeries) SetLineWidth(lw float32) {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
 	if lw < 0 {
 		return
 	}
-	for i := range ser.data {
-		ser.data[i].setLineWidth(lw)
-		ser.data[i].refresh()
+	ser.lineWidth = lw
+	for i := range ser.attached {
+		ser.attached[i].setLineWidth(lw)
+		ser.attached[i].refresh()
 	}
 }
 
 func (ser *PointSeries) SetDotSize(ds float32) {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
 	if ds < 0 {
 		return
 	}
-	for i := range ser.data {
-		ser.data[i].setDotSize(ds)
-		ser.data[i].refresh()
+	ser.dotSize = ds
+	for i := range ser.attached {
+		ser.attached[i].setDotSize(ds)
+		ser.attached[i].refresh()
 	}
 }
 
@@ -747,12 +946,15 @@ func (ser *PointSeries) SetNumericalBarWidth(width float64) (err error) {
 		err = errors.New("invalid width")
 		return
 	}
+	ser.mu.Lock()
 	ser.nBarWidth = width
 	for i := range ser.data {
 		ser.data[i].setNBarWidthAndShift(width, ser.nBarShift)
 	}
-	if ser.cont != nil {
-		ser.cont.DataChange()
+	cont := ser.cont
+	ser.mu.Unlock()
+	if cont != nil {
+		cont.DataChange()
 	}
 	return
 }
@@ -762,12 +964,15 @@ func (ser *PointSeries) SetTemporalBarWidth(width time.Duration) (err error) {
 		err = errors.New("invalid width")
 		return
 	}
+	ser.mu.Lock()
 	ser.tBarWidth = width
 	for i := range ser.data {
 		ser.data[i].setTBarWidthAndShift(width, ser.tBarShift)
 	}
-	if ser.cont != nil {
-		ser.cont.DataChange()
+	cont := ser.cont
+	ser.mu.Unlock()
+	if cont != nil {
+		cont.DataChange()
 	}
 	return
 }
@@ -787,38 +992,70 @@ func (ser *PointSeries) IsLollipopSeries() (b bool) {
 	return
 }
 
+// IsLineSeries reports whether the points are connected by lines without area
+func (ser *PointSeries) IsLineSeries() (b bool) {
+	b = ser.showFromPrevLine && !ser.showArea
+	return
+}
+
+// Appearance gives the line width and dot size and whether dots are drawn; the series must be locked
+func (ser *PointSeries) Appearance() (lineWidth float32, dotSize float32, showDot bool) {
+	lineWidth = ser.lineWidth
+	dotSize = ser.dotSize
+	showDot = ser.showDot
+	return
+}
+
+// BarWidth gives the bar width of numerical and temporal bar series; the series must be locked
+func (ser *PointSeries) BarWidth() (nWidth float64, tWidth time.Duration) {
+	nWidth = ser.nBarWidth
+	tWidth = ser.tBarWidth
+	return
+}
+
 func (ser *PointSeries) BindToChart(ch container) (err error) {
 	if ch.IsPolar() {
+		ser.mu.Lock()
 		for i := range ser.data {
 			if ser.data[i].val < 0 {
+				ser.mu.Unlock()
 				err = errors.New("invalid data, negative val not allowed for polar charts")
 				return
 			}
 		}
+		ser.mu.Unlock()
 	}
 	err = ser.baseSeries.BindToChart(ch)
 	return
 }
 
 func (ser *PointSeries) BindToStack(stack *StackedSeries) (err error) {
+	ser.mu.Lock()
 	for i := range ser.data {
 		if ser.data[i].val < 0 {
+			ser.mu.Unlock()
 			err = errors.New("invalid data, negative val not allowed for stacked series")
 			return
 		}
 	}
+	ser.mu.Unlock()
 	ser.legendEntry.SetSuper(stack.name)
 	ser.super = stack.name
 	err = ser.baseSeries.BindToChart(stack)
 	if err != nil {
 		return
 	}
+	ser.mu.Lock()
 	ser.isStacked = true
+	ser.mu.Unlock()
 	return
 }
 
 func (ser *PointSeries) Release() {
 	ser.baseSeries.Release()
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
+	ser.detachAll()
 	ser.showDot = false
 	ser.showFromValBaseLine = false
 	ser.showFromPrevLine = false
@@ -836,10 +1073,11 @@ func (ser *PointSeries) Release() {
 }
 
 func (ser *PointSeries) Clear() {
+	ser.mu.Lock()
 	ser.data = []*dataPoint{}
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.sampleDirty = true
+	ser.mu.Unlock()
+	ser.dataChanged()
 }
 
 func (ser *PointSeries) DeleteNumericalDataInRange(min float64, max float64) (c int) {
@@ -847,6 +1085,7 @@ func (ser *PointSeries) DeleteNumericalDataInRange(min float64, max float64) (c
 	if min > max {
 		return
 	}
+	ser.mu.Lock()
 	finalData := []*dataPoint{}
 	for i := range ser.data {
 		if ser.data[i].n > min && ser.data[i].n < max {
@@ -856,17 +1095,51 @@ func (ser *PointSeries) DeleteNumericalDataInRange(min float64, max float64) (c
 		}
 	}
 	if c == 0 {
+		ser.mu.Unlock()
 		return
 	}
 	ser.data = nil
 	ser.data = finalData
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.sampleDirty = true
+	ser.mu.Unlock()
+	ser.dataChanged()
 	return
 }
 
 func (ser *PointSeries) AddNumericalData(input []data.NumericalPoint) (err error) {
+	if len(input) == 0 {
+		return
+	}
+	ser.mu.Lock()
+	err = ser.addNumericalData(input)
+	ser.mu.Unlock()
+	if err != nil {
+		return
+	}
+	ser.dataChanged()
+	return
+}
+
+// ReplaceNumericalData replaces all data of the series with input
+// If input is invalid, an error is returned and the series keeps its data
+func (ser *PointSeries) ReplaceNumericalData(input []data.NumericalPoint) (err error) {
+	ser.mu.Lock()
+	old := ser.data
+	ser.data = []*dataPoint{}
+	err = ser.addNumericalData(input)
+	if err != nil {
+		ser.data = old
+		ser.mu.Unlock()
+		return
+	}
+	ser.sampleDirty = true
+	ser.mu.Unlock()
+	ser.dataChanged()
+	return
+}
+
+// addNumericalData adds the input to the data; the series must be locked
+func (ser *PointSeries) addNumericalData(input []data.NumericalPoint) (err error) {
 	if len(input) == 0 {
 		return
 	}
@@ -892,7 +1165,7 @@ func (ser *PointSeries) AddNumericalData(input []data.NumericalPoint) (err error
 		newData = input
 	}
 	for i := range newData {
-		dPoint := emptyDataPoint(ser.col, ser.showDot, ser.showFromValBaseLine,
+		dPoint := emptyDataPoint(ser.showDot, ser.showFromValBaseLine,
 			ser.showFromPrevLine, ser.showBar)
 		dPoint.n = newData[i].N
 		dPoint.val = newData[i].Val
@@ -904,9 +1177,7 @@ func (ser *PointSeries) AddNumericalData(input []data.NumericalPoint) (err error
 		}
 		ser.data = append(ser.data, dPoint)
 	}
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.sampleDirty = true
 	return
 }
 
@@ -915,6 +1186,7 @@ func (ser *PointSeries) DeleteTemporalDataInRange(min time.Time, max time.Time)
 	if min.After(max) {
 		return
 	}
+	ser.mu.Lock()
 	finalData := []*dataPoint{}
 	for i := range ser.data {
 		if ser.data[i].t.After(min) && ser.data[i].t.Before(max) {
@@ -924,17 +1196,51 @@ func (ser *PointSeries) DeleteTemporalDataInRange(min time.Time, max time.Time)
 		}
 	}
 	if c == 0 {
+		ser.mu.Unlock()
 		return
 	}
 	ser.data = nil
 	ser.data = finalData
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.sampleDirty = true
+	ser.mu.Unlock()
+	ser.dataChanged()
 	return
 }
 
 func (ser *PointSeries) AddTemporalData(input []data.TemporalPoint) (err error) {
+	if len(input) == 0 {
+		return
+	}
+	ser.mu.Lock()
+	err = ser.addTemporalData(input)
+	ser.mu.Unlock()
+	if err != nil {
+		return
+	}
+	ser.dataChanged()
+	return
+}
+
+// ReplaceTemporalData replaces all data of the series with input
+// If input is invalid, an error is returned and the series keeps its data
+func (ser *PointSeries) ReplaceTemporalData(input []data.TemporalPoint) (err error) {
+	ser.mu.Lock()
+	old := ser.data
+	ser.data = []*dataPoint{}
+	err = ser.addTemporalData(input)
+	if err != nil {
+		ser.data = old
+		ser.mu.Unlock()
+		return
+	}
+	ser.sampleDirty = true
+	ser.mu.Unlock()
+	ser.dataChanged()
+	return
+}
+
+// addTemporalData adds the input to the data; the series must be locked
+func (ser *PointSeries) addTemporalData(input []data.TemporalPoint) (err error) {
 	if len(input) == 0 {
 		return
 	}
@@ -960,7 +1266,7 @@ func (ser *PointSeries) AddTemporalData(input []data.TemporalPoint) (err error)
 		newData = input
 	}
 	for i := range newData {
-		dPoint := emptyDataPoint(ser.col, ser.showDot, ser.showFromValBaseLine,
+		dPoint := emptyDataPoint(ser.showDot, ser.showFromValBaseLine,
 			ser.showFromPrevLine, ser.showBar)
 		dPoint.t = newData[i].T
 		dPoint.val = newData[i].Val
@@ -972,9 +1278,7 @@ func (ser *PointSeries) AddTemporalData(input []data.TemporalPoint) (err error)
 		}
 		ser.data = append(ser.data, dPoint)
 	}
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.sampleDirty = true
 	return
 }
 
@@ -983,6 +1287,7 @@ func (ser *PointSeries) DeleteCategoricalDataInRange(cat []string) (c int) {
 	if len(cat) == 0 {
 		return
 	}
+	ser.mu.Lock()
 	finalData := []*dataPoint{}
 	for i := range ser.data {
 		del := false
@@ -999,17 +1304,51 @@ func (ser *PointSeries) DeleteCategoricalDataInRange(cat []string) (c int) {
 		}
 	}
 	if c == 0 {
+		ser.mu.Unlock()
 		return
 	}
 	ser.data = nil
 	ser.data = finalData
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.sampleDirty = true
+	ser.mu.Unlock()
+	ser.dataChanged()
 	return
 }
 
 func (ser *PointSeries) AddCategoricalData(input []data.CategoricalPoint) (err error) {
+	if len(input) == 0 {
+		return
+	}
+	ser.mu.Lock()
+	err = ser.addCategoricalData(input)
+	ser.mu.Unlock()
+	if err != nil {
+		return
+	}
+	ser.dataChanged()
+	return
+}
+
+// ReplaceCategoricalData replaces all data of the series with input
+// If input is invalid, an error is returned and the series keeps its data
+func (ser *PointSeries) ReplaceCategoricalData(input []data.CategoricalPoint) (err error) {
+	ser.mu.Lock()
+	old := ser.data
+	ser.data = []*dataPoint{}
+	err = ser.addCategoricalData(input)
+	if err != nil {
+		ser.data = old
+		ser.mu.Unlock()
+		return
+	}
+	ser.sampleDirty = true
+	ser.mu.Unlock()
+	ser.dataChanged()
+	return
+}
+
+// addCategoricalData adds the input to the data; the series must be locked
+func (ser *PointSeries) addCategoricalData(input []data.CategoricalPoint) (err error) {
 	if len(input) == 0 {
 		return
 	}
@@ -1034,7 +1373,7 @@ func (ser *PointSeries) AddCategoricalData(input []data.CategoricalPoint) (err e
 		if catExist {
 			continue
 		}
-		dPoint := emptyDataPoint(ser.col, ser.showDot, ser.showFromValBaseLine,
+		dPoint := emptyDataPoint(ser.showDot, ser.showFromValBaseLine,
 			ser.showFromPrevLine, ser.showBar)
 		dPoint.c = input[i].C
 		dPoint.val = input[i].Val
@@ -1043,8 +1382,36 @@ func (ser *PointSeries) AddCategoricalData(input []data.CategoricalPoint) (err e
 		}
 		ser.data = append(ser.data, dPoint)
 	}
-	if ser.cont != nil {
-		ser.cont.DataChange()
+	ser.sampleDirty = true
+	return
+}
+
+// NumericalData gives the data points with n between nMin and nMax; the series must be locked
+func (ser *PointSeries) NumericalData(nMin float64, nMax float64) (ps []data.NumericalPoint) {
+	for i := range ser.data {
+		if inNRange(ser.data[i].n, nMin, nMax) {
+			ps = append(ps, data.NumericalPoint{N: ser.data[i].n, Val: ser.data[i].val})
+		}
+	}
+	return
+}
+
+// TemporalData gives the data points with n between nMin and nMax; the series must be locked
+func (ser *PointSeries) TemporalData(nMin float64, nMax float64) (ps []data.TemporalPoint) {
+	for i := range ser.data {
+		if inNRange(ser.data[i].n, nMin, nMax) {
+			ps = append(ps, data.TemporalPoint{T: ser.data[i].t, Val: ser.data[i].val})
+		}
+	}
+	return
+}
+
+// CategoricalData gives the data points with n between nMin and nMax; the series must be locked
+func (ser *PointSeries) CategoricalData(nMin float64, nMax float64) (ps []data.CategoricalPoint) {
+	for i := range ser.data {
+		if inNRange(ser.data[i].n, nMin, nMax) {
+			ps = append(ps, data.CategoricalPoint{C: ser.data[i].c, Val: ser.data[i].val})
+		}
 	}
 	return
 }
@@ -0,0 +1,234 @@
+package series
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// DownsampleMethod defines how a point series is reduced if it has more points in the visible range than pixels
+type DownsampleMethod string
+
+const (
+	DownsampleNone   DownsampleMethod = "None"
+	DownsampleLTTB   DownsampleMethod = "LTTB"
+	DownsampleMinMax DownsampleMethod = "MinMax"
+)
+
+// width of the from-axis in pixels that is assumed before the chart has been laid out
+const defaultSamplingPixels = 1000
+
+// SetDownsampling defines the method that is used if the series has more points in the visible range than can be displayed
+// Downsampling only affects the drawn points; bar series, stacked series and series that are not sorted by n
+// are never downsampled
+func (ser *PointSeries) SetDownsampling(m DownsampleMethod) (err error) {
+	switch m {
+	case DownsampleNone, DownsampleLTTB, DownsampleMinMax:
+	default:
+		err = errors.New("invalid downsampling method")
+		return
+	}
+	ser.mu.Lock()
+	ser.downsample = m
+	ser.sampleDirty = true
+	cont := ser.cont
+	ser.mu.Unlock()
+	if cont != nil {
+		cont.DataChange()
+	}
+	return
+}
+
+// SetSamplingRange sets the from-axis range that is displayed on the given number of pixels
+// The drawn points are selected again if range or number of pixels have changed
+func (ser *PointSeries) SetSamplingRange(min float64, max float64, pixels float32) {
+	if min == ser.sampleMin && max == ser.sampleMax && pixels == ser.samplePixels {
+		return
+	}
+	ser.sampleMin = min
+	ser.sampleMax = max
+	ser.samplePixels = pixels
+	ser.sampleDirty = true
+}
+
+// drawnPoints gives the points that are drawn: the downsampled points if the series is downsampled, otherwise all points
+func (ser *PointSeries) drawnPoints() (ps []*dataPoint) {
+	if ser.sampleDirty {
+		ser.sampled = ser.downsampledPoints()
+		ser.sampleDirty = false
+		ser.drawnSorted = sortedByN(ser.data)
+	}
+	ps = ser.data
+	if ser.sampled != nil {
+		ps = ser.sampled
+	}
+	return
+}
+
+// downsampledPoints selects the points within the sampling range and one neighbour on each side
+// The result is nil if the series is not downsampled
+func (ser *PointSeries) downsampledPoints() (ps []*dataPoint) {
+	if ser.downsample == DownsampleNone || ser.showBar || ser.isStacked || !sortedByN(ser.data) {
+		return
+	}
+	pixels := float64(ser.samplePixels)
+	if pixels <= 0 {
+		pixels = defaultSamplingPixels
+	}
+	first, last := 0, len(ser.data)
+	min, max := ser.sampleMin, ser.sampleMax
+	if max > min {
+		first = sort.Search(len(ser.data), func(i int) bool { return ser.data[i].n >= min })
+		last = sort.Search(len(ser.data), func(i int) bool { return ser.data[i].n > max })
+		if first > 0 {
+			first--
+		}
+		if last < len(ser.data) {
+			last++
+		}
+	} else if len(ser.data) > 0 {
+		min, max = ser.data[0].n, ser.data[len(ser.data)-1].n
+	}
+	if last-first <= int(2*pixels) {
+		return
+	}
+	xs := make([]float64, last-first)
+	ys := make([]float64, last-first)
+	for i := range xs {
+		xs[i] = ser.data[first+i].n
+		ys[i] = ser.data[first+i].val
+	}
+	var idx []int
+	if ser.downsample == DownsampleMinMax {
+		idx = minMaxIndices(xs, ys, min, max, int(pixels))
+	} else {
+		idx = lttbIndices(xs, ys, int(2*pixels))
+	}
+	ps = make([]*dataPoint, len(idx))
+	for i := range idx {
+		ps[i] = ser.data[first+idx[i]]
+	}
+	return
+}
+
+// NearestPoint gives the raw data point whose n is closest to x; downsampling does not affect the result
+// found is false if the series is hidden or empty
+func (ser *PointSeries) NearestPoint(x float64) (found bool, n float64, val float64) {
+	if !ser.visible || len(ser.data) == 0 {
+		return
+	}
+	k := 0
+	if sortedByN(ser.data) {
+		k = sort.Search(len(ser.data), func(i int) bool { return ser.data[i].n >= x })
+		if k == len(ser.data) || (k > 0 && x-ser.data[k-1].n < ser.data[k].n-x) {
+			k--
+		}
+	} else {
+		for i := range ser.data {
+			if math.Abs(ser.data[i].n-x) < math.Abs(ser.data[k].n-x) {
+				k = i
+			}
+		}
+	}
+	found = true
+	n = ser.data[k].n
+	val = ser.data[k].val
+	return
+}
+
+func sortedByN(ps []*dataPoint) (b bool) {
+	for i := 1; i < len(ps); i++ {
+		if ps[i].n < ps[i-1].n {
+			return
+		}
+	}
+	b = true
+	return
+}
+
+// lttbIndices selects threshold points with the largest triangle three buckets algorithm
+// The first and the last point are always selected; xs must be sorted
+func lttbIndices(xs []float64, ys []float64, threshold int) (idx []int) {
+	n := len(xs)
+	if threshold >= n || threshold < 3 {
+		for i := range xs {
+			idx = append(idx, i)
+		}
+		return
+	}
+	bucket := float64(n-2) / float64(threshold-2)
+	idx = append(idx, 0)
+	a := 0
+	for i := range threshold - 2 {
+		// average point of the next bucket is the third corner of the triangle
+		avgStart := int(float64(i+1)*bucket) + 1
+		avgEnd := min(int(float64(i+2)*bucket)+1, n)
+		avgX, avgY := 0.0, 0.0
+		for j := avgStart; j < avgEnd; j++ {
+			avgX += xs[j]
+			avgY += ys[j]
+		}
+		avgX /= float64(avgEnd - avgStart)
+		avgY /= float64(avgEnd - avgStart)
+		// select the point of the current bucket with the largest triangle
+		start := int(float64(i)*bucket) + 1
+		end := int(float64(i+1)*bucket) + 1
+		maxArea := -1.0
+		next := start
+		for j := start; j < end; j++ {
+			area := math.Abs((xs[a]-avgX)*(ys[j]-ys[a]) - (xs[a]-xs[j])*(avgY-ys[a]))
+			if area > maxArea {
+				maxArea = area
+				next = j
+			}
+		}
+		idx = append(idx, next)
+		a = next
+	}
+	idx = append(idx, n-1)
+	return
+}
+
+// minMaxIndices divides [min,max] into columns of equal width and selects the first, the last,
+// the minimum and the maximum point of each column; points outside of [min,max] form one column on each side
+// xs must be sorted
+func minMaxIndices(xs []float64, ys []float64, min float64, max float64, columns int) (idx []int) {
+	if columns < 1 || max <= min {
+		for i := range xs {
+			idx = append(idx, i)
+		}
+		return
+	}
+	width := (max - min) / float64(columns)
+	column := func(x float64) (c int) {
+		c = int(math.Floor((x - min) / width))
+		c = int(math.Max(-1, math.Min(float64(columns), float64(c))))
+		return
+	}
+	start := 0
+	for start < len(xs) {
+		c := column(xs[start])
+		end := start + 1
+		for end < len(xs) && column(xs[end]) == c {
+			end++
+		}
+		iMin, iMax := start, start
+		for j := start + 1; j < end; j++ {
+			if ys[j] < ys[iMin] {
+				iMin = j
+			}
+			if ys[j] > ys[iMax] {
+				iMax = j
+			}
+		}
+		sel := []int{start, iMin, iMax, end - 1}
+		sort.Ints(sel)
+		for i := range sel {
+			if i == 0 || sel[i] != sel[i-1] {
+				idx = append(idx, sel[i])
+			}
+		}
+		start = end
+	}
+	return
+}
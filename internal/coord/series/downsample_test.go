@@ -0,0 +1,50 @@
+package series
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLTTBIndices(t *testing.T) {
+	var tests = []struct {
+		xs        []float64
+		ys        []float64
+		threshold int
+		expIdx    []int
+	}{
+		{[]float64{0, 1, 2}, []float64{0, 1, 0}, 5, []int{0, 1, 2}},
+		{[]float64{0, 1, 2, 3, 4}, []float64{0, 0, 5, 0, 0}, 2, []int{0, 1, 2, 3, 4}},
+		{[]float64{0, 1, 2, 3, 4}, []float64{0, 0, 5, 0, 0}, 3, []int{0, 2, 4}},
+		{[]float64{0, 1, 2, 3, 4, 5, 6}, []float64{0, 1, 0, 0, -3, 0, 0}, 4, []int{0, 1, 4, 6}},
+		{[]float64{}, []float64{}, 3, nil},
+	}
+	for i, tt := range tests {
+		idx := lttbIndices(tt.xs, tt.ys, tt.threshold)
+		if !slices.Equal(idx, tt.expIdx) {
+			t.Errorf("wrong indices, set %d, exp %v, have %v", i, tt.expIdx, idx)
+		}
+	}
+}
+
+func TestMinMaxIndices(t *testing.T) {
+	var tests = []struct {
+		xs      []float64
+		ys      []float64
+		min     float64
+		max     float64
+		columns int
+		expIdx  []int
+	}{
+		{[]float64{0, 1, 2, 3}, []float64{0, 1, 2, 3}, 0, 4, 0, []int{0, 1, 2, 3}},
+		{[]float64{0, 1, 2, 3}, []float64{0, 1, 2, 3}, 4, 4, 2, []int{0, 1, 2, 3}},
+		{[]float64{0, 0.2, 0.4, 0.6, 0.8}, []float64{1, 5, -2, 3, 2}, 0, 1, 1, []int{0, 1, 2, 4}},
+		{[]float64{0, 0.2, 0.4, 0.6, 0.8, 1.2, 1.4, 1.6}, []float64{1, 5, -2, 3, 2, 0, 0, 0}, 0, 2, 2, []int{0, 1, 2, 4, 5, 7}},
+		{[]float64{-1, 0.5, 0.6, 3}, []float64{0, 1, 2, 0}, 0, 1, 1, []int{0, 1, 2, 3}},
+	}
+	for i, tt := range tests {
+		idx := minMaxIndices(tt.xs, tt.ys, tt.min, tt.max, tt.columns)
+		if !slices.Equal(idx, tt.expIdx) {
+			t.Errorf("wrong indices, set %d, exp %v, have %v", i, tt.expIdx, idx)
+		}
+	}
+}
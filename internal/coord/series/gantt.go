@@ -0,0 +1,533 @@
+package series
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"time"
+
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+type ganttTask struct {
+	row    string
+	name   string
+	tStart time.Time
+	tEnd   time.Time
+	nStart float64
+	nEnd   float64
+	nRow   float64
+	bar    *canvas.Rectangle
+	label  *canvas.Text
+}
+
+func emptyGanttTask(col color.Color) (task *ganttTask) {
+	task = &ganttTask{
+		bar:   canvas.NewRectangle(col),
+		label: canvas.NewText("", theme.Color(theme.ColorNameBackground)),
+	}
+	task.bar.CornerRadius = 2
+	return
+}
+
+func (task *ganttTask) hide() {
+	task.bar.Hide()
+	task.label.Hide()
+}
+
+func (task *ganttTask) show() {
+	task.bar.Show()
+	task.label.Show()
+}
+
+type ganttMilestone struct {
+	row   string
+	name  string
+	t     time.Time
+	n     float64
+	nRow  float64
+	dot   *canvas.Circle
+	label *canvas.Text
+}
+
+func emptyGanttMilestone(col color.Color) (ms *ganttMilestone) {
+	ms = &ganttMilestone{
+		dot:   canvas.NewCircle(col),
+		label: canvas.NewText("", theme.Color(theme.ColorNameForeground)),
+	}
+	ms.dot.Resize(fyne.NewSize(10, 10))
+	return
+}
+
+func (ms *ganttMilestone) hide() {
+	ms.dot.Hide()
+	ms.label.Hide()
+}
+
+func (ms *ganttMilestone) show() {
+	ms.dot.Show()
+	ms.label.Show()
+}
+
+type ganttDependency struct {
+	from  string
+	to    string
+	lines []*canvas.Line // connector (two segments) and arrow head (two segments)
+}
+
+func emptyGanttDependency() (dep *ganttDependency) {
+	dep = &ganttDependency{}
+	for range 4 {
+		dep.lines = append(dep.lines, canvas.NewLine(theme.Color(theme.ColorNameForeground)))
+	}
+	return
+}
+
+func (dep *ganttDependency) hide() {
+	for i := range dep.lines {
+		dep.lines[i].Hide()
+	}
+}
+
+func (dep *ganttDependency) show() {
+	for i := range dep.lines {
+		dep.lines[i].Show()
+	}
+}
+
+// GanttSeries shows tasks as horizontal bars from start to end time; each task belongs to a row of the categorical y-axis
+type GanttSeries struct {
+	baseSeries
+	tasks      []*ganttTask
+	milestones []*ganttMilestone
+	deps       []*ganttDependency
+	barHeight  float64
+}
+
+func EmptyGanttSeries(name string, colName fyne.ThemeColorName) (ser *GanttSeries) {
+	ser = &GanttSeries{
+		barHeight: 0.6,
+	}
+	ser.baseSeries = emptyBaseSeries(name, colName, ser.toggleView)
+	return
+}
+
+// Rows gives all rows of the series in order of first appearance
+func (ser *GanttSeries) Rows() (rows []string) {
+	add := func(r string) {
+		for i := range rows {
+			if rows[i] == r {
+				return
+			}
+		}
+		rows = append(rows, r)
+	}
+	for i := range ser.tasks {
+		add(ser.tasks[i].row)
+	}
+	for i := range ser.milestones {
+		add(ser.milestones[i].row)
+	}
+	return
+}
+
+// ConvertRowsToN sets the y-coordinate of all tasks and milestones
+func (ser *GanttSeries) ConvertRowsToN(rToN func(r string) (n float64)) {
+	for i := range ser.tasks {
+		ser.tasks[i].nRow = rToN(ser.tasks[i].row)
+	}
+	for i := range ser.milestones {
+		ser.milestones[i].nRow = rToN(ser.milestones[i].row)
+	}
+}
+
+// SetBarHeight sets the height of the bars as fraction of the row height
+func (ser *GanttSeries) SetBarHeight(h float64) {
+	ser.barHeight = h
+}
+
+func (ser *GanttSeries) TRange() (isEmpty bool, min time.Time, max time.Time) {
+	isEmpty = true
+	extend := func(tMin time.Time, tMax time.Time) {
+		if isEmpty {
+			min = tMin
+			max = tMax
+			isEmpty = false
+			return
+		}
+		if tMin.Before(min) {
+			min = tMin
+		}
+		if tMax.After(max) {
+			max = tMax
+		}
+	}
+	for i := range ser.tasks {
+		extend(ser.tasks[i].tStart, ser.tasks[i].tEnd)
+	}
+	for i := range ser.milestones {
+		extend(ser.milestones[i].t, ser.milestones[i].t)
+	}
+	return
+}
+
+func (ser *GanttSeries) ConvertTtoN(tToN func(t time.Time) (n float64)) {
+	for i := range ser.tasks {
+		ser.tasks[i].nStart = tToN(ser.tasks[i].tStart)
+		ser.tasks[i].nEnd = tToN(ser.tasks[i].tEnd)
+	}
+	for i := range ser.milestones {
+		ser.milestones[i].n = tToN(ser.milestones[i].t)
+	}
+}
+
+func (ser *GanttSeries) task(name string) (task *ganttTask) {
+	for i := range ser.tasks {
+		if ser.tasks[i].name == name {
+			task = ser.tasks[i]
+			return
+		}
+	}
+	return
+}
+
+func (ser *GanttSeries) CartesianRects(xMin float64, xMax float64, yMin float64,
+	yMax float64) (rs []renderer.CartesianRect) {
+	h := ser.barHeight / 2
+	for i := range ser.tasks {
+		t := ser.tasks[i]
+		if t.nEnd < xMin || t.nStart > xMax || t.nRow-h < yMin || t.nRow+h > yMax {
+			continue
+		}
+		rs = append(rs, renderer.CartesianRect{
+			X1:   math.Max(t.nStart, xMin),
+			Y1:   t.nRow - h,
+			X2:   math.Min(t.nEnd, xMax),
+			Y2:   t.nRow + h,
+			Rect: t.bar,
+		})
+	}
+	return
+}
+
+func (ser *GanttSeries) CartesianNodes(xMin float64, xMax float64, yMin float64,
+	yMax float64) (ns []renderer.CartesianNode) {
+	for i := range ser.milestones {
+		ms := ser.milestones[i]
+		if ms.n < xMin || ms.n > xMax || ms.nRow < yMin || ms.nRow > yMax {
+			continue
+		}
+		ns = append(ns, renderer.CartesianNode{X: ms.n, Y: ms.nRow, Dot: ms.dot})
+	}
+	return
+}
+
+func (ser *GanttSeries) CartesianTexts(xMin float64, xMax float64, yMin float64,
+	yMax float64) (ts []renderer.CartesianText) {
+	for i := range ser.tasks {
+		t := ser.tasks[i]
+		if t.label.Text == "" || t.nEnd < xMin || t.nStart > xMax || t.nRow < yMin || t.nRow > yMax {
+			continue
+		}
+		ts = append(ts, renderer.CartesianText{
+			X:    (math.Max(t.nStart, xMin) + math.Min(t.nEnd, xMax)) / 2,
+			Y:    t.nRow,
+			Text: t.label,
+		})
+	}
+	for i := range ser.milestones {
+		ms := ser.milestones[i]
+		y := ms.nRow + (ser.barHeight / 2) + 0.1
+		if ms.label.Text == "" || ms.n < xMin || ms.n > xMax || y > yMax || ms.nRow < yMin {
+			continue
+		}
+		ts = append(ts, renderer.CartesianText{X: ms.n, Y: y, Text: ms.label})
+	}
+	return
+}
+
+// CartesianEdges gives the dependency arrows; each arrow runs from the end of the first task vertically to the row
+// of the second task and then horizontally to its start
+func (ser *GanttSeries) CartesianEdges(xMin float64, xMax float64, yMin float64,
+	yMax float64) (es []renderer.CartesianEdge) {
+	headLength := 0.01 * (xMax - xMin)
+	headWidth := 0.15
+	h := ser.barHeight / 2
+	for i := range ser.deps {
+		from := ser.task(ser.deps[i].from)
+		to := ser.task(ser.deps[i].to)
+		if from == nil || to == nil {
+			continue
+		}
+		x1 := from.nEnd
+		y1 := from.nRow - h
+		if to.nRow > from.nRow {
+			y1 = from.nRow + h
+		}
+		x2 := to.nStart
+		y2 := to.nRow
+		if math.Min(x1, x2)-headLength < xMin || math.Max(x1, x2) > xMax ||
+			math.Min(y1, y2)-headWidth < yMin || math.Max(y1, y2)+headWidth > yMax {
+			continue
+		}
+		dir := 1.0
+		if x2 < x1 {
+			dir = -1.0
+		}
+		l := ser.deps[i].lines
+		es = append(es, renderer.CartesianEdge{X1: x1, Y1: y1, X2: x1, Y2: y2, Line: l[0]},
+			renderer.CartesianEdge{X1: x1, Y1: y2, X2: x2, Y2: y2, Line: l[1]},
+			renderer.CartesianEdge{X1: x2 - (dir * headLength), Y1: y2 + headWidth, X2: x2, Y2: y2, Line: l[2]},
+			renderer.CartesianEdge{X1: x2 - (dir * headLength), Y1: y2 - headWidth, X2: x2, Y2: y2, Line: l[3]})
+	}
+	return
+}
+
+func (ser *GanttSeries) RefreshTheme() {
+	ser.col = theme.Color(ser.colName)
+	for i := range ser.tasks {
+		ser.tasks[i].bar.FillColor = ser.col
+		ser.tasks[i].label.Color = theme.Color(theme.ColorNameBackground)
+	}
+	for i := range ser.milestones {
+		ser.milestones[i].dot.FillColor = ser.col
+		ser.milestones[i].label.Color = theme.Color(theme.ColorNameForeground)
+	}
+	for i := range ser.deps {
+		for j := range ser.deps[i].lines {
+			ser.deps[i].lines[j].StrokeColor = theme.Color(theme.ColorNameForeground)
+		}
+	}
+}
+
+// Show makes all elements of the series visible
+func (ser *GanttSeries) Show() {
+	ser.visible = true
+	for i := range ser.tasks {
+		ser.tasks[i].show()
+	}
+	for i := range ser.milestones {
+		ser.milestones[i].show()
+	}
+	for i := range ser.deps {
+		ser.deps[i].show()
+	}
+	ser.legendEntry.Show()
+}
+
+// Hide hides all elements of the series
+func (ser *GanttSeries) Hide() {
+	ser.visible = false
+	for i := range ser.tasks {
+		ser.tasks[i].hide()
+	}
+	for i := range ser.milestones {
+		ser.milestones[i].hide()
+	}
+	for i := range ser.deps {
+		ser.deps[i].hide()
+	}
+	ser.legendEntry.Hide()
+}
+
+func (ser *GanttSeries) toggleView() {
+	if ser.visible {
+		ser.Hide()
+	} else {
+		ser.Show()
+	}
+}
+
+func (ser *GanttSeries) SetColor(colName fyne.ThemeColorName) {
+	ser.colName = colName
+	ser.legendEntry.SetColor(colName)
+	ser.RefreshTheme()
+	for i := range ser.tasks {
+		ser.tasks[i].bar.Refresh()
+	}
+	for i := range ser.milestones {
+		ser.milestones[i].dot.Refresh()
+	}
+}
+
+func (ser *GanttSeries) SetLineWidth(lw float32) {
+	if lw < 0 {
+		return
+	}
+	for i := range ser.deps {
+		for j := range ser.deps[i].lines {
+			ser.deps[i].lines[j].StrokeWidth = lw
+			ser.deps[i].lines[j].Refresh()
+		}
+	}
+}
+
+func (ser *GanttSeries) BindToChart(ch container) (err error) {
+	if ch.IsPolar() {
+		err = errors.New("gantt series can only be added to cartesian charts")
+		return
+	}
+	err = ser.baseSeries.BindToChart(ch)
+	return
+}
+
+func (ser *GanttSeries) Clear() {
+	ser.mu.Lock()
+	ser.tasks = []*ganttTask{}
+	ser.milestones = []*ganttMilestone{}
+	ser.deps = []*ganttDependency{}
+	ser.mu.Unlock()
+	ser.dataChanged()
+}
+
+// DeleteTasks deletes all tasks and milestones with one of the given names
+// The return value gives the number of elements that have been removed
+func (ser *GanttSeries) DeleteTasks(names []string) (c int) {
+	del := func(n string) (b bool) {
+		for i := range names {
+			if names[i] == n {
+				b = true
+				return
+			}
+		}
+		return
+	}
+	ser.mu.Lock()
+	finalTasks := []*ganttTask{}
+	for i := range ser.tasks {
+		if del(ser.tasks[i].name) {
+			c++
+		} else {
+			finalTasks = append(finalTasks, ser.tasks[i])
+		}
+	}
+	finalMilestones := []*ganttMilestone{}
+	for i := range ser.milestones {
+		if del(ser.milestones[i].name) {
+			c++
+		} else {
+			finalMilestones = append(finalMilestones, ser.milestones[i])
+		}
+	}
+	if c == 0 {
+		ser.mu.Unlock()
+		return
+	}
+	ser.tasks = finalTasks
+	ser.milestones = finalMilestones
+	ser.mu.Unlock()
+	ser.dataChanged()
+	return
+}
+
+// AddTasks adds tasks to the series
+// Tasks with a name that already exists are ignored; an error is returned if TEnd is before TStart
+func (ser *GanttSeries) AddTasks(input []data.GanttTask) (err error) {
+	if len(input) == 0 {
+		return
+	}
+	for i := range input {
+		if input[i].TEnd.Before(input[i].TStart) {
+			err = errors.New("invalid data, end before start")
+			return
+		}
+	}
+	ser.mu.Lock()
+	for i := range input {
+		if input[i].Name != "" && ser.task(input[i].Name) != nil {
+			continue
+		}
+		task := emptyGanttTask(ser.col)
+		task.row = input[i].Row
+		task.name = input[i].Name
+		task.tStart = input[i].TStart
+		task.tEnd = input[i].TEnd
+		task.label.Text = input[i].Name
+		if !ser.visible {
+			task.hide()
+		}
+		ser.tasks = append(ser.tasks, task)
+	}
+	ser.mu.Unlock()
+	ser.dataChanged()
+	return
+}
+
+// AddMilestones adds milestones to the series
+func (ser *GanttSeries) AddMilestones(input []data.GanttMilestone) (err error) {
+	if len(input) == 0 {
+		return
+	}
+	ser.mu.Lock()
+	for i := range input {
+		ms := emptyGanttMilestone(ser.col)
+		ms.row = input[i].Row
+		ms.name = input[i].Name
+		ms.t = input[i].T
+		ms.label.Text = input[i].Name
+		if !ser.visible {
+			ms.hide()
+		}
+		ser.milestones = append(ser.milestones, ms)
+	}
+	ser.mu.Unlock()
+	ser.dataChanged()
+	return
+}
+
+// AddDependencies adds dependency arrows between tasks
+// An error is returned if From equals To; dependencies referencing unknown tasks are not drawn
+func (ser *GanttSeries) AddDependencies(input []data.GanttDependency) (err error) {
+	for i := range input {
+		if input[i].From == input[i].To {
+			err = errors.New("invalid dependency")
+			return
+		}
+	}
+	ser.mu.Lock()
+	for i := range input {
+		dep := emptyGanttDependency()
+		dep.from = input[i].From
+		dep.to = input[i].To
+		if !ser.visible {
+			dep.hide()
+		}
+		ser.deps = append(ser.deps, dep)
+	}
+	ser.mu.Unlock()
+	ser.dataChanged()
+	return
+}
+
+// Tasks gives the tasks that overlap the range from nMin to nMax; the series must be locked
+func (ser *GanttSeries) Tasks(nMin float64, nMax float64) (ts []data.GanttTask) {
+	for _, task := range ser.tasks {
+		if task.nEnd >= nMin && task.nStart <= nMax {
+			ts = append(ts, data.GanttTask{Row: task.row, Name: task.name, TStart: task.tStart, TEnd: task.tEnd})
+		}
+	}
+	return
+}
+
+// Milestones gives the milestones with n between nMin and nMax; the series must be locked
+func (ser *GanttSeries) Milestones(nMin float64, nMax float64) (ms []data.GanttMilestone) {
+	for _, m := range ser.milestones {
+		if inNRange(m.n, nMin, nMax) {
+			ms = append(ms, data.GanttMilestone{Row: m.row, Name: m.name, T: m.t})
+		}
+	}
+	return
+}
+
+// Dependencies gives the dependencies between tasks; the series must be locked
+func (ser *GanttSeries) Dependencies() (ds []data.GanttDependency) {
+	for _, dep := range ser.deps {
+		ds = append(ds, data.GanttDependency{From: dep.from, To: dep.to})
+	}
+	return
+}
@@ -0,0 +1,57 @@
+package series
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+func TestGanttRowsAndRange(t *testing.T) {
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var tests = []struct {
+		tasks      []data.GanttTask
+		milestones []data.GanttMilestone
+		expErr     bool
+		expRows    []string
+		expMin     time.Time
+		expMax     time.Time
+	}{
+		{[]data.GanttTask{{Row: "a", Name: "1", TStart: t0, TEnd: t0.Add(time.Hour)}},
+			nil, false, []string{"a"}, t0, t0.Add(time.Hour)},
+		{[]data.GanttTask{{Row: "b", Name: "1", TStart: t0, TEnd: t0.Add(time.Hour)},
+			{Row: "a", Name: "2", TStart: t0.Add(-time.Hour), TEnd: t0},
+			{Row: "b", Name: "3", TStart: t0, TEnd: t0.Add(2 * time.Hour)}},
+			[]data.GanttMilestone{{Row: "c", Name: "m", T: t0.Add(3 * time.Hour)}},
+			false, []string{"b", "a", "c"}, t0.Add(-time.Hour), t0.Add(3 * time.Hour)},
+		{[]data.GanttTask{{Row: "a", Name: "1", TStart: t0, TEnd: t0.Add(-time.Hour)}},
+			nil, true, nil, time.Time{}, time.Time{}},
+	}
+	for i, tt := range tests {
+		gs := EmptyGanttSeries("test", theme.ColorNamePrimary)
+		err := gs.AddTasks(tt.tasks)
+		if (err != nil) != tt.expErr {
+			t.Errorf("wrong error, set %d", i)
+			continue
+		}
+		if tt.expErr {
+			continue
+		}
+		gs.AddMilestones(tt.milestones)
+		rows := gs.Rows()
+		if len(rows) != len(tt.expRows) {
+			t.Errorf("wrong number of rows, set %d", i)
+			continue
+		}
+		for j := range rows {
+			if rows[j] != tt.expRows[j] {
+				t.Errorf("wrong row order, set %d, exp %s, have %s", i, tt.expRows[j], rows[j])
+			}
+		}
+		_, min, max := gs.TRange()
+		if !min.Equal(tt.expMin) || !max.Equal(tt.expMax) {
+			t.Errorf("wrong range, set %d", i)
+		}
+	}
+}
@@ -0,0 +1,659 @@
+package series
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+	"sort"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+// IndicatorInput is one input value for the calculation of a technical indicator
+type IndicatorInput struct {
+	T      time.Time
+	Close  float64
+	High   float64
+	Low    float64
+	Volume float64
+}
+
+// IndicatorSource is a series whose data can be used to calculate a technical indicator
+type IndicatorSource interface {
+	IndicatorInput() (in []IndicatorInput)
+	AddDataListener(id string, f func())
+	RemoveDataListener(id string)
+	chart() (ch container)
+}
+
+// IndicatorInput returns the data points of the series sorted by t
+// All points have the same weight (volume of 1)
+func (ser *PointSeries) IndicatorInput() (in []IndicatorInput) {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
+	for i := range ser.data {
+		in = append(in, IndicatorInput{
+			T:      ser.data[i].t,
+			Close:  ser.data[i].val,
+			High:   ser.data[i].val,
+			Low:    ser.data[i].val,
+			Volume: 1,
+		})
+	}
+	sort.SliceStable(in, func(i, j int) bool { return in[i].T.Before(in[j].T) })
+	return
+}
+
+// IndicatorInput returns the candles of the series sorted by t
+// The t of each input is the center of the candle
+func (ser *CandleStickSeries) IndicatorInput() (in []IndicatorInput) {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
+	for i := range ser.data {
+		in = append(in, IndicatorInput{
+			T:      ser.data[i].tStart.Add(ser.data[i].tEnd.Sub(ser.data[i].tStart) / 2),
+			Close:  ser.data[i].close,
+			High:   ser.data[i].high,
+			Low:    ser.data[i].low,
+			Volume: ser.data[i].volume,
+		})
+	}
+	sort.SliceStable(in, func(i, j int) bool { return in[i].T.Before(in[j].T) })
+	return
+}
+
+type IndicatorType string
+
+const (
+	IndicatorSMA       IndicatorType = "SMA"
+	IndicatorEMA       IndicatorType = "EMA"
+	IndicatorBollinger IndicatorType = "Bollinger"
+	IndicatorVWAP      IndicatorType = "VWAP"
+	IndicatorRSI       IndicatorType = "RSI"
+	IndicatorMACD      IndicatorType = "MACD"
+)
+
+// IndicatorSeries is a series that is derived from the data of another series.
+// It is recalculated every time the data of the source series changes.
+type IndicatorSeries struct {
+	baseSeries
+	typ       IndicatorType
+	src       IndicatorSource
+	periods   []int
+	k         float64
+	lines     []*PointSeries
+	showBand  bool
+	lineWidth float32
+}
+
+func emptyIndicatorSeries(name string, colName fyne.ThemeColorName, typ IndicatorType,
+	src IndicatorSource, periods []int, nLines int) (ser *IndicatorSeries, err error) {
+	if src == nil {
+		err = errors.New("no source series")
+		return
+	}
+	for i := range periods {
+		if periods[i] < 1 {
+			err = errors.New("invalid period")
+			return
+		}
+	}
+	ser = &IndicatorSeries{
+		typ:       typ,
+		src:       src,
+		periods:   periods,
+		lineWidth: 1,
+	}
+	ser.baseSeries = emptyBaseSeries(name, colName, ser.toggleView)
+	for range nLines {
+		line := EmptyPointSeries(name, colName)
+		line.MakeLine(false)
+		ser.lines = append(ser.lines, line)
+	}
+	return
+}
+
+// EmptySMASeries creates a simple moving average over period values of src
+func EmptySMASeries(name string, colName fyne.ThemeColorName, src IndicatorSource,
+	period int) (ser *IndicatorSeries, err error) {
+	ser, err = emptyIndicatorSeries(name, colName, IndicatorSMA, src, []int{period}, 1)
+	if err != nil {
+		return
+	}
+	ser.subscribe()
+	return
+}
+
+// EmptyEMASeries creates an exponential moving average over period values of src
+func EmptyEMASeries(name string, colName fyne.ThemeColorName, src IndicatorSource,
+	period int) (ser *IndicatorSeries, err error) {
+	ser, err = emptyIndicatorSeries(name, colName, IndicatorEMA, src, []int{period}, 1)
+	if err != nil {
+		return
+	}
+	ser.subscribe()
+	return
+}
+
+// EmptyBollingerSeries creates Bollinger bands around the simple moving average over period values of src
+// The bands are k standard deviations away from the moving average
+func EmptyBollingerSeries(name string, colName fyne.ThemeColorName, src IndicatorSource,
+	period int, k float64) (ser *IndicatorSeries, err error) {
+	if k < 0 {
+		err = errors.New("invalid number of standard deviations")
+		return
+	}
+	ser, err = emptyIndicatorSeries(name, colName, IndicatorBollinger, src, []int{period}, 3)
+	if err != nil {
+		return
+	}
+	ser.k = k
+	ser.showBand = true
+	ser.subscribe()
+	return
+}
+
+// EmptyVWAPSeries creates the volume weighted average price of src
+func EmptyVWAPSeries(name string, colName fyne.ThemeColorName,
+	src IndicatorSource) (ser *IndicatorSeries, err error) {
+	ser, err = emptyIndicatorSeries(name, colName, IndicatorVWAP, src, []int{}, 1)
+	if err != nil {
+		return
+	}
+	ser.subscribe()
+	return
+}
+
+// EmptyRSISeries creates the relative strength index over period values of src
+func EmptyRSISeries(name string, colName fyne.ThemeColorName, src IndicatorSource,
+	period int) (ser *IndicatorSeries, err error) {
+	ser, err = emptyIndicatorSeries(name, colName, IndicatorRSI, src, []int{period}, 1)
+	if err != nil {
+		return
+	}
+	ser.subscribe()
+	return
+}
+
+// EmptyMACDSeries creates the moving average convergence/divergence of src
+// The MACD line and the histogram use colName, the signal line uses signalColName
+func EmptyMACDSeries(name string, colName fyne.ThemeColorName, signalColName fyne.ThemeColorName,
+	src IndicatorSource, fast int, slow int, signal int) (ser *IndicatorSeries, err error) {
+	if fast >= slow {
+		err = errors.New("fast period must be smaller than slow period")
+		return
+	}
+	ser, err = emptyIndicatorSeries(name, colName, IndicatorMACD, src, []int{fast, slow, signal}, 3)
+	if err != nil {
+		return
+	}
+	ser.lines[1].SetColor(signalColName)
+	ser.lines[2] = EmptyPointSeries(name, colName)
+	ser.lines[2].MakeBar()
+	ser.subscribe()
+	return
+}
+
+func (ser *IndicatorSeries) subscribe() {
+	ser.src.AddDataListener(fmt.Sprintf("%p", ser), ser.update)
+	ser.update()
+}
+
+// Release removes the series from its chart; it is no longer recalculated when the data of the source changes
+func (ser *IndicatorSeries) Release() {
+	ser.src.RemoveDataListener(fmt.Sprintf("%p", ser))
+	ser.baseSeries.Release()
+}
+
+// Lock locks the indicator series and its lines
+func (ser *IndicatorSeries) Lock() {
+	ser.mu.Lock()
+	for i := range ser.lines {
+		ser.lines[i].Lock()
+	}
+}
+
+// Unlock unlocks the lines and the indicator series
+func (ser *IndicatorSeries) Unlock() {
+	for i := len(ser.lines) - 1; i >= 0; i-- {
+		ser.lines[i].Unlock()
+	}
+	ser.mu.Unlock()
+}
+
+// update recalculates the indicator from the current data of the source series
+func (ser *IndicatorSeries) update() {
+	outs := ser.calculate(ser.src.IndicatorInput())
+	for i := range ser.lines {
+		ser.lines[i].Lock()
+		ser.lines[i].data = nil
+		ser.lines[i].sampleDirty = true
+		ser.lines[i].Unlock()
+		if i < len(outs) {
+			ser.lines[i].AddTemporalData(outs[i])
+		}
+		if ser.lines[i].IsBarSeries() {
+			ser.lines[i].SetTemporalBarWidth(minSpacing(outs[i]) * 8 / 10)
+		}
+		ser.lines[i].SetLineWidth(ser.lineWidth)
+		if !ser.visible {
+			ser.lines[i].Hide()
+		}
+	}
+	if cont := ser.chart(); cont != nil && cont != ser.src.chart() {
+		// if source and indicator share the chart, the source triggers the update of the chart
+		cont.DataChange()
+	}
+}
+
+func (ser *IndicatorSeries) calculate(in []IndicatorInput) (outs [][]data.TemporalPoint) {
+	closes := make([]float64, len(in))
+	for i := range in {
+		closes[i] = in[i].Close
+	}
+	switch ser.typ {
+	case IndicatorSMA:
+		outs = append(outs, toTemporalPoints(in, simpleMovingAverage(closes, ser.periods[0])))
+	case IndicatorEMA:
+		outs = append(outs, toTemporalPoints(in, exponentialMovingAverage(closes, ser.periods[0])))
+	case IndicatorBollinger:
+		mid, upper, lower := bollingerBands(closes, ser.periods[0], ser.k)
+		outs = append(outs, toTemporalPoints(in, mid), toTemporalPoints(in, upper),
+			toTemporalPoints(in, lower))
+	case IndicatorVWAP:
+		outs = append(outs, toTemporalPoints(in, volumeWeightedAveragePrice(in)))
+	case IndicatorRSI:
+		outs = append(outs, toTemporalPoints(in, relativeStrengthIndex(closes, ser.periods[0])))
+	case IndicatorMACD:
+		macd, signal, hist := movingAverageConvergenceDivergence(closes, ser.periods[0],
+			ser.periods[1], ser.periods[2])
+		outs = append(outs, toTemporalPoints(in, macd), toTemporalPoints(in, signal),
+			toTemporalPoints(in, hist))
+	}
+	return
+}
+
+func (ser *IndicatorSeries) TRange() (isEmpty bool, min time.Time, max time.Time) {
+	isEmpty = true
+	for i := range ser.lines {
+		lEmpty, lMin, lMax := ser.lines[i].TRange()
+		if lEmpty {
+			continue
+		}
+		if isEmpty {
+			isEmpty = false
+			min = lMin
+			max = lMax
+			continue
+		}
+		if lMin.Before(min) {
+			min = lMin
+		}
+		if lMax.After(max) {
+			max = lMax
+		}
+	}
+	return
+}
+
+func (ser *IndicatorSeries) ValRange() (isEmpty bool, min float64, max float64) {
+	isEmpty = true
+	for i := range ser.lines {
+		lEmpty, lMin, lMax := ser.lines[i].ValRange()
+		if lEmpty {
+			continue
+		}
+		if isEmpty {
+			isEmpty = false
+			min = lMin
+			max = lMax
+			continue
+		}
+		if lMin < min {
+			min = lMin
+		}
+		if lMax > max {
+			max = lMax
+		}
+	}
+	return
+}
+
+func (ser *IndicatorSeries) ConvertTtoN(tToN func(t time.Time) (n float64)) {
+	for i := range ser.lines {
+		ser.lines[i].ConvertTtoN(tToN)
+	}
+}
+
+func (ser *IndicatorSeries) CartesianNodes(xMin float64, xMax float64, yMin float64,
+	yMax float64) (ns []renderer.CartesianNode) {
+	for i := range ser.lines {
+		ns = append(ns, ser.lines[i].CartesianNodes(xMin, xMax, yMin, yMax)...)
+	}
+	return
+}
+
+func (ser *IndicatorSeries) CartesianEdges(xMin float64, xMax float64, yMin float64,
+	yMax float64) (es []renderer.CartesianEdge) {
+	for i := range ser.lines {
+		es = append(es, ser.lines[i].CartesianEdges(xMin, xMax, yMin, yMax)...)
+	}
+	return
+}
+
+func (ser *IndicatorSeries) CartesianRects(xMin float64, xMax float64, yMin float64,
+	yMax float64) (fs []renderer.CartesianRect) {
+	for i := range ser.lines {
+		fs = append(fs, ser.lines[i].CartesianRects(xMin, xMax, yMin, yMax)...)
+	}
+	return
+}
+
+func (ser *IndicatorSeries) RasterColorCartesian(x float64, y float64) (col color.Color) {
+	col = ser.baseSeries.RasterColorCartesian(x, y)
+	if !ser.visible || !ser.showBand {
+		return
+	}
+	upper, okUpper := ser.lines[1].interpolate(x)
+	lower, okLower := ser.lines[2].interpolate(x)
+	if !okUpper || !okLower || y < lower || y > upper {
+		return
+	}
+	r, g, b, _ := ser.col.RGBA()
+	col = color.RGBA64{R: uint16(r), G: uint16(g), B: uint16(b), A: 0x4444}
+	return
+}
+
+// RasterPolygons gives the band between the upper and the lower line where both are defined
+func (ser *IndicatorSeries) RasterPolygons() (ps []renderer.Polygon) {
+	upper, lower := ser.lines[1].data, ser.lines[2].data
+	if !ser.visible || !ser.showBand || len(upper) < 2 || len(lower) < 2 {
+		return
+	}
+	var xs, ys []float64
+	for _, point := range upper {
+		xs = append(xs, point.n)
+		ys = append(ys, point.val)
+	}
+	for i := len(lower) - 1; i >= 0; i-- {
+		xs = append(xs, lower[i].n)
+		ys = append(ys, lower[i].val)
+	}
+	xMin := math.Max(upper[0].n, lower[0].n)
+	xMax := math.Min(upper[len(upper)-1].n, lower[len(lower)-1].n)
+	xs, ys = renderer.ClipPolygon(xs, ys, xMin, xMax, math.Inf(-1), math.Inf(1))
+	if len(xs) > 0 {
+		ps = append(ps, renderer.Polygon{X: xs, Y: ys, Col: renderer.FillColor(ser.col, 0x44)})
+	}
+	return
+}
+
+func (ser *IndicatorSeries) IsPartOfChartRaster() (b bool) {
+	b = ser.showBand && ser.visible && ser.cont != nil && !ser.cont.IsPolar()
+	return
+}
+
+func (ser *IndicatorSeries) RefreshTheme() {
+	ser.col = theme.Color(ser.colName)
+	for i := range ser.lines {
+		ser.lines[i].RefreshTheme()
+	}
+}
+
+func (ser *IndicatorSeries) BindToChart(ch container) (err error) {
+	if ch.IsPolar() {
+		err = errors.New("indicator series can not be added to polar charts")
+		return
+	}
+	err = ser.baseSeries.BindToChart(ch)
+	if err != nil {
+		return
+	}
+	// an indicator that has been released before follows its source series again
+	ser.subscribe()
+	return
+}
+
+// Show makes all elements of the series visible
+func (ser *IndicatorSeries) Show() {
+	ser.visible = true
+	for i := range ser.lines {
+		ser.lines[i].Show()
+	}
+	ser.legendEntry.Show()
+}
+
+// Hide hides all elements of the series
+func (ser *IndicatorSeries) Hide() {
+	ser.visible = false
+	for i := range ser.lines {
+		ser.lines[i].Hide()
+	}
+	ser.legendEntry.Hide()
+}
+
+func (ser *IndicatorSeries) toggleView() {
+	if ser.visible {
+		ser.Hide()
+	} else {
+		ser.Show()
+	}
+	if ser.showBand && ser.cont != nil {
+		ser.cont.RasterRefresh()
+	}
+}
+
+// SetColor changes the color of the series
+// The signal line of a MACD keeps its own color
+func (ser *IndicatorSeries) SetColor(colName fyne.ThemeColorName) {
+	ser.colName = colName
+	ser.col = theme.Color(colName)
+	ser.legendEntry.SetColor(colName)
+	for i := range ser.lines {
+		if ser.typ == IndicatorMACD && i == 1 {
+			continue
+		}
+		ser.lines[i].SetColor(colName)
+	}
+	if ser.showBand && ser.cont != nil {
+		ser.cont.RasterRefresh()
+	}
+}
+
+func (ser *IndicatorSeries) SetLineWidth(lw float32) {
+	if lw < 0 {
+		return
+	}
+	ser.lineWidth = lw
+	for i := range ser.lines {
+		ser.lines[i].SetLineWidth(lw)
+	}
+}
+
+// interpolate gives the val of the line at n
+// ok is false if n is outside of the range of the line
+func (ser *PointSeries) interpolate(n float64) (val float64, ok bool) {
+	for i := 1; i < len(ser.data); i++ {
+		if ser.data[i].n < n {
+			continue
+		}
+		n1 := ser.data[i-1].n
+		n2 := ser.data[i].n
+		if n < n1 {
+			return
+		}
+		val = ser.data[i].val
+		if n2 > n1 {
+			val = ser.data[i-1].val + ((n - n1) / (n2 - n1) * (ser.data[i].val - ser.data[i-1].val))
+		}
+		ok = true
+		return
+	}
+	return
+}
+
+func toTemporalPoints(in []IndicatorInput, vals []float64) (out []data.TemporalPoint) {
+	for i := range vals {
+		if math.IsNaN(vals[i]) {
+			continue
+		}
+		out = append(out, data.TemporalPoint{T: in[i].T, Val: vals[i]})
+	}
+	return
+}
+
+func minSpacing(tps []data.TemporalPoint) (d time.Duration) {
+	for i := 1; i < len(tps); i++ {
+		s := tps[i].T.Sub(tps[i-1].T)
+		if s > 0 && (d == 0 || s < d) {
+			d = s
+		}
+	}
+	return
+}
+
+func nanSlice(n int) (vals []float64) {
+	vals = make([]float64, n)
+	for i := range vals {
+		vals[i] = math.NaN()
+	}
+	return
+}
+
+// simpleMovingAverage calculates the average of the last period values
+// The first period-1 values are NaN
+func simpleMovingAverage(vals []float64, period int) (avg []float64) {
+	avg = nanSlice(len(vals))
+	sum := 0.0
+	for i := range vals {
+		sum += vals[i]
+		if i >= period {
+			sum -= vals[i-period]
+		}
+		if i >= period-1 {
+			avg[i] = sum / float64(period)
+		}
+	}
+	return
+}
+
+// exponentialMovingAverage calculates the exponential moving average with a smoothing factor of 2/(period+1)
+// Leading NaN values are skipped; the average is seeded with the simple average of the first period values
+func exponentialMovingAverage(vals []float64, period int) (avg []float64) {
+	avg = nanSlice(len(vals))
+	first := 0
+	for first < len(vals) && math.IsNaN(vals[first]) {
+		first++
+	}
+	if len(vals)-first < period {
+		return
+	}
+	alpha := 2.0 / float64(period+1)
+	sum := 0.0
+	for i := first; i < first+period; i++ {
+		sum += vals[i]
+	}
+	prev := sum / float64(period)
+	avg[first+period-1] = prev
+	for i := first + period; i < len(vals); i++ {
+		prev = (alpha * vals[i]) + ((1 - alpha) * prev)
+		avg[i] = prev
+	}
+	return
+}
+
+// bollingerBands calculates the simple moving average and the bands k standard deviations above and below
+func bollingerBands(vals []float64, period int, k float64) (mid []float64, upper []float64, lower []float64) {
+	mid = simpleMovingAverage(vals, period)
+	upper = nanSlice(len(vals))
+	lower = nanSlice(len(vals))
+	for i := period - 1; i < len(vals); i++ {
+		sq := 0.0
+		for j := i - period + 1; j <= i; j++ {
+			sq += math.Pow(vals[j]-mid[i], 2)
+		}
+		sd := math.Sqrt(sq / float64(period))
+		upper[i] = mid[i] + (k * sd)
+		lower[i] = mid[i] - (k * sd)
+	}
+	return
+}
+
+// volumeWeightedAveragePrice calculates the cumulative average of the typical price weighted by volume
+func volumeWeightedAveragePrice(in []IndicatorInput) (vwap []float64) {
+	vwap = nanSlice(len(in))
+	pv := 0.0
+	v := 0.0
+	for i := range in {
+		pv += ((in[i].High + in[i].Low + in[i].Close) / 3) * in[i].Volume
+		v += in[i].Volume
+		if v > 0 {
+			vwap[i] = pv / v
+		}
+	}
+	return
+}
+
+// relativeStrengthIndex calculates the relative strength index using Wilder's smoothing
+func relativeStrengthIndex(vals []float64, period int) (rsi []float64) {
+	rsi = nanSlice(len(vals))
+	if len(vals) <= period {
+		return
+	}
+	gain := 0.0
+	loss := 0.0
+	for i := 1; i < len(vals); i++ {
+		d := vals[i] - vals[i-1]
+		g := math.Max(d, 0)
+		l := math.Max(-d, 0)
+		if i <= period {
+			gain += g / float64(period)
+			loss += l / float64(period)
+			if i < period {
+				continue
+			}
+		} else {
+			gain = ((gain * float64(period-1)) + g) / float64(period)
+			loss = ((loss * float64(period-1)) + l) / float64(period)
+		}
+		switch {
+		case loss == 0 && gain == 0:
+			rsi[i] = 50
+		case loss == 0:
+			rsi[i] = 100
+		default:
+			rsi[i] = 100 - (100 / (1 + (gain / loss)))
+		}
+	}
+	return
+}
+
+// movingAverageConvergenceDivergence calculates the MACD line, its signal line and the histogram
+func movingAverageConvergenceDivergence(vals []float64, fast int, slow int,
+	signal int) (macd []float64, sig []float64, hist []float64) {
+	emaFast := exponentialMovingAverage(vals, fast)
+	emaSlow := exponentialMovingAverage(vals, slow)
+	macd = nanSlice(len(vals))
+	for i := range vals {
+		macd[i] = emaFast[i] - emaSlow[i]
+	}
+	sig = exponentialMovingAverage(macd, signal)
+	hist = nanSlice(len(vals))
+	for i := range vals {
+		hist[i] = macd[i] - sig[i]
+	}
+	return
+}
+
+// Lines gives the points of each line of the indicator with n between nMin and nMax; the series must be locked
+func (ser *IndicatorSeries) Lines(nMin float64, nMax float64) (lines [][]data.TemporalPoint) {
+	for i := range ser.lines {
+		lines = append(lines, ser.lines[i].TemporalData(nMin, nMax))
+	}
+	return
+}
@@ -0,0 +1,208 @@
+package series
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+func equalFloats(a []float64, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if math.IsNaN(a[i]) != math.IsNaN(b[i]) {
+			return false
+		}
+		if !math.IsNaN(a[i]) && math.Abs(a[i]-b[i]) > 0.000001 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestIndicatorMovingAverage(t *testing.T) {
+	nan := math.NaN()
+	var tests = []struct {
+		input  []float64
+		period int
+		expSMA []float64
+		expEMA []float64
+	}{
+		{[]float64{}, 3, []float64{}, []float64{}},
+		{[]float64{1, 2}, 3, []float64{nan, nan}, []float64{nan, nan}},
+		{[]float64{1, 2, 3, 4, 5}, 1, []float64{1, 2, 3, 4, 5}, []float64{1, 2, 3, 4, 5}},
+		{[]float64{1, 2, 3, 4, 5}, 3, []float64{nan, nan, 2, 3, 4}, []float64{nan, nan, 2, 3, 4}},
+		{[]float64{nan, 2, 4, 6, 2}, 3, []float64{nan, nan, nan, nan, nan}, []float64{nan, nan, nan, 4, 3}},
+	}
+	for i, tt := range tests {
+		sma := simpleMovingAverage(tt.input, tt.period)
+		if !equalFloats(sma, tt.expSMA) {
+			t.Errorf("wrong sma, set %d, exp %v, have %v", i, tt.expSMA, sma)
+		}
+		ema := exponentialMovingAverage(tt.input, tt.period)
+		if !equalFloats(ema, tt.expEMA) {
+			t.Errorf("wrong ema, set %d, exp %v, have %v", i, tt.expEMA, ema)
+		}
+	}
+}
+
+func TestIndicatorBollingerBands(t *testing.T) {
+	nan := math.NaN()
+	var tests = []struct {
+		input    []float64
+		period   int
+		k        float64
+		expMid   []float64
+		expUpper []float64
+		expLower []float64
+	}{
+		{[]float64{2, 2, 2}, 2, 2, []float64{nan, 2, 2}, []float64{nan, 2, 2}, []float64{nan, 2, 2}},
+		{[]float64{1, 3, 5}, 2, 2, []float64{nan, 2, 4}, []float64{nan, 4, 6}, []float64{nan, 0, 2}},
+	}
+	for i, tt := range tests {
+		mid, upper, lower := bollingerBands(tt.input, tt.period, tt.k)
+		if !equalFloats(mid, tt.expMid) || !equalFloats(upper, tt.expUpper) || !equalFloats(lower, tt.expLower) {
+			t.Errorf("wrong bands, set %d, have %v %v %v", i, mid, upper, lower)
+		}
+	}
+}
+
+func TestIndicatorVWAP(t *testing.T) {
+	nan := math.NaN()
+	var tests = []struct {
+		input   []IndicatorInput
+		expVWAP []float64
+	}{
+		{[]IndicatorInput{{Close: 3, High: 3, Low: 3, Volume: 0}}, []float64{nan}},
+		{[]IndicatorInput{{Close: 2, High: 3, Low: 1, Volume: 1}, {Close: 5, High: 5, Low: 5, Volume: 3}},
+			[]float64{2, 4.25}},
+	}
+	for i, tt := range tests {
+		vwap := volumeWeightedAveragePrice(tt.input)
+		if !equalFloats(vwap, tt.expVWAP) {
+			t.Errorf("wrong vwap, set %d, exp %v, have %v", i, tt.expVWAP, vwap)
+		}
+	}
+}
+
+func TestIndicatorRSI(t *testing.T) {
+	nan := math.NaN()
+	var tests = []struct {
+		input  []float64
+		period int
+		expRSI []float64
+	}{
+		{[]float64{1, 2}, 2, []float64{nan, nan}},
+		{[]float64{1, 2, 3, 4}, 2, []float64{nan, nan, 100, 100}},
+		{[]float64{4, 3, 2}, 2, []float64{nan, nan, 0}},
+		{[]float64{1, 1, 1}, 2, []float64{nan, nan, 50}},
+		{[]float64{1, 3, 2, 2}, 2, []float64{nan, nan, 200.0 / 3, 200.0 / 3}},
+	}
+	for i, tt := range tests {
+		rsi := relativeStrengthIndex(tt.input, tt.period)
+		if !equalFloats(rsi, tt.expRSI) {
+			t.Errorf("wrong rsi, set %d, exp %v, have %v", i, tt.expRSI, rsi)
+		}
+	}
+}
+
+func TestIndicatorMACD(t *testing.T) {
+	input := []float64{1, 2, 3, 4, 5, 6, 7, 8}
+	macd, sig, hist := movingAverageConvergenceDivergence(input, 2, 4, 2)
+	for i := range input {
+		if i < 3 && !math.IsNaN(macd[i]) {
+			t.Errorf("macd defined before slow period, index %d", i)
+		}
+		if i >= 3 && math.Abs(macd[i]-1) > 0.000001 {
+			t.Errorf("wrong macd of linear input, index %d, have %f", i, macd[i])
+		}
+		if i < 4 && !math.IsNaN(sig[i]) {
+			t.Errorf("signal defined before signal period, index %d", i)
+		}
+		if i >= 4 && math.Abs(hist[i]) > 0.000001 {
+			t.Errorf("wrong histogram of linear input, index %d, have %f", i, hist[i])
+		}
+	}
+}
+
+func TestIndicatorUpdate(t *testing.T) {
+	test.NewTempApp(t)
+	now := time.Now()
+	src := EmptyPointSeries("source", theme.ColorNamePrimary)
+	ind, err := EmptySMASeries("sma", theme.ColorNamePrimary, src, 2)
+	if err != nil {
+		t.Fatalf("creating indicator failed, %s", err.Error())
+	}
+	if isEmpty, _, _ := ind.ValRange(); !isEmpty {
+		t.Errorf("indicator of empty source not empty")
+	}
+	err = src.AddTemporalData([]data.TemporalPoint{
+		{T: now, Val: 1},
+		{T: now.Add(time.Minute), Val: 3},
+		{T: now.Add(2 * time.Minute), Val: 5},
+	})
+	if err != nil {
+		t.Fatalf("adding data failed, %s", err.Error())
+	}
+	err = testValRange(ind, false, 2, 4)
+	if err != nil {
+		t.Errorf("wrong Val range after adding data, %s", err.Error())
+	}
+	err = testTRange(ind, false, now.Add(time.Minute), now.Add(2*time.Minute))
+	if err != nil {
+		t.Errorf("wrong T range after adding data, %s", err.Error())
+	}
+	src.Clear()
+	if isEmpty, _, _ := ind.ValRange(); !isEmpty {
+		t.Errorf("indicator not empty after clearing source")
+	}
+	err = ind.BindToChart(chartDummy{})
+	if err != nil {
+		t.Fatalf("binding indicator failed, %s", err.Error())
+	}
+	ind.Release()
+	err = src.AddTemporalData([]data.TemporalPoint{{T: now, Val: 1}, {T: now.Add(time.Minute), Val: 3}})
+	if err != nil {
+		t.Fatalf("adding data failed, %s", err.Error())
+	}
+	if isEmpty, _, _ := ind.ValRange(); !isEmpty {
+		t.Errorf("released indicator recalculated after source change")
+	}
+	_, err = EmptySMASeries("sma", theme.ColorNamePrimary, src, 0)
+	if err == nil {
+		t.Errorf("creating indicator with invalid period succeeded")
+	}
+	_, err = EmptyMACDSeries("macd", theme.ColorNamePrimary, theme.ColorNameError, src, 4, 2, 2)
+	if err == nil {
+		t.Errorf("creating macd with fast period not smaller than slow period succeeded")
+	}
+}
+
+func TestIndicatorConcurrentSubscription(t *testing.T) {
+	test.NewTempApp(t)
+	now := time.Now()
+	src := EmptyPointSeries("source", theme.ColorNamePrimary)
+	done := make(chan bool)
+	go func() {
+		// indicators subscribe to the source and unsubscribe while its data changes
+		for i := range 100 {
+			id := fmt.Sprintf("listener %d", i)
+			src.AddDataListener(id, func() {})
+			src.RemoveDataListener(id)
+		}
+		done <- true
+	}()
+	for i := range 100 {
+		err := src.AddTemporalData([]data.TemporalPoint{{T: now.Add(time.Duration(i) * time.Minute), Val: 1}})
+		if err != nil {
+			t.Fatalf("adding data failed, %s", err.Error())
+		}
+	}
+	<-done
+}
@@ -0,0 +1,281 @@
+package series
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/internal/batch"
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+// number of points at which a density estimate is evaluated
+const kdeResolution = 50
+
+// KDESeries is a line that shows the kernel density estimate of a set of samples
+type KDESeries struct {
+	baseSeries
+	samples   []float64
+	bandwidth float64
+	line      *PointSeries
+	lineWidth float32
+}
+
+func EmptyKDESeries(name string, colName fyne.ThemeColorName) (ser *KDESeries) {
+	ser = &KDESeries{
+		bandwidth: 0,
+		line:      EmptyPointSeries(name, colName),
+		lineWidth: 1,
+	}
+	ser.baseSeries = emptyBaseSeries(name, colName, ser.toggleView)
+	ser.line.MakeLine(false)
+	return
+}
+
+// Lock locks the density series and its line
+func (ser *KDESeries) Lock() {
+	ser.mu.Lock()
+	ser.line.Lock()
+}
+
+// Unlock unlocks the line and the density series
+func (ser *KDESeries) Unlock() {
+	ser.line.Unlock()
+	ser.mu.Unlock()
+}
+
+// update evaluates the density estimate of the current samples
+func (ser *KDESeries) update() {
+	ser.mu.Lock()
+	samples := append([]float64{}, ser.samples...)
+	ser.mu.Unlock()
+	ser.line.Lock()
+	ser.line.data = nil
+	ser.line.sampleDirty = true
+	ser.line.Unlock()
+	if len(samples) > 0 {
+		h := ser.bandwidth
+		if h == 0 {
+			h = silvermanBandwidth(samples)
+		}
+		grid := densityGrid(samples, h, kdeResolution)
+		dens := kernelDensity(samples, h, grid)
+		nps := make([]data.NumericalPoint, len(grid))
+		for i := range grid {
+			nps[i] = data.NumericalPoint{N: grid[i], Val: dens[i]}
+		}
+		ser.line.AddNumericalData(nps)
+	}
+	ser.line.SetLineWidth(ser.lineWidth)
+	if !ser.visible {
+		ser.line.Hide()
+	}
+	if cont := ser.chart(); cont != nil {
+		cont.DataChange()
+	}
+}
+
+func (ser *KDESeries) NRange() (isEmpty bool, min float64, max float64) {
+	isEmpty, min, max = ser.line.NRange()
+	return
+}
+
+func (ser *KDESeries) ValRange() (isEmpty bool, min float64, max float64) {
+	isEmpty, min, max = ser.line.ValRange()
+	if !isEmpty {
+		min = 0
+	}
+	return
+}
+
+func (ser *KDESeries) CartesianEdges(xMin float64, xMax float64, yMin float64,
+	yMax float64) (es []renderer.CartesianEdge) {
+	es = ser.line.CartesianEdges(xMin, xMax, yMin, yMax)
+	return
+}
+
+func (ser *KDESeries) RefreshTheme() {
+	ser.col = theme.Color(ser.colName)
+	ser.line.RefreshTheme()
+}
+
+func (ser *KDESeries) BindToChart(ch container) (err error) {
+	if ch.IsPolar() {
+		err = errors.New("density series can not be added to polar charts")
+		return
+	}
+	err = ser.baseSeries.BindToChart(ch)
+	return
+}
+
+// Show makes all elements of the series visible
+func (ser *KDESeries) Show() {
+	ser.visible = true
+	ser.line.Show()
+	ser.legendEntry.Show()
+}
+
+// Hide hides all elements of the series
+func (ser *KDESeries) Hide() {
+	ser.visible = false
+	ser.line.Hide()
+	ser.legendEntry.Hide()
+}
+
+func (ser *KDESeries) toggleView() {
+	if ser.visible {
+		ser.Hide()
+	} else {
+		ser.Show()
+	}
+}
+
+func (ser *KDESeries) SetColor(colName fyne.ThemeColorName) {
+	ser.colName = colName
+	ser.col = theme.Color(colName)
+	ser.legendEntry.SetColor(colName)
+	ser.line.SetColor(colName)
+}
+
+func (ser *KDESeries) SetLineWidth(lw float32) {
+	if lw < 0 {
+		return
+	}
+	ser.lineWidth = lw
+	ser.line.SetLineWidth(lw)
+}
+
+// SetBandwidth changes the bandwidth of the gaussian kernel
+// A bandwidth of 0 selects the bandwidth automatically (Silverman's rule of thumb)
+func (ser *KDESeries) SetBandwidth(bw float64) (err error) {
+	if bw < 0 || math.IsNaN(bw) || math.IsInf(bw, 0) {
+		err = errors.New("invalid bandwidth")
+		return
+	}
+	ser.bandwidth = bw
+	ser.update()
+	return
+}
+
+func (ser *KDESeries) Clear() {
+	ser.mu.Lock()
+	ser.samples = []float64{}
+	ser.mu.Unlock()
+	batch.RunOnMain(ser.update)
+}
+
+// AddData adds samples to the series
+func (ser *KDESeries) AddData(samples []float64) (err error) {
+	if len(samples) == 0 {
+		return
+	}
+	if !validSamples(samples) {
+		err = errors.New("invalid data")
+		return
+	}
+	ser.mu.Lock()
+	ser.samples = append(ser.samples, samples...)
+	sort.Float64s(ser.samples)
+	ser.mu.Unlock()
+	batch.RunOnMain(ser.update)
+	return
+}
+
+func validSamples(samples []float64) (ok bool) {
+	for i := range samples {
+		if math.IsNaN(samples[i]) || math.IsInf(samples[i], 0) {
+			return
+		}
+	}
+	ok = true
+	return
+}
+
+// silvermanBandwidth estimates a bandwidth for a gaussian kernel with Silverman's rule of thumb
+// The samples must be sorted
+func silvermanBandwidth(sorted []float64) (h float64) {
+	n := float64(len(sorted))
+	if n == 0 {
+		return
+	}
+	mean := 0.0
+	for i := range sorted {
+		mean += sorted[i] / n
+	}
+	variance := 0.0
+	for i := range sorted {
+		variance += math.Pow(sorted[i]-mean, 2) / n
+	}
+	spread := math.Sqrt(variance)
+	iqr := (quantile(sorted, 0.75) - quantile(sorted, 0.25)) / 1.34
+	if iqr > 0 && iqr < spread {
+		spread = iqr
+	}
+	h = 0.9 * spread * math.Pow(n, -0.2)
+	if h == 0 {
+		// all samples are equal
+		h = math.Max(math.Abs(mean)/10, 1)
+	}
+	return
+}
+
+// quantile gives the q quantile of the sorted samples using linear interpolation
+func quantile(sorted []float64, q float64) (v float64) {
+	if len(sorted) == 0 {
+		return
+	}
+	pos := q * float64(len(sorted)-1)
+	i := int(math.Floor(pos))
+	if i >= len(sorted)-1 {
+		v = sorted[len(sorted)-1]
+		return
+	}
+	v = sorted[i] + ((pos - float64(i)) * (sorted[i+1] - sorted[i]))
+	return
+}
+
+// densityGrid gives n evenly spaced points from 3 bandwidths below the smallest to 3 bandwidths above the largest sample
+// The samples must be sorted
+func densityGrid(sorted []float64, h float64, n int) (grid []float64) {
+	if len(sorted) == 0 || n < 2 {
+		return
+	}
+	min := sorted[0] - (3 * h)
+	max := sorted[len(sorted)-1] + (3 * h)
+	grid = make([]float64, n)
+	for i := range grid {
+		grid[i] = min + ((max - min) * float64(i) / float64(n-1))
+	}
+	return
+}
+
+// kernelDensity evaluates the gaussian kernel density estimate of the samples at each point of grid
+func kernelDensity(samples []float64, h float64, grid []float64) (dens []float64) {
+	dens = make([]float64, len(grid))
+	if len(samples) == 0 || h <= 0 {
+		return
+	}
+	norm := 1 / (float64(len(samples)) * h * math.Sqrt(2*math.Pi))
+	for i := range grid {
+		sum := 0.0
+		for j := range samples {
+			u := (grid[i] - samples[j]) / h
+			sum += math.Exp(-0.5 * u * u)
+		}
+		dens[i] = sum * norm
+	}
+	return
+}
+
+// Data gives the samples between nMin and nMax; the series must be locked
+func (ser *KDESeries) Data(nMin float64, nMax float64) (samples []float64) {
+	for _, s := range ser.samples {
+		if inNRange(s, nMin, nMax) {
+			samples = append(samples, s)
+		}
+	}
+	return
+}
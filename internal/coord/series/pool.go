@@ -0,0 +1,166 @@
+package series
+
+import (
+	"sort"
+	"sync"
+
+	"fyne.io/fyne/v2/canvas"
+)
+
+// maximum number of unused canvas objects of each kind that are kept for reuse
+const maxPoolSize = 10000
+
+// objectPool holds canvas objects that are not used by any data point, so that they can be reused
+type objectPool struct {
+	mu      sync.Mutex
+	circles []*canvas.Circle
+	lines   []*canvas.Line
+	rects   []*canvas.Rectangle
+}
+
+// pool is shared by all point and candlestick series
+var pool = &objectPool{}
+
+func (p *objectPool) circle() (c *canvas.Circle) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if n := len(p.circles); n > 0 {
+		c = p.circles[n-1]
+		p.circles = p.circles[:n-1]
+		return
+	}
+	c = &canvas.Circle{}
+	return
+}
+
+func (p *objectPool) line() (l *canvas.Line) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if n := len(p.lines); n > 0 {
+		l = p.lines[n-1]
+		p.lines = p.lines[:n-1]
+		return
+	}
+	l = &canvas.Line{}
+	return
+}
+
+func (p *objectPool) rect() (r *canvas.Rectangle) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if n := len(p.rects); n > 0 {
+		r = p.rects[n-1]
+		p.rects = p.rects[:n-1]
+		return
+	}
+	r = &canvas.Rectangle{}
+	return
+}
+
+// put returns the canvas objects of a data point to the pool
+func (p *objectPool) put(point *dataPoint) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if point.dot != nil && len(p.circles) < maxPoolSize {
+		p.circles = append(p.circles, point.dot)
+	}
+	for _, l := range []*canvas.Line{point.fromValBase, point.fromPrev} {
+		if l != nil && len(p.lines) < maxPoolSize {
+			p.lines = append(p.lines, l)
+		}
+	}
+	if point.bar != nil && len(p.rects) < maxPoolSize {
+		p.rects = append(p.rects, point.bar)
+	}
+}
+
+// putCandle returns the canvas objects of a candle to the pool
+func (p *objectPool) putCandle(point *candleStickPoint) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, l := range []*canvas.Line{point.upperLine, point.lowerLine} {
+		if l != nil && len(p.lines) < maxPoolSize {
+			p.lines = append(p.lines, l)
+		}
+	}
+	if point.candle != nil && len(p.rects) < maxPoolSize {
+		// bars are drawn without rounded corners
+		point.candle.CornerRadius = 0
+		p.rects = append(p.rects, point.candle)
+	}
+}
+
+// viewPoints gives the drawn points with a n within [min,max] and one neighbour on each side
+// Only these points own canvas objects; the objects of all other points are returned to the pool
+// The series must be locked
+func (ser *PointSeries) viewPoints(min float64, max float64) (ps []*dataPoint) {
+	ps = ser.drawnPoints()
+	if ser.drawnSorted && max >= min {
+		first := sort.Search(len(ps), func(i int) bool { return ps[i].n >= min })
+		last := sort.Search(len(ps), func(i int) bool { return ps[i].n > max })
+		if first > 0 {
+			first--
+		}
+		if last < len(ps) {
+			last++
+		}
+		ps = ps[first:last]
+	}
+	ser.view++
+	for _, point := range ps {
+		point.view = ser.view
+		if !point.attached {
+			point.attach(ser.pointStyle())
+		}
+	}
+	for _, point := range ser.attached {
+		if point.view != ser.view {
+			point.detach()
+		}
+	}
+	ser.attached = append(ser.attached[:0], ps...)
+	return
+}
+
+// detachAll returns the canvas objects of all points to the pool
+// The series must be locked
+func (ser *PointSeries) detachAll() {
+	for _, point := range ser.attached {
+		point.detach()
+	}
+	ser.attached = ser.attached[:0]
+}
+
+// viewCandles gives the candles that overlap [min,max]
+// Only these candles own canvas objects; the objects of all other candles are returned to the pool
+// Candles are not sorted, so all candles are checked, but no objects are created for the ones out of view
+// The series must be locked
+func (ser *CandleStickSeries) viewCandles(min float64, max float64) (cs []*candleStickPoint) {
+	ser.view++
+	for _, point := range ser.data {
+		if point.nEnd < min || point.nStart > max {
+			continue
+		}
+		point.view = ser.view
+		if !point.attached {
+			point.attach(ser.lineWidth, ser.visible)
+		}
+		cs = append(cs, point)
+	}
+	for _, point := range ser.attached {
+		if point.view != ser.view {
+			point.detach()
+		}
+	}
+	ser.attached = append(ser.attached[:0], cs...)
+	return
+}
+
+// detachAll returns the canvas objects of all candles to the pool
+// The series must be locked
+func (ser *CandleStickSeries) detachAll() {
+	for _, point := range ser.attached {
+		point.detach()
+	}
+	ser.attached = ser.attached[:0]
+}
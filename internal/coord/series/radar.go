@@ -0,0 +1,414 @@
+package series
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"sort"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+// AxisRange defines the value range of a single spoke of a radar chart
+type AxisRange struct {
+	C   string
+	Min float64
+	Max float64
+}
+
+type radarPoint struct {
+	c    string
+	n    float64
+	val  float64
+	dot  *canvas.Circle
+	line *canvas.Line
+}
+
+func emptyRadarPoint(col color.Color) (point *radarPoint) {
+	point = &radarPoint{
+		dot:  canvas.NewCircle(col),
+		line: canvas.NewLine(col),
+	}
+	point.dot.Resize(fyne.NewSize(5, 5))
+	return
+}
+
+func (point *radarPoint) hide() {
+	point.dot.Hide()
+	point.line.Hide()
+}
+
+func (point *radarPoint) show() {
+	point.dot.Show()
+	point.line.Show()
+}
+
+func (point *radarPoint) setColor(col color.Color) {
+	point.dot.FillColor = col
+	point.line.StrokeColor = col
+	point.dot.Refresh()
+	point.line.Refresh()
+}
+
+// RadarSeries is a closed line or area over all categories of a radar chart
+type RadarSeries struct {
+	baseSeries
+	data    []*radarPoint
+	showDot bool
+	filled  bool
+	ranges  []AxisRange
+	outerR  float64
+}
+
+func EmptyRadarSeries(name string, colName fyne.ThemeColorName) (ser *RadarSeries) {
+	ser = &RadarSeries{
+		outerR: 1,
+	}
+	ser.baseSeries = emptyBaseSeries(name, colName, ser.toggleView)
+	return
+}
+
+func (ser *RadarSeries) MakeLine(showDot bool) {
+	ser.showDot = showDot
+	ser.filled = false
+}
+
+func (ser *RadarSeries) MakeArea(showDot bool) {
+	ser.showDot = showDot
+	ser.filled = true
+}
+
+// SetAxisRanges sets the ranges of all spokes that are scaled individually
+func (ser *RadarSeries) SetAxisRanges(ranges []AxisRange) {
+	ser.ranges = append([]AxisRange{}, ranges...)
+}
+
+// SetOuterRadius sets the radius at which the max of a ranged spoke is drawn
+func (ser *RadarSeries) SetOuterRadius(r float64) {
+	ser.outerR = r
+}
+
+func (ser *RadarSeries) radius(point *radarPoint) (r float64) {
+	r = point.val
+	for i := range ser.ranges {
+		if ser.ranges[i].C == point.c {
+			r = radarRangeRadius(point.val, ser.ranges[i].Min, ser.ranges[i].Max, ser.outerR)
+			return
+		}
+	}
+	r = math.Max(r, 0)
+	return
+}
+
+// radarRangeRadius maps val from [min, max] to [0, outerR]; values outside the range are clamped
+func radarRangeRadius(val float64, min float64, max float64, outerR float64) (r float64) {
+	if max <= min {
+		return
+	}
+	r = math.Min(math.Max((val-min)/(max-min), 0), 1) * outerR
+	return
+}
+
+func (ser *RadarSeries) isRanged(c string) (b bool) {
+	for i := range ser.ranges {
+		if ser.ranges[i].C == c {
+			b = true
+			return
+		}
+	}
+	return
+}
+
+func (ser *RadarSeries) CRange() (cs []string) {
+	for i := range ser.data {
+		cs = append(cs, ser.data[i].c)
+	}
+	return
+}
+
+func (ser *RadarSeries) ValRange() (isEmpty bool, min float64, max float64) {
+	isEmpty = true
+	for i := range ser.data {
+		if ser.isRanged(ser.data[i].c) {
+			continue
+		}
+		val := math.Max(ser.data[i].val, 0)
+		if isEmpty {
+			min = val
+			max = val
+			isEmpty = false
+		}
+		min = math.Min(min, val)
+		max = math.Max(max, val)
+	}
+	return
+}
+
+func (ser *RadarSeries) ConvertCtoN(cToN func(c string) (n float64)) {
+	for i := range ser.data {
+		ser.data[i].n = cToN(ser.data[i].c)
+	}
+	sort.SliceStable(ser.data, func(i, j int) bool { return ser.data[i].n < ser.data[j].n })
+}
+
+func (ser *RadarSeries) PolarNodes(phiMin float64, phiMax float64, rMin float64,
+	rMax float64) (ns []renderer.PolarNode) {
+	if !ser.showDot {
+		return
+	}
+	for i := range ser.data {
+		r := ser.radius(ser.data[i])
+		if r > rMax || ser.data[i].n < phiMin || ser.data[i].n > phiMax {
+			continue
+		}
+		ns = append(ns, renderer.PolarNode{
+			Phi: ser.data[i].n,
+			R:   r,
+			Dot: ser.data[i].dot,
+		})
+	}
+	return
+}
+
+func (ser *RadarSeries) PolarEdges(phiMin float64, phiMax float64, rMin float64,
+	rMax float64) (es []renderer.PolarEdge) {
+	if len(ser.data) < 2 {
+		return
+	}
+	// each point draws the line from its predecessor; the first point closes the polygon
+	for i := range ser.data {
+		prev := ser.data[len(ser.data)-1]
+		if i > 0 {
+			prev = ser.data[i-1]
+		}
+		r1 := ser.radius(prev)
+		r2 := ser.radius(ser.data[i])
+		if r1 > rMax || r2 > rMax {
+			continue
+		}
+		es = append(es, renderer.PolarEdge{
+			Phi1: prev.n,
+			R1:   r1,
+			Phi2: ser.data[i].n,
+			R2:   r2,
+			Line: ser.data[i].line,
+		})
+	}
+	return
+}
+
+func (ser *RadarSeries) RasterColorPolar(phi float64, r float64, x float64,
+	y float64) (col color.Color) {
+	col = ser.baseSeries.RasterColorPolar(phi, r, x, y)
+	if !ser.visible || !ser.filled || len(ser.data) < 3 {
+		return
+	}
+	xs := make([]float64, len(ser.data))
+	ys := make([]float64, len(ser.data))
+	for i := range ser.data {
+		rad := ser.radius(ser.data[i])
+		xs[i] = rad * math.Cos(ser.data[i].n)
+		ys[i] = rad * math.Sin(ser.data[i].n)
+	}
+	if pointInPolygon(x, y, xs, ys) {
+		red, green, blue, _ := ser.col.RGBA()
+		col = color.RGBA64{R: uint16(red), G: uint16(green), B: uint16(blue), A: 0x8888}
+	}
+	return
+}
+
+// pointInPolygon checks whether (x,y) is inside the closed polygon given by its vertices (even-odd rule)
+func pointInPolygon(x float64, y float64, xs []float64, ys []float64) (in bool) {
+	j := len(xs) - 1
+	for i := range xs {
+		if (ys[i] > y) != (ys[j] > y) &&
+			x < xs[i]+((y-ys[i])*(xs[j]-xs[i])/(ys[j]-ys[i])) {
+			in = !in
+		}
+		j = i
+	}
+	return
+}
+
+func (ser *RadarSeries) RasterPolygons() (ps []renderer.Polygon) {
+	if !ser.visible || !ser.filled || len(ser.data) < 3 {
+		return
+	}
+	p := renderer.Polygon{Plane: true, Col: renderer.FillColor(ser.col, 0x88)}
+	for i := range ser.data {
+		rad := ser.radius(ser.data[i])
+		p.X = append(p.X, rad*math.Cos(ser.data[i].n))
+		p.Y = append(p.Y, rad*math.Sin(ser.data[i].n))
+	}
+	ps = append(ps, p)
+	return
+}
+
+func (ser *RadarSeries) IsPartOfChartRaster() (b bool) {
+	b = ser.cont != nil && ser.visible && ser.filled
+	return
+}
+
+func (ser *RadarSeries) RefreshTheme() {
+	ser.col = theme.Color(ser.colName)
+	for i := range ser.data {
+		ser.data[i].setColor(ser.col)
+	}
+}
+
+// Show makes all elements of the series visible
+func (ser *RadarSeries) Show() {
+	ser.visible = true
+	for i := range ser.data {
+		ser.data[i].show()
+	}
+	ser.legendEntry.Show()
+}
+
+// Hide hides all elements of the series
+func (ser *RadarSeries) Hide() {
+	ser.visible = false
+	for i := range ser.data {
+		ser.data[i].hide()
+	}
+	ser.legendEntry.Hide()
+}
+
+func (ser *RadarSeries) toggleView() {
+	if ser.visible {
+		ser.Hide()
+	} else {
+		ser.Show()
+	}
+	if ser.filled && ser.cont != nil {
+		ser.cont.RasterRefresh()
+	}
+}
+
+func (ser *RadarSeries) SetColor(colName fyne.ThemeColorName) {
+	ser.colName = colName
+	ser.col = theme.Color(ser.colName)
+	ser.legendEntry.SetColor(colName)
+	for i := range ser.data {
+		ser.data[i].setColor(ser.col)
+	}
+	if ser.filled && ser.cont != nil {
+		ser.cont.RasterRefresh()
+	}
+}
+
+func (ser *RadarSeries) SetLineWidth(lw float32) {
+	if lw < 0 {
+		return
+	}
+	for i := range ser.data {
+		ser.data[i].line.StrokeWidth = lw
+		ser.data[i].line.Refresh()
+	}
+}
+
+func (ser *RadarSeries) SetDotSize(ds float32) {
+	if ds < 0 {
+		return
+	}
+	for i := range ser.data {
+		ser.data[i].dot.Resize(fyne.NewSize(ds, ds))
+		ser.data[i].dot.Refresh()
+	}
+}
+
+func (ser *RadarSeries) BindToChart(ch container) (err error) {
+	if !ch.IsPolar() {
+		err = errors.New("radar series can only be added to polar charts")
+		return
+	}
+	err = ser.baseSeries.BindToChart(ch)
+	return
+}
+
+func (ser *RadarSeries) Clear() {
+	ser.mu.Lock()
+	ser.data = []*radarPoint{}
+	ser.mu.Unlock()
+	ser.dataChanged()
+}
+
+func (ser *RadarSeries) DeleteDataInRange(cat []string) (c int) {
+	c = 0
+	if len(cat) == 0 {
+		return
+	}
+	ser.mu.Lock()
+	finalData := []*radarPoint{}
+	for i := range ser.data {
+		del := false
+		for j := range cat {
+			if ser.data[i].c == cat[j] {
+				del = true
+				break
+			}
+		}
+		if del {
+			c++
+		} else {
+			finalData = append(finalData, ser.data[i])
+		}
+	}
+	if c == 0 {
+		ser.mu.Unlock()
+		return
+	}
+	ser.data = finalData
+	ser.mu.Unlock()
+	ser.dataChanged()
+	return
+}
+
+func (ser *RadarSeries) AddData(input []data.CategoricalPoint) (err error) {
+	if len(input) == 0 {
+		return
+	}
+	for i := range input {
+		if math.IsNaN(input[i].Val) || math.IsInf(input[i].Val, 0) {
+			err = errors.New("invalid data, val must be finite")
+			return
+		}
+	}
+	ser.mu.Lock()
+	for i := range input {
+		catExist := false
+		for j := range ser.data {
+			if input[i].C == ser.data[j].c {
+				catExist = true
+				break
+			}
+		}
+		if catExist {
+			continue
+		}
+		point := emptyRadarPoint(ser.col)
+		point.c = input[i].C
+		point.val = input[i].Val
+		if !ser.visible {
+			point.hide()
+		}
+		ser.data = append(ser.data, point)
+	}
+	ser.mu.Unlock()
+	ser.dataChanged()
+	return
+}
+
+// Data gives the data points with n between nMin and nMax; the series must be locked
+func (ser *RadarSeries) Data(nMin float64, nMax float64) (ps []data.CategoricalPoint) {
+	for _, p := range ser.data {
+		if inNRange(p.n, nMin, nMax) {
+			ps = append(ps, data.CategoricalPoint{C: p.c, Val: p.val})
+		}
+	}
+	return
+}
@@ -0,0 +1,51 @@
+package series
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRadarRangeRadius(t *testing.T) {
+	var tests = []struct {
+		val    float64
+		min    float64
+		max    float64
+		outerR float64
+		expR   float64
+	}{
+		{5, 0, 10, 1, 0.5},
+		{0, 0, 10, 2, 0},
+		{10, 0, 10, 2, 2},
+		{-3, -5, -1, 4, 2},
+		{20, 0, 10, 1, 1},
+		{-20, 0, 10, 1, 0},
+		{5, 10, 10, 1, 0},
+	}
+	for i, tt := range tests {
+		r := radarRangeRadius(tt.val, tt.min, tt.max, tt.outerR)
+		if math.Abs(r-tt.expR) > 0.000001 {
+			t.Errorf("wrong radius, set %d, exp %f, have %f", i, tt.expR, r)
+		}
+	}
+}
+
+func TestRadarPointInPolygon(t *testing.T) {
+	square := struct{ xs, ys []float64 }{[]float64{1, -1, -1, 1}, []float64{1, 1, -1, -1}}
+	var tests = []struct {
+		x     float64
+		y     float64
+		expIn bool
+	}{
+		{0, 0, true},
+		{0.9, -0.9, true},
+		{1.1, 0, false},
+		{0, -1.1, false},
+		{5, 5, false},
+	}
+	for i, tt := range tests {
+		in := pointInPolygon(tt.x, tt.y, square.xs, square.ys)
+		if in != tt.expIn {
+			t.Errorf("wrong result, set %d, exp %t, have %t", i, tt.expIn, in)
+		}
+	}
+}
@@ -0,0 +1,644 @@
+package series
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+type RegressionType string
+
+const (
+	RegressionLinear      RegressionType = "Linear"
+	RegressionPolynomial  RegressionType = "Polynomial"
+	RegressionExponential RegressionType = "Exponential"
+	RegressionLogarithmic RegressionType = "Logarithmic"
+	RegressionPower       RegressionType = "Power"
+	RegressionLOESS       RegressionType = "LOESS"
+)
+
+// number of points at which the fitted curve is evaluated
+const regressionSamples = 100
+
+// RegressionSeries is a curve that is fitted to the data of a point series.
+// It is refitted every time the data of the source series changes.
+type RegressionSeries struct {
+	baseSeries
+	src            *PointSeries
+	temporal       bool
+	typ            RegressionType
+	degree         int
+	span           float64
+	line           *PointSeries
+	annotation     *canvas.Text
+	showAnnotation bool
+	equation       string
+	rSquared       float64
+	lineWidth      float32
+}
+
+// EmptyRegressionSeries creates a regression of the given type for src
+// For temporal sources x is measured in seconds since the first point of src
+// degree is only used by polynomial regressions, span only by LOESS regressions
+func EmptyRegressionSeries(name string, colName fyne.ThemeColorName, src *PointSeries, temporal bool,
+	typ RegressionType, degree int, span float64) (ser *RegressionSeries, err error) {
+	if src == nil {
+		err = errors.New("no source series")
+		return
+	}
+	switch typ {
+	case RegressionLinear, RegressionExponential:
+	case RegressionPolynomial:
+		if degree < 1 {
+			err = errors.New("invalid degree")
+			return
+		}
+	case RegressionLogarithmic, RegressionPower:
+		if temporal {
+			err = errors.New("regression type not supported for temporal series")
+			return
+		}
+	case RegressionLOESS:
+		if span <= 0 || span > 1 {
+			err = errors.New("invalid span")
+			return
+		}
+	default:
+		err = errors.New("unknown regression type")
+		return
+	}
+	ser = &RegressionSeries{
+		src:            src,
+		temporal:       temporal,
+		typ:            typ,
+		degree:         degree,
+		span:           span,
+		line:           EmptyPointSeries(name, colName),
+		annotation:     canvas.NewText("", theme.Color(colName)),
+		showAnnotation: false,
+		lineWidth:      1,
+	}
+	ser.baseSeries = emptyBaseSeries(name, colName, ser.toggleView)
+	ser.line.MakeLine(false)
+	ser.annotation.TextSize = theme.CaptionTextSize()
+	ser.annotation.Hide()
+	ser.subscribe()
+	return
+}
+
+func (ser *RegressionSeries) subscribe() {
+	ser.src.AddDataListener(fmt.Sprintf("%p", ser), ser.update)
+	ser.update()
+}
+
+// Release removes the series from its chart; it is no longer refitted when the data of the source changes
+func (ser *RegressionSeries) Release() {
+	ser.src.RemoveDataListener(fmt.Sprintf("%p", ser))
+	ser.baseSeries.Release()
+}
+
+// Lock locks the regression series and its curve
+func (ser *RegressionSeries) Lock() {
+	ser.mu.Lock()
+	ser.line.Lock()
+}
+
+// Unlock unlocks the curve and the regression series
+func (ser *RegressionSeries) Unlock() {
+	ser.line.Unlock()
+	ser.mu.Unlock()
+}
+
+// update refits the curve to the current data of the source series
+func (ser *RegressionSeries) update() {
+	xs, ys, t0 := ser.input()
+	f, eq, ok := fitRegression(ser.typ, xs, ys, ser.degree, ser.span)
+	ser.line.Lock()
+	ser.line.data = nil
+	ser.line.sampleDirty = true
+	ser.line.Unlock()
+	ser.equation = ""
+	ser.rSquared = 0
+	if ok {
+		ser.equation = eq
+		ser.rSquared = rSquared(xs, ys, f)
+		xMin, xMax := xs[0], xs[0]
+		for i := range xs {
+			xMin = math.Min(xMin, xs[i])
+			xMax = math.Max(xMax, xs[i])
+		}
+		var nps []data.NumericalPoint
+		var tps []data.TemporalPoint
+		for i := range regressionSamples {
+			x := xMin + ((xMax - xMin) * float64(i) / float64(regressionSamples-1))
+			y := f(x)
+			if math.IsNaN(y) || math.IsInf(y, 0) {
+				continue
+			}
+			if ser.temporal {
+				tps = append(tps, data.TemporalPoint{T: t0.Add(time.Duration(x * float64(time.Second))), Val: y})
+			} else {
+				nps = append(nps, data.NumericalPoint{N: x, Val: y})
+			}
+		}
+		if ser.temporal {
+			ser.line.AddTemporalData(tps)
+		} else {
+			ser.line.AddNumericalData(nps)
+		}
+	}
+	ser.annotation.Text = ser.annotationText()
+	ser.line.SetLineWidth(ser.lineWidth)
+	if !ser.visible {
+		ser.line.Hide()
+	}
+	if cont := ser.chart(); cont != nil && cont != ser.src.chart() {
+		// if source and regression share the chart, the source triggers the update of the chart
+		cont.DataChange()
+	}
+}
+
+// input gives the points of the source series that can be used by the regression type
+func (ser *RegressionSeries) input() (xs []float64, ys []float64, t0 time.Time) {
+	ser.src.Lock()
+	defer ser.src.Unlock()
+	if ser.temporal {
+		for i := range ser.src.data {
+			if i == 0 || ser.src.data[i].t.Before(t0) {
+				t0 = ser.src.data[i].t
+			}
+		}
+	}
+	for i := range ser.src.data {
+		x := ser.src.data[i].n
+		if ser.temporal {
+			x = ser.src.data[i].t.Sub(t0).Seconds()
+		}
+		y := ser.src.data[i].val
+		if (ser.typ == RegressionLogarithmic || ser.typ == RegressionPower) && x <= 0 {
+			continue
+		}
+		if (ser.typ == RegressionExponential || ser.typ == RegressionPower) && y <= 0 {
+			continue
+		}
+		xs = append(xs, x)
+		ys = append(ys, y)
+	}
+	return
+}
+
+func (ser *RegressionSeries) annotationText() (s string) {
+	if ser.equation == "" {
+		return
+	}
+	s = "R² = " + strconv.FormatFloat(ser.rSquared, 'f', 4, 64)
+	if ser.typ != RegressionLOESS {
+		s = ser.equation + "   " + s
+	}
+	return
+}
+
+// Equation gives the fitted equation; it is empty if the curve could not be fitted
+func (ser *RegressionSeries) Equation() (eq string) {
+	eq = ser.equation
+	return
+}
+
+// RSquared gives the coefficient of determination of the fitted curve
+func (ser *RegressionSeries) RSquared() (r2 float64) {
+	r2 = ser.rSquared
+	return
+}
+
+func (ser *RegressionSeries) TRange() (isEmpty bool, min time.Time, max time.Time) {
+	isEmpty, min, max = ser.line.TRange()
+	return
+}
+
+func (ser *RegressionSeries) NRange() (isEmpty bool, min float64, max float64) {
+	isEmpty, min, max = ser.line.NRange()
+	return
+}
+
+func (ser *RegressionSeries) ValRange() (isEmpty bool, min float64, max float64) {
+	isEmpty, min, max = ser.line.ValRange()
+	return
+}
+
+func (ser *RegressionSeries) ConvertTtoN(tToN func(t time.Time) (n float64)) {
+	ser.line.ConvertTtoN(tToN)
+}
+
+func (ser *RegressionSeries) CartesianEdges(xMin float64, xMax float64, yMin float64,
+	yMax float64) (es []renderer.CartesianEdge) {
+	es = ser.line.CartesianEdges(xMin, xMax, yMin, yMax)
+	return
+}
+
+func (ser *RegressionSeries) CartesianTexts(xMin float64, xMax float64, yMin float64,
+	yMax float64) (ts []renderer.CartesianText) {
+	if len(ser.line.data) == 0 {
+		return
+	}
+	// the annotation is placed above the curve at three quarters of its range
+	point := ser.line.data[(len(ser.line.data)*3)/4]
+	if point.n < xMin || point.n > xMax || point.val < yMin || point.val > yMax {
+		return
+	}
+	ts = append(ts, renderer.CartesianText{
+		X:    point.n,
+		Y:    point.val + ((yMax - yMin) / 20),
+		Text: ser.annotation,
+	})
+	return
+}
+
+func (ser *RegressionSeries) RefreshTheme() {
+	ser.col = theme.Color(ser.colName)
+	ser.annotation.Color = ser.col
+	ser.annotation.TextSize = theme.CaptionTextSize()
+	ser.line.RefreshTheme()
+}
+
+func (ser *RegressionSeries) BindToChart(ch container) (err error) {
+	if ch.IsPolar() {
+		err = errors.New("regression series can not be added to polar charts")
+		return
+	}
+	err = ser.baseSeries.BindToChart(ch)
+	if err != nil {
+		return
+	}
+	// a regression that has been released before follows its source series again
+	ser.subscribe()
+	return
+}
+
+// Show makes all elements of the series visible
+func (ser *RegressionSeries) Show() {
+	ser.visible = true
+	ser.line.Show()
+	if ser.showAnnotation {
+		ser.annotation.Show()
+	}
+	ser.legendEntry.Show()
+}
+
+// Hide hides all elements of the series
+func (ser *RegressionSeries) Hide() {
+	ser.visible = false
+	ser.line.Hide()
+	ser.annotation.Hide()
+	ser.legendEntry.Hide()
+}
+
+func (ser *RegressionSeries) toggleView() {
+	if ser.visible {
+		ser.Hide()
+	} else {
+		ser.Show()
+	}
+}
+
+// SetAnnotationVisibility defines whether the equation and R² are displayed next to the curve
+func (ser *RegressionSeries) SetAnnotationVisibility(show bool) {
+	ser.showAnnotation = show
+	if show && ser.visible {
+		ser.annotation.Show()
+	} else {
+		ser.annotation.Hide()
+	}
+	if ser.cont != nil {
+		ser.cont.DataChange()
+	}
+}
+
+func (ser *RegressionSeries) SetColor(colName fyne.ThemeColorName) {
+	ser.colName = colName
+	ser.col = theme.Color(colName)
+	ser.legendEntry.SetColor(colName)
+	ser.annotation.Color = ser.col
+	ser.annotation.Refresh()
+	ser.line.SetColor(colName)
+}
+
+func (ser *RegressionSeries) SetLineWidth(lw float32) {
+	if lw < 0 {
+		return
+	}
+	ser.lineWidth = lw
+	ser.line.SetLineWidth(lw)
+}
+
+// fitRegression fits a curve of the given type to the points (xs, ys)
+// ok is false if the points are not sufficient for the fit
+func fitRegression(typ RegressionType, xs []float64, ys []float64, degree int,
+	span float64) (f func(x float64) float64, eq string, ok bool) {
+	switch typ {
+	case RegressionLinear:
+		f, eq, ok = polynomialRegression(xs, ys, 1)
+	case RegressionPolynomial:
+		f, eq, ok = polynomialRegression(xs, ys, degree)
+	case RegressionExponential:
+		f, eq, ok = exponentialRegression(xs, ys)
+	case RegressionLogarithmic:
+		f, eq, ok = logarithmicRegression(xs, ys)
+	case RegressionPower:
+		f, eq, ok = powerRegression(xs, ys)
+	case RegressionLOESS:
+		f, ok = loessRegression(xs, ys, span)
+		eq = "LOESS"
+	}
+	return
+}
+
+// polynomialCoefficients fits a polynomial of the given degree by least squares
+// coef[i] is the coefficient of x^i
+func polynomialCoefficients(xs []float64, ys []float64, degree int) (coef []float64, ok bool) {
+	if len(xs) <= degree || len(xs) != len(ys) {
+		return
+	}
+	// x is scaled to [0,1] to keep the normal equations well conditioned
+	a, b := xs[0], xs[0]
+	for i := range xs {
+		a = math.Min(a, xs[i])
+		b = math.Max(b, xs[i])
+	}
+	s := b - a
+	if s == 0 {
+		return
+	}
+	m := degree + 1
+	mat := make([][]float64, m)
+	for i := range mat {
+		mat[i] = make([]float64, m+1)
+	}
+	for k := range xs {
+		u := (xs[k] - a) / s
+		for i := range m {
+			for j := range m {
+				mat[i][j] += math.Pow(u, float64(i+j))
+			}
+			mat[i][m] += ys[k] * math.Pow(u, float64(i))
+		}
+	}
+	c, ok := solveLinearSystem(mat)
+	if !ok {
+		return
+	}
+	// expand c_k*((x-a)/s)^k to coefficients of x
+	coef = make([]float64, m)
+	for k := range m {
+		for j := 0; j <= k; j++ {
+			coef[j] += c[k] * binomial(k, j) * math.Pow(-a, float64(k-j)) / math.Pow(s, float64(k))
+		}
+	}
+	// remove rounding noise of the expansion
+	cMax := 0.0
+	for i := range coef {
+		cMax = math.Max(cMax, math.Abs(coef[i]))
+	}
+	for i := range coef {
+		if math.Abs(coef[i]) < 1e-12*cMax {
+			coef[i] = 0
+		}
+	}
+	return
+}
+
+func polynomialRegression(xs []float64, ys []float64, degree int) (f func(x float64) float64, eq string, ok bool) {
+	coef, ok := polynomialCoefficients(xs, ys, degree)
+	if !ok {
+		return
+	}
+	f = func(x float64) (y float64) {
+		for i := len(coef) - 1; i >= 0; i-- {
+			y = (y * x) + coef[i]
+		}
+		return
+	}
+	var sb strings.Builder
+	sb.WriteString("y = ")
+	for i := len(coef) - 1; i >= 0; i-- {
+		c := coef[i]
+		if i < len(coef)-1 {
+			if c < 0 {
+				sb.WriteString(" - ")
+				c = -c
+			} else {
+				sb.WriteString(" + ")
+			}
+		}
+		sb.WriteString(formatCoefficient(c))
+		switch i {
+		case 0:
+		case 1:
+			sb.WriteString("x")
+		default:
+			sb.WriteString("x^" + strconv.Itoa(i))
+		}
+	}
+	eq = sb.String()
+	return
+}
+
+// exponentialRegression fits y = a*e^(b*x) by a linear regression of ln(y)
+func exponentialRegression(xs []float64, ys []float64) (f func(x float64) float64, eq string, ok bool) {
+	lys := make([]float64, len(ys))
+	for i := range ys {
+		lys[i] = math.Log(ys[i])
+	}
+	coef, ok := polynomialCoefficients(xs, lys, 1)
+	if !ok {
+		return
+	}
+	a := math.Exp(coef[0])
+	b := coef[1]
+	f = func(x float64) float64 { return a * math.Exp(b*x) }
+	eq = "y = " + formatCoefficient(a) + "·e^(" + formatCoefficient(b) + "x)"
+	return
+}
+
+// logarithmicRegression fits y = a + b*ln(x)
+func logarithmicRegression(xs []float64, ys []float64) (f func(x float64) float64, eq string, ok bool) {
+	lxs := make([]float64, len(xs))
+	for i := range xs {
+		lxs[i] = math.Log(xs[i])
+	}
+	coef, ok := polynomialCoefficients(lxs, ys, 1)
+	if !ok {
+		return
+	}
+	a := coef[0]
+	b := coef[1]
+	f = func(x float64) float64 { return a + (b * math.Log(x)) }
+	eq = "y = " + formatCoefficient(a)
+	if b < 0 {
+		eq += " - " + formatCoefficient(-b) + "·ln(x)"
+	} else {
+		eq += " + " + formatCoefficient(b) + "·ln(x)"
+	}
+	return
+}
+
+// powerRegression fits y = a*x^b by a linear regression of ln(y) over ln(x)
+func powerRegression(xs []float64, ys []float64) (f func(x float64) float64, eq string, ok bool) {
+	lxs := make([]float64, len(xs))
+	lys := make([]float64, len(ys))
+	for i := range xs {
+		lxs[i] = math.Log(xs[i])
+		lys[i] = math.Log(ys[i])
+	}
+	coef, ok := polynomialCoefficients(lxs, lys, 1)
+	if !ok {
+		return
+	}
+	a := math.Exp(coef[0])
+	b := coef[1]
+	f = func(x float64) float64 { return a * math.Pow(x, b) }
+	eq = "y = " + formatCoefficient(a) + "·x^" + formatCoefficient(b)
+	return
+}
+
+// loessRegression creates a locally weighted linear regression
+// Each local fit uses the span*len(xs) nearest points weighted with the tricube function
+func loessRegression(xs []float64, ys []float64, span float64) (f func(x float64) float64, ok bool) {
+	if len(xs) < 2 || len(xs) != len(ys) {
+		return
+	}
+	k := int(math.Ceil(span * float64(len(xs))))
+	k = max(k, 2)
+	ok = true
+	f = func(x0 float64) (y float64) {
+		dist := make([]float64, len(xs))
+		for i := range xs {
+			dist[i] = math.Abs(xs[i] - x0)
+		}
+		sorted := append([]float64{}, dist...)
+		sort.Float64s(sorted)
+		d := sorted[k-1]
+		var sw, swx, swy, swxx, swxy float64
+		for i := range xs {
+			w := 0.0
+			if d == 0 {
+				if dist[i] == 0 {
+					w = 1
+				}
+			} else if dist[i] < d {
+				w = math.Pow(1-math.Pow(dist[i]/d, 3), 3)
+			}
+			sw += w
+			swx += w * xs[i]
+			swy += w * ys[i]
+			swxx += w * xs[i] * xs[i]
+			swxy += w * xs[i] * ys[i]
+		}
+		if sw == 0 {
+			y = math.NaN()
+			return
+		}
+		denom := (sw * swxx) - (swx * swx)
+		if math.Abs(denom) < 1e-12*sw*swxx {
+			y = swy / sw
+			return
+		}
+		b := ((sw * swxy) - (swx * swy)) / denom
+		a := (swy - (b * swx)) / sw
+		y = a + (b * x0)
+		return
+	}
+	return
+}
+
+// rSquared calculates the coefficient of determination of f for the points (xs, ys)
+func rSquared(xs []float64, ys []float64, f func(x float64) float64) (r2 float64) {
+	if len(ys) == 0 {
+		return
+	}
+	mean := 0.0
+	for i := range ys {
+		mean += ys[i] / float64(len(ys))
+	}
+	ssTot := 0.0
+	ssRes := 0.0
+	for i := range ys {
+		ssTot += math.Pow(ys[i]-mean, 2)
+		ssRes += math.Pow(ys[i]-f(xs[i]), 2)
+	}
+	if ssTot == 0 {
+		if ssRes == 0 {
+			r2 = 1
+		}
+		return
+	}
+	r2 = 1 - (ssRes / ssTot)
+	return
+}
+
+// solveLinearSystem solves the linear system given as augmented matrix by Gaussian elimination
+func solveLinearSystem(mat [][]float64) (x []float64, ok bool) {
+	n := len(mat)
+	for col := range n {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(mat[row][col]) > math.Abs(mat[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(mat[pivot][col]) < 1e-12 {
+			return
+		}
+		mat[col], mat[pivot] = mat[pivot], mat[col]
+		for row := col + 1; row < n; row++ {
+			factor := mat[row][col] / mat[col][col]
+			for j := col; j <= n; j++ {
+				mat[row][j] -= factor * mat[col][j]
+			}
+		}
+	}
+	x = make([]float64, n)
+	for row := n - 1; row >= 0; row-- {
+		sum := mat[row][n]
+		for j := row + 1; j < n; j++ {
+			sum -= mat[row][j] * x[j]
+		}
+		x[row] = sum / mat[row][row]
+	}
+	ok = true
+	return
+}
+
+func binomial(n int, k int) (b float64) {
+	b = 1
+	for i := 1; i <= k; i++ {
+		b = b * float64(n-k+i) / float64(i)
+	}
+	return
+}
+
+func formatCoefficient(c float64) (s string) {
+	s = strconv.FormatFloat(c, 'g', 4, 64)
+	return
+}
+
+// NumericalCurve gives the points of the fitted curve with n between nMin and nMax; the series must be locked
+func (ser *RegressionSeries) NumericalCurve(nMin float64, nMax float64) (ps []data.NumericalPoint) {
+	ps = ser.line.NumericalData(nMin, nMax)
+	return
+}
+
+// TemporalCurve gives the points of the fitted curve with n between nMin and nMax; the series must be locked
+func (ser *RegressionSeries) TemporalCurve(nMin float64, nMax float64) (ps []data.TemporalPoint) {
+	ps = ser.line.TemporalData(nMin, nMax)
+	return
+}
@@ -0,0 +1,110 @@
+package series
+
+import (
+	"math"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+func TestRegressionFit(t *testing.T) {
+	var tests = []struct {
+		typ        RegressionType
+		degree     int
+		span       float64
+		xs         []float64
+		ys         []float64
+		expSuccess bool
+		expEq      string
+		expR2      float64
+		x          float64
+		expY       float64
+	}{
+		{RegressionLinear, 0, 0, []float64{1}, []float64{1}, false, "", 0, 0, 0},
+		{RegressionLinear, 0, 0, []float64{2, 2}, []float64{1, 3}, false, "", 0, 0, 0},
+		{RegressionLinear, 0, 0, []float64{0, 1, 2}, []float64{1, 3, 5}, true, "y = 2x + 1", 1, 10, 21},
+		{RegressionLinear, 0, 0, []float64{0, 1, 2}, []float64{1, -1, -3}, true, "y = -2x + 1", 1, 3, -5},
+		{RegressionPolynomial, 2, 0, []float64{-1, 0, 1, 2}, []float64{2, 1, 2, 5}, true, "y = 1x^2 + 0x + 1", 1, 3, 10},
+		{RegressionPolynomial, 3, 0, []float64{0, 1, 2}, []float64{0, 1, 8}, false, "", 0, 0, 0},
+		{RegressionExponential, 0, 0, []float64{0, 1, 2}, []float64{2, 2 * math.E, 2 * math.E * math.E}, true, "y = 2·e^(1x)", 1, 3, 2 * math.Pow(math.E, 3)},
+		{RegressionLogarithmic, 0, 0, []float64{1, math.E, math.E * math.E}, []float64{1, 4, 7}, true, "y = 1 + 3·ln(x)", 1, math.Pow(math.E, 3), 10},
+		{RegressionPower, 0, 0, []float64{1, 2, 4}, []float64{3, 12, 48}, true, "y = 3·x^2", 1, 3, 27},
+		{RegressionLOESS, 0, 1, []float64{0, 1, 2, 3}, []float64{1, 2, 3, 4}, true, "LOESS", 1, 1.5, 2.5},
+	}
+	for i, tt := range tests {
+		f, eq, ok := fitRegression(tt.typ, tt.xs, tt.ys, tt.degree, tt.span)
+		if ok != tt.expSuccess {
+			t.Errorf("wrong fit result, set %d, exp %t, have %t", i, tt.expSuccess, ok)
+		}
+		if !ok {
+			continue
+		}
+		if eq != tt.expEq {
+			t.Errorf("wrong equation, set %d, exp %s, have %s", i, tt.expEq, eq)
+		}
+		r2 := rSquared(tt.xs, tt.ys, f)
+		if math.Abs(r2-tt.expR2) > 0.000001 {
+			t.Errorf("wrong R², set %d, exp %f, have %f", i, tt.expR2, r2)
+		}
+		y := f(tt.x)
+		if math.Abs(y-tt.expY) > 0.000001 {
+			t.Errorf("wrong value, set %d, exp %f, have %f", i, tt.expY, y)
+		}
+	}
+}
+
+func TestRegressionRSquared(t *testing.T) {
+	var tests = []struct {
+		xs    []float64
+		ys    []float64
+		f     func(x float64) float64
+		expR2 float64
+	}{
+		{[]float64{}, []float64{}, func(x float64) float64 { return x }, 0},
+		{[]float64{0, 1}, []float64{1, 1}, func(x float64) float64 { return 1 }, 1},
+		{[]float64{0, 1}, []float64{1, 1}, func(x float64) float64 { return 2 }, 0},
+		{[]float64{0, 1, 2}, []float64{0, 2, 4}, func(x float64) float64 { return 2 }, 0},
+		{[]float64{0, 1, 2}, []float64{0, 2, 4}, func(x float64) float64 { return x }, 0.375},
+	}
+	for i, tt := range tests {
+		r2 := rSquared(tt.xs, tt.ys, tt.f)
+		if math.Abs(r2-tt.expR2) > 0.000001 {
+			t.Errorf("wrong R², set %d, exp %f, have %f", i, tt.expR2, r2)
+		}
+	}
+}
+
+func TestRegressionRelease(t *testing.T) {
+	test.NewTempApp(t)
+	src := EmptyPointSeries("source", theme.ColorNamePrimary)
+	reg, err := EmptyRegressionSeries("fit", theme.ColorNamePrimary, src, false, RegressionLinear, 0, 0)
+	if err == nil {
+		err = reg.BindToChart(chartDummy{})
+	}
+	if err == nil {
+		err = src.AddNumericalData([]data.NumericalPoint{{N: 0, Val: 1}, {N: 1, Val: 3}})
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if reg.equation != "y = 2x + 1" {
+		t.Errorf("wrong equation, exp y = 2x + 1, have %s", reg.equation)
+	}
+	reg.Release()
+	err = src.AddNumericalData([]data.NumericalPoint{{N: 2, Val: 9}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if reg.equation != "y = 2x + 1" {
+		t.Errorf("released regression refitted, have %s", reg.equation)
+	}
+	err = reg.BindToChart(chartDummy{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if reg.equation == "y = 2x + 1" {
+		t.Errorf("regression not refitted after binding it again")
+	}
+}
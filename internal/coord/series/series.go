@@ -3,14 +3,22 @@ package series
 import (
 	"errors"
 	"image/color"
+	"math"
+	"sync"
 	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/internal/batch"
 	"github.com/s-daehling/fyne-charts/internal/interact"
 	"github.com/s-daehling/fyne-charts/internal/renderer"
 )
 
+type dataListener struct {
+	id string
+	f  func()
+}
+
 type baseSeries struct {
 	name        string
 	super       string
@@ -19,6 +27,9 @@ type baseSeries struct {
 	colName     fyne.ThemeColorName
 	legendEntry *interact.LegendEntry
 	cont        container
+	listeners   []dataListener
+	onRelease   func()
+	mu          sync.Mutex
 }
 
 func emptyBaseSeries(name string, colName fyne.ThemeColorName, togView func()) (ser baseSeries) {
@@ -40,21 +51,125 @@ func (ser *baseSeries) Name() (n string) {
 	return
 }
 
+// ColorName gives the theme color name of the series
+func (ser *baseSeries) ColorName() (colName fyne.ThemeColorName) {
+	colName = ser.colName
+	return
+}
+
+// Lock locks the data of the series
+// The chart locks all its series while it updates or renders them
+func (ser *baseSeries) Lock() {
+	ser.mu.Lock()
+}
+
+// Unlock unlocks the data of the series
+func (ser *baseSeries) Unlock() {
+	ser.mu.Unlock()
+}
+
 func (ser *baseSeries) BindToChart(ch container) (err error) {
+	ser.mu.Lock()
 	if ser.cont != nil {
+		ser.mu.Unlock()
 		err = errors.New("series is already part of a chart")
 		return
 	}
 	ser.cont = ch
+	ser.mu.Unlock()
 	ch.AddLegendEntry(ser.legendEntry)
 	return
 }
 
 func (ser *baseSeries) Release() {
-	if ser.cont != nil {
-		ser.cont.RemoveLegendEntry(ser.name, ser.super)
-	}
+	ser.mu.Lock()
+	cont := ser.cont
 	ser.cont = nil
+	ser.mu.Unlock()
+	if cont != nil {
+		cont.RemoveLegendEntry(ser.name, ser.super)
+	}
+	if ser.onRelease != nil {
+		ser.onRelease()
+		ser.onRelease = nil
+	}
+}
+
+// SetReleaseFunc registers a function that is called once when the series is released from its chart
+func (ser *baseSeries) SetReleaseFunc(f func()) {
+	ser.onRelease = f
+}
+
+// AddDataListener registers a function that is called every time the data of the series changes
+// An existing listener with the same id is replaced
+func (ser *baseSeries) AddDataListener(id string, f func()) {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
+	for i := range ser.listeners {
+		if ser.listeners[i].id == id {
+			ser.listeners[i].f = f
+			return
+		}
+	}
+	ser.listeners = append(ser.listeners, dataListener{id: id, f: f})
+}
+
+// RemoveDataListener removes the listener with the given id if it exists
+func (ser *baseSeries) RemoveDataListener(id string) {
+	ser.mu.Lock()
+	defer ser.mu.Unlock()
+	for i := range ser.listeners {
+		if ser.listeners[i].id == id {
+			ser.listeners = append(ser.listeners[:i], ser.listeners[i+1:]...)
+			return
+		}
+	}
+}
+
+// dataChanged notifies the data listeners and the chart on the main goroutine after the data has been changed
+// Must not be called while the series is locked
+func (ser *baseSeries) dataChanged() {
+	cont := ser.chart()
+	batch.RunOnMain(func() {
+		ser.notifyDataListeners()
+		if cont != nil {
+			cont.DataChange()
+		}
+	})
+}
+
+// notifyDataListeners calls the listeners without holding the lock, because they read the data of the series
+func (ser *baseSeries) notifyDataListeners() {
+	ser.mu.Lock()
+	ls := append([]dataListener(nil), ser.listeners...)
+	ser.mu.Unlock()
+	for i := range ls {
+		ls[i].f()
+	}
+}
+
+func (ser *baseSeries) chart() (ch container) {
+	ser.mu.Lock()
+	ch = ser.cont
+	ser.mu.Unlock()
+	return
+}
+
+// VisibleNRange gives the range of the from-axis of the chart in n
+// The range is empty (min > max) if the series is not part of a chart
+// Must not be called while the series is locked
+func (ser *baseSeries) VisibleNRange() (min float64, max float64) {
+	min, max = math.Inf(1), math.Inf(-1)
+	if cont := ser.chart(); cont != nil {
+		min, max = cont.VisibleFromNRange()
+	}
+	return
+}
+
+// inNRange reports whether n is within the range from min to max, including the limits
+func inNRange(n float64, min float64, max float64) (b bool) {
+	b = n >= min && n <= max
+	return
 }
 
 func (ser *baseSeries) HasChart() (b bool) {
@@ -133,6 +248,11 @@ func (ser *baseSeries) RasterColorPolar(phi float64, r float64, x float64, y flo
 	return
 }
 
+// RasterPolygons gives the areas that the series draws on the raster of the chart as polygons
+func (ser *baseSeries) RasterPolygons() (ps []renderer.Polygon) {
+	return
+}
+
 func (ser *baseSeries) IsPartOfChartRaster() (b bool) {
 	b = false
 	return
@@ -147,6 +267,8 @@ type Series interface {
 	Name() (n string)
 	BindToChart(ch container) (err error)
 	Release()
+	Lock()
+	Unlock()
 	Hide()
 	Show()
 	CRange() (cs []string)
@@ -165,6 +287,7 @@ type Series interface {
 	PolarTexts(phiMin float64, phiMax float64, rMin float64, rMax float64) (es []renderer.PolarText)
 	RasterColorCartesian(x float64, y float64) (col color.Color)
 	RasterColorPolar(phi float64, r float64, x float64, y float64) (col color.Color)
+	RasterPolygons() (ps []renderer.Polygon)
 	IsPartOfChartRaster() (b bool)
 	RefreshTheme()
 }
@@ -175,4 +298,5 @@ type container interface {
 	RasterRefresh()
 	AddLegendEntry(le *interact.LegendEntry)
 	RemoveLegendEntry(name string, super string)
+	VisibleFromNRange() (min float64, max float64)
 }
@@ -19,6 +19,7 @@ func (cd chartDummy) DataChange()                                 {}
 func (cd chartDummy) RasterRefresh()                     {}
 func (cd chartDummy) AddLegendEntry(le *interact.LegendEntry)     {}
 func (cd chartDummy) RemoveLegendEntry(name string, super string) {}
+func (cd chartDummy) VisibleFromNRange() (float64, float64)       { return 0, 10 }
 
 func testNRange(ser Series, expIsEmpty bool, expMin float64, expMax float64) (err error) {
 	isEmpty, min, max := ser.NRange()
@@ -3,10 +3,12 @@ package series
 import (
 	"errors"
 	"image/color"
+	"math"
 
 	"fyne.io/fyne/v2/theme"
 	"github.com/s-daehling/fyne-charts/internal/interact"
 	"github.com/s-daehling/fyne-charts/internal/renderer"
+	"github.com/s-daehling/fyne-charts/pkg/data"
 )
 
 type StackedSeries struct {
@@ -23,6 +25,36 @@ func EmptyStackedSeries(name string) (ser *StackedSeries) {
 	return
 }
 
+// Lock locks the stacked series and the data of all series in the stack
+func (ser *StackedSeries) Lock() {
+	ser.mu.Lock()
+	for i := range ser.stack {
+		ser.stack[i].Lock()
+	}
+}
+
+// Unlock unlocks the data of all series in the stack and the stacked series
+func (ser *StackedSeries) Unlock() {
+	for i := len(ser.stack) - 1; i >= 0; i-- {
+		ser.stack[i].Unlock()
+	}
+	ser.mu.Unlock()
+}
+
+// Stack gives the series in the stack; the stacked series must be locked
+func (ser *StackedSeries) Stack() (stack []*PointSeries) {
+	stack = append(stack, ser.stack...)
+	return
+}
+
+// stackedSeries gives a copy of the series in the stack
+func (ser *StackedSeries) stackedSeries() (stack []*PointSeries) {
+	ser.mu.Lock()
+	stack = append(stack, ser.stack...)
+	ser.mu.Unlock()
+	return
+}
+
 func (ser *StackedSeries) IsPolar() (b bool) {
 	if ser.cont != nil {
 		b = ser.cont.IsPolar()
@@ -54,6 +86,14 @@ func (ser *StackedSeries) RemoveLegendEntry(name string, super string) {
 	}
 }
 
+func (ser *StackedSeries) VisibleFromNRange() (min float64, max float64) {
+	min, max = math.Inf(1), math.Inf(-1)
+	if ser.cont != nil {
+		min, max = ser.cont.VisibleFromNRange()
+	}
+	return
+}
+
 func (ser *StackedSeries) CRange() (cs []string) {
 	for i := range ser.stack {
 		cats := ser.stack[i].CRange()
@@ -135,6 +175,16 @@ func (ser *StackedSeries) RasterColorPolar(phi float64, r float64, x float64, y
 	return
 }
 
+func (ser *StackedSeries) RasterPolygons() (ps []renderer.Polygon) {
+	if !ser.visible || !ser.IsPolar() {
+		return
+	}
+	for i := range ser.stack {
+		ps = append(ps, ser.stack[i].RasterPolygons()...)
+	}
+	return
+}
+
 func (ser *StackedSeries) IsPartOfChartRaster() (b bool) {
 	b = false
 	if ser.cont == nil || !ser.visible {
@@ -175,8 +225,8 @@ func (ser *StackedSeries) UpdateValOffset() {
 // Show makes the bars of the series visible
 func (ser *StackedSeries) Show() {
 	ser.visible = true
-	for i := range ser.stack {
-		ser.stack[i].Show()
+	for _, ps := range ser.stackedSeries() {
+		ps.Show()
 	}
 	ser.legendEntry.Show()
 }
@@ -184,8 +234,8 @@ func (ser *StackedSeries) Show() {
 // Hide hides the bars of the series
 func (ser *StackedSeries) Hide() {
 	ser.visible = false
-	for i := range ser.stack {
-		ser.stack[i].Hide()
+	for _, ps := range ser.stackedSeries() {
+		ps.Hide()
 	}
 	ser.legendEntry.Hide()
 }
@@ -203,27 +253,28 @@ func (ser *StackedSeries) BindToChart(ch container) (err error) {
 	if err != nil {
 		return
 	}
-	for i := range ser.stack {
-		ch.AddLegendEntry(ser.stack[i].legendEntry)
+	for _, ps := range ser.stackedSeries() {
+		ch.AddLegendEntry(ps.legendEntry)
 	}
 	return
 }
 
 func (ser *StackedSeries) Release() {
-	if ser.cont == nil {
+	cont := ser.chart()
+	if cont == nil {
 		return
 	}
-	for i := range ser.stack {
-		ser.cont.RemoveLegendEntry(ser.stack[i].name, ser.stack[i].super)
+	for _, ps := range ser.stackedSeries() {
+		cont.RemoveLegendEntry(ps.name, ps.super)
 	}
 	ser.baseSeries.Release()
 }
 
 func (ser *StackedSeries) Clear() {
+	ser.mu.Lock()
 	ser.stack = []*PointSeries{}
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.mu.Unlock()
+	ser.dataChanged()
 }
 
 // DeleteDataInRange deletes all data points with one of the given category
@@ -233,25 +284,29 @@ func (ser *StackedSeries) DeleteCategoricalDataInRange(cat []string) (c int) {
 	if len(cat) == 0 {
 		return
 	}
-	for i := range ser.stack {
-		c += ser.stack[i].DeleteCategoricalDataInRange(cat)
+	for _, ps := range ser.stackedSeries() {
+		c += ps.DeleteCategoricalDataInRange(cat)
 	}
 	return
 }
 
 func (ser *StackedSeries) RemovePointSeries(name string) {
 	newStack := make([]*PointSeries, 0)
+	released := make([]*PointSeries, 0)
+	ser.mu.Lock()
 	for i := range ser.stack {
 		if ser.stack[i].name != name {
 			newStack = append(newStack, ser.stack[i])
 		} else {
-			ser.stack[i].Release()
+			released = append(released, ser.stack[i])
 		}
 	}
 	ser.stack = newStack
-	if ser.cont != nil {
-		ser.cont.DataChange()
+	ser.mu.Unlock()
+	for i := range released {
+		released[i].Release()
 	}
+	ser.dataChanged()
 }
 
 func (ser *StackedSeries) AddPointSeries(ps *PointSeries) (err error) {
@@ -264,10 +319,10 @@ func (ser *StackedSeries) AddPointSeries(ps *PointSeries) (err error) {
 	if err != nil {
 		return
 	}
+	ser.mu.Lock()
 	ser.stack = append(ser.stack, ps)
-	if ser.cont != nil {
-		ser.cont.DataChange()
-	}
+	ser.mu.Unlock()
+	ser.dataChanged()
 	return
 }
 
@@ -281,3 +336,13 @@ func (ser *StackedSeries) seriesExist(name string) (exist bool) {
 	}
 	return
 }
+
+// CategoricalData gives the data points with n between nMin and nMax of each series in the stack by name
+// The stacked series must be locked
+func (ser *StackedSeries) CategoricalData(nMin float64, nMax float64) (ds map[string][]data.CategoricalPoint) {
+	ds = make(map[string][]data.CategoricalPoint)
+	for i := range ser.stack {
+		ds[ser.stack[i].Name()] = ser.stack[i].CategoricalData(nMin, nMax)
+	}
+	return
+}
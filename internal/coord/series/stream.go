@@ -0,0 +1,249 @@
+package series
+
+import (
+	"errors"
+	"time"
+
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+// SetNumericalStream limits the series to the newest capacity points whose N is within window of the newest point
+// capacity = 0 and window = 0 disable the respective limit
+func (ser *PointSeries) SetNumericalStream(capacity int, window float64) (err error) {
+	if capacity < 0 || window < 0 {
+		err = errors.New("invalid stream limits")
+		return
+	}
+	ser.mu.Lock()
+	ser.streamCap = capacity
+	ser.streamNWindow = window
+	k := ser.evict()
+	ser.mu.Unlock()
+	if k > 0 {
+		ser.dataChanged()
+	}
+	return
+}
+
+// SetTemporalStream limits the series to the newest capacity points whose T is within window of the newest point
+// capacity = 0 and window = 0 disable the respective limit
+func (ser *PointSeries) SetTemporalStream(capacity int, window time.Duration) (err error) {
+	if capacity < 0 || window < 0 {
+		err = errors.New("invalid stream limits")
+		return
+	}
+	ser.mu.Lock()
+	ser.streamCap = capacity
+	ser.streamTWindow = window
+	k := ser.evict()
+	ser.mu.Unlock()
+	if k > 0 {
+		ser.dataChanged()
+	}
+	return
+}
+
+// SetStreamScroll defines whether the range of the series is the window ending at the newest point
+// Scrolling is only effective if a window is set
+func (ser *PointSeries) SetStreamScroll(scroll bool) {
+	ser.mu.Lock()
+	ser.streamScroll = scroll
+	cont := ser.cont
+	ser.mu.Unlock()
+	if cont != nil {
+		cont.DataChange()
+	}
+}
+
+// StreamNumericalData appends points in ascending order of N and evicts the points outside of the stream limits
+// Evicted points are reused for new points
+func (ser *PointSeries) StreamNumericalData(input []data.NumericalPoint) (err error) {
+	if len(input) == 0 {
+		return
+	}
+	ser.mu.Lock()
+	err = ser.streamNumericalData(input)
+	ser.mu.Unlock()
+	if err != nil {
+		return
+	}
+	ser.dataChanged()
+	return
+}
+
+// streamNumericalData appends the input and evicts old points; the series must be locked
+func (ser *PointSeries) streamNumericalData(input []data.NumericalPoint) (err error) {
+	err = ser.checkStreamVals(len(input), func(i int) float64 { return input[i].Val })
+	if err != nil {
+		return
+	}
+	for i := range input {
+		if (i > 0 && input[i].N < input[i-1].N) ||
+			(i == 0 && len(ser.data) > 0 && input[i].N < ser.data[len(ser.data)-1].n) {
+			err = errors.New("data not in ascending order")
+			return
+		}
+	}
+	for i := range input {
+		dPoint := ser.streamPoint()
+		dPoint.n = input[i].N
+		dPoint.val = input[i].Val
+		if ser.showBar {
+			dPoint.setNBarWidthAndShift(ser.nBarWidth, ser.nBarWidth)
+		}
+		ser.streamAppend(dPoint)
+	}
+	ser.sampleDirty = true
+	ser.evict()
+	return
+}
+
+// StreamTemporalData appends points in chronological order and evicts the points outside of the stream limits
+// Evicted points are reused for new points
+func (ser *PointSeries) StreamTemporalData(input []data.TemporalPoint) (err error) {
+	if len(input) == 0 {
+		return
+	}
+	ser.mu.Lock()
+	err = ser.streamTemporalData(input)
+	ser.mu.Unlock()
+	if err != nil {
+		return
+	}
+	ser.dataChanged()
+	return
+}
+
+// streamTemporalData appends the input and evicts old points; the series must be locked
+func (ser *PointSeries) streamTemporalData(input []data.TemporalPoint) (err error) {
+	err = ser.checkStreamVals(len(input), func(i int) float64 { return input[i].Val })
+	if err != nil {
+		return
+	}
+	for i := range input {
+		if (i > 0 && input[i].T.Before(input[i-1].T)) ||
+			(i == 0 && len(ser.data) > 0 && input[i].T.Before(ser.data[len(ser.data)-1].t)) {
+			err = errors.New("data not in chronological order")
+			return
+		}
+	}
+	for i := range input {
+		dPoint := ser.streamPoint()
+		dPoint.t = input[i].T
+		dPoint.val = input[i].Val
+		if ser.showBar {
+			dPoint.setTBarWidthAndShift(ser.tBarWidth, ser.tBarWidth)
+		}
+		ser.streamAppend(dPoint)
+	}
+	ser.sampleDirty = true
+	ser.evict()
+	return
+}
+
+func (ser *PointSeries) checkStreamVals(n int, val func(i int) float64) (err error) {
+	if ser.cont == nil || !(ser.cont.IsPolar() || ser.isStacked) {
+		return
+	}
+	for i := 0; i < n; i++ {
+		if val(i) < 0 {
+			err = errors.New("negative val not allowed")
+			return
+		}
+	}
+	return
+}
+
+// streamPoint gives a spare point if one exists, otherwise a new point
+// Spare points do not own canvas objects, so they take the style of the series once they are drawn
+func (ser *PointSeries) streamPoint() (point *dataPoint) {
+	if len(ser.spare) == 0 {
+		point = emptyDataPoint(ser.showDot, ser.showFromValBaseLine, ser.showFromPrevLine, ser.showBar)
+	} else {
+		point = ser.spare[len(ser.spare)-1]
+		ser.spare = ser.spare[:len(ser.spare)-1]
+		point.showDot = ser.showDot
+		point.showFromValBaseLine = ser.showFromValBaseLine
+		point.showFromPrevLine = ser.showFromPrevLine
+		point.showBar = ser.showBar
+	}
+	if ser.showFromValBaseLine {
+		point.setValBase(ser.valBase)
+	}
+	return
+}
+
+// streamAppend appends point to the data in the stream buffer
+// The data is a window of the buffer that moves to the end as points are appended and evicted; once the end is
+// reached, the data is moved back to the start of the buffer if at least half of it is free and the buffer is
+// doubled otherwise. Like this, appending is amortized constant time and does not allocate once the buffer
+// has grown to twice the number of points kept by the stream
+func (ser *PointSeries) streamAppend(point *dataPoint) {
+	if len(ser.data) == cap(ser.data) {
+		head, ok := ser.streamHead()
+		if !ok || head < len(ser.data) {
+			ser.streamBuf = make([]*dataPoint, 2*len(ser.data)+1)
+		}
+		n := copy(ser.streamBuf, ser.data)
+		clear(ser.streamBuf[n:])
+		ser.data = ser.streamBuf[:n]
+	}
+	ser.data = append(ser.data, point)
+}
+
+// streamHead gives the position of the first point of the data in the stream buffer
+// ok is false if the data does not lie at the end of the stream buffer, e.g. because it has been replaced
+func (ser *PointSeries) streamHead() (head int, ok bool) {
+	c, bc := cap(ser.data), cap(ser.streamBuf)
+	if c == 0 || c > bc {
+		return
+	}
+	// both slices end at the same element if the data is a window of the buffer
+	ok = &ser.data[:c][c-1] == &ser.streamBuf[:bc][bc-1]
+	head = bc - c
+	return
+}
+
+// evict moves the points outside of the stream limits to the spare points
+// Only the oldest points are compared with the limits and the data is advanced in the stream buffer, so that
+// evicting k points takes O(k) time
+func (ser *PointSeries) evict() (k int) {
+	last := len(ser.data) - 1
+	var outside func(i int) bool
+	switch {
+	case last < 0:
+	case ser.streamTWindow > 0:
+		start := ser.data[last].t.Add(-ser.streamTWindow)
+		outside = func(i int) bool { return ser.data[i].t.Before(start) }
+	case ser.streamNWindow > 0:
+		start := ser.data[last].n - ser.streamNWindow
+		outside = func(i int) bool { return ser.data[i].n < start }
+	}
+	k = streamEvictions(len(ser.data), ser.streamCap, outside)
+	if k == 0 {
+		return
+	}
+	for i := range k {
+		ser.data[i].detach()
+	}
+	ser.spare = append(ser.spare, ser.data[:k]...)
+	clear(ser.data[:k])
+	ser.data = ser.data[k:]
+	ser.sampleDirty = true
+	return
+}
+
+// streamEvictions gives the number of leading points to remove from n points in ascending order
+// so that at most capacity points remain and no point is outside of the window of the newest point
+// capacity = 0 disables the limit of the number of points and outside = nil the window
+func streamEvictions(n int, capacity int, outside func(i int) bool) (k int) {
+	if capacity > 0 && n > capacity {
+		k = n - capacity
+	}
+	if outside != nil {
+		for k < n && outside(k) {
+			k++
+		}
+	}
+	return
+}
@@ -0,0 +1,165 @@
+package series
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+func TestStreamEvictions(t *testing.T) {
+	var tests = []struct {
+		keys     []float64
+		capacity int
+		window   float64
+		expK     int
+	}{
+		{[]float64{0, 1, 2, 3}, 0, 0, 0},
+		{[]float64{0, 1, 2, 3}, 2, 0, 2},
+		{[]float64{0, 1, 2, 3}, 5, 0, 0},
+		{[]float64{0, 1, 2, 3}, 0, 1.5, 2},
+		{[]float64{0, 1, 2, 3}, 0, 2, 1},
+		{[]float64{0, 1, 2, 3}, 3, 1.5, 2},
+		{[]float64{0, 1, 2, 3}, 1, 1.5, 3},
+		{[]float64{}, 2, 1, 0},
+	}
+	for i, tt := range tests {
+		var outside func(j int) bool
+		if tt.window > 0 {
+			outside = func(j int) bool { return tt.keys[j] < tt.keys[len(tt.keys)-1]-tt.window }
+		}
+		k := streamEvictions(len(tt.keys), tt.capacity, outside)
+		if k != tt.expK {
+			t.Errorf("wrong number of evictions, set %d, exp %d, have %d", i, tt.expK, k)
+		}
+	}
+}
+
+func TestStreamData(t *testing.T) {
+	test.NewTempApp(t)
+
+	// eviction by capacity
+	ser := EmptyPointSeries("s", theme.ColorNamePrimary)
+	err := ser.SetNumericalStream(3, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for n := range 4 {
+		err = ser.StreamNumericalData([]data.NumericalPoint{{N: float64(n), Val: 1}})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	for n := 4; n < 10; n++ {
+		// points are evicted after the new point is appended, so the point evicted before is reused
+		var spare *dataPoint
+		if len(ser.spare) == 1 {
+			spare = ser.spare[0]
+		}
+		err = ser.StreamNumericalData([]data.NumericalPoint{{N: float64(n), Val: 1}})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(ser.data) != 3 || ser.data[0].n != float64(n-2) || ser.data[2].n != float64(n) {
+			t.Errorf("wrong data after streaming point %d", n)
+			continue
+		}
+		// the data moves in a buffer that has room for twice the points of the stream
+		if head, ok := ser.streamHead(); !ok || head+len(ser.data) > cap(ser.streamBuf) || cap(ser.streamBuf) > 9 {
+			t.Errorf("data not in the stream buffer after streaming point %d", n)
+		}
+		if spare == nil || ser.data[2] != spare || len(ser.spare) != 1 {
+			t.Errorf("spare point not reused after streaming point %d", n)
+		}
+	}
+	err = ser.StreamNumericalData([]data.NumericalPoint{{N: 5, Val: 1}})
+	if err == nil {
+		t.Errorf("expected error for data in wrong order")
+	}
+
+	// eviction by time window
+	ser = EmptyPointSeries("s", theme.ColorNamePrimary)
+	err = ser.SetTemporalStream(0, 2*time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var tests = []struct {
+		offsets  []time.Duration
+		expFirst time.Duration
+		expLen   int
+		expTotal int
+	}{
+		{[]time.Duration{0, time.Second}, 0, 2, 2},
+		{[]time.Duration{2 * time.Second}, 0, 3, 3},
+		{[]time.Duration{3 * time.Second}, time.Second, 3, 4},
+		{[]time.Duration{10 * time.Second, 11 * time.Second}, 10 * time.Second, 2, 5},
+	}
+	for i, tt := range tests {
+		input := make([]data.TemporalPoint, len(tt.offsets))
+		for j := range tt.offsets {
+			input[j] = data.TemporalPoint{T: t0.Add(tt.offsets[j]), Val: 1}
+		}
+		err = ser.StreamTemporalData(input)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(ser.data) != tt.expLen || !ser.data[0].t.Equal(t0.Add(tt.expFirst)) {
+			t.Errorf("wrong data, set %d, exp %d points from %s, have %d", i, tt.expLen, tt.expFirst, len(ser.data))
+		}
+		// only points that cannot be taken from the spare points are allocated
+		if len(ser.data)+len(ser.spare) != tt.expTotal {
+			t.Errorf("wrong number of points and spare points, set %d, exp %d, have %d", i, tt.expTotal,
+				len(ser.data)+len(ser.spare))
+		}
+	}
+}
+
+// streamer gives a series with a stream of capacity points and a function that appends one point to it
+func streamer(tb testing.TB, capacity int) (ser *PointSeries, stream func()) {
+	ser = EmptyPointSeries("s", theme.ColorNamePrimary)
+	err := ser.SetNumericalStream(capacity, 0)
+	if err != nil {
+		tb.Fatalf("unexpected error: %s", err)
+	}
+	input := make([]data.NumericalPoint, 1)
+	n := 0
+	stream = func() {
+		input[0] = data.NumericalPoint{N: float64(n), Val: 1}
+		n++
+		ser.StreamNumericalData(input)
+	}
+	// fill the stream and let the buffer grow to its final size
+	for range 3 * capacity {
+		stream()
+	}
+	return
+}
+
+func TestStreamAllocations(t *testing.T) {
+	test.NewTempApp(t)
+	var allocs []float64
+	for _, capacity := range []int{100, 10000, 100000} {
+		_, stream := streamer(t, capacity)
+		allocs = append(allocs, testing.AllocsPerRun(1000, stream))
+	}
+	// the allocations per point do not depend on the number of points of the stream
+	for i := range allocs {
+		if allocs[i] != allocs[0] {
+			t.Errorf("allocations depend on the capacity of the stream: %v", allocs)
+			break
+		}
+	}
+}
+
+func BenchmarkStream100k(b *testing.B) {
+	test.NewTempApp(b)
+	_, stream := streamer(b, 100000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		stream()
+	}
+}
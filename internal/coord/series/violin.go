@@ -0,0 +1,615 @@
+package series
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"sort"
+
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+type violinPoint struct {
+	c          string
+	n          float64
+	samples    []float64
+	vals       []float64
+	halfWidths []float64
+	firstQuart float64
+	median     float64
+	thirdQuart float64
+	width      float64
+	leftLines  []*canvas.Line
+	rightLines []*canvas.Line
+	box        *canvas.Rectangle
+	medianDot  *canvas.Circle
+	showBox    bool
+}
+
+func emptyViolinPoint(samples []float64, col color.Color, showBox bool) (point *violinPoint) {
+	point = &violinPoint{
+		samples:   append([]float64{}, samples...),
+		box:       canvas.NewRectangle(col),
+		medianDot: canvas.NewCircle(theme.Color(theme.ColorNameBackground)),
+		showBox:   showBox,
+	}
+	sort.Float64s(point.samples)
+	for range kdeResolution - 1 {
+		point.leftLines = append(point.leftLines, canvas.NewLine(col))
+		point.rightLines = append(point.rightLines, canvas.NewLine(col))
+	}
+	point.medianDot.Resize(fyne.NewSize(5, 5))
+	if !showBox {
+		point.box.Hide()
+		point.medianDot.Hide()
+	}
+	return
+}
+
+// estimate calculates the density shape and the quartiles of the samples
+// A bandwidth of 0 selects the bandwidth automatically
+func (point *violinPoint) estimate(bw float64) {
+	h := bw
+	if h == 0 {
+		h = silvermanBandwidth(point.samples)
+	}
+	point.vals = densityGrid(point.samples, h, kdeResolution)
+	dens := kernelDensity(point.samples, h, point.vals)
+	maxDens := 0.0
+	for i := range dens {
+		maxDens = math.Max(maxDens, dens[i])
+	}
+	point.halfWidths = make([]float64, len(dens))
+	for i := range dens {
+		if maxDens > 0 {
+			point.halfWidths[i] = dens[i] / maxDens
+		}
+	}
+	point.firstQuart = quantile(point.samples, 0.25)
+	point.median = quantile(point.samples, 0.5)
+	point.thirdQuart = quantile(point.samples, 0.75)
+}
+
+func (point *violinPoint) refresh() {
+	for i := range point.leftLines {
+		point.leftLines[i].Refresh()
+		point.rightLines[i].Refresh()
+	}
+	point.box.Refresh()
+	point.medianDot.Refresh()
+}
+
+func (point *violinPoint) hide() {
+	for i := range point.leftLines {
+		point.leftLines[i].Hide()
+		point.rightLines[i].Hide()
+	}
+	point.box.Hide()
+	point.medianDot.Hide()
+}
+
+func (point *violinPoint) show() {
+	for i := range point.leftLines {
+		point.leftLines[i].Show()
+		point.rightLines[i].Show()
+	}
+	if point.showBox {
+		point.box.Show()
+		point.medianDot.Show()
+	}
+}
+
+func (point *violinPoint) setColor(col color.Color) {
+	for i := range point.leftLines {
+		point.leftLines[i].StrokeColor = col
+		point.rightLines[i].StrokeColor = col
+	}
+	point.box.FillColor = col
+	point.medianDot.FillColor = theme.Color(theme.ColorNameBackground)
+}
+
+func (point *violinPoint) setLineWidth(lw float32) {
+	for i := range point.leftLines {
+		point.leftLines[i].StrokeWidth = lw
+		point.rightLines[i].StrokeWidth = lw
+	}
+}
+
+func (point *violinPoint) setWidth(width float64) {
+	point.width = width
+}
+
+func (point *violinPoint) inRange(xMin float64, xMax float64, yMin float64, yMax float64) (b bool) {
+	b = len(point.vals) > 0 && point.n >= xMin && point.n <= xMax &&
+		point.vals[0] >= yMin && point.vals[len(point.vals)-1] <= yMax
+	return
+}
+
+func (point *violinPoint) cartesianNodes(xMin float64, xMax float64, yMin float64,
+	yMax float64) (ns []renderer.CartesianNode) {
+	if !point.inRange(xMin, xMax, yMin, yMax) {
+		return
+	}
+	ns = append(ns, renderer.CartesianNode{
+		X:   point.n,
+		Y:   point.median,
+		Dot: point.medianDot,
+	})
+	return
+}
+
+func (point *violinPoint) cartesianEdges(xMin float64, xMax float64, yMin float64,
+	yMax float64) (es []renderer.CartesianEdge) {
+	if !point.inRange(xMin, xMax, yMin, yMax) {
+		return
+	}
+	for i := range point.leftLines {
+		w1 := point.halfWidths[i] * point.width / 2
+		w2 := point.halfWidths[i+1] * point.width / 2
+		es = append(es, renderer.CartesianEdge{
+			X1:   point.n - w1,
+			Y1:   point.vals[i],
+			X2:   point.n - w2,
+			Y2:   point.vals[i+1],
+			Line: point.leftLines[i],
+		}, renderer.CartesianEdge{
+			X1:   point.n + w1,
+			Y1:   point.vals[i],
+			X2:   point.n + w2,
+			Y2:   point.vals[i+1],
+			Line: point.rightLines[i],
+		})
+	}
+	return
+}
+
+func (point *violinPoint) cartesianRects(xMin float64, xMax float64,
+	yMin float64, yMax float64) (as []renderer.CartesianRect) {
+	if !point.inRange(xMin, xMax, yMin, yMax) {
+		return
+	}
+	as = append(as, renderer.CartesianRect{
+		X1:   point.n - (point.width / 16),
+		Y1:   point.firstQuart,
+		X2:   point.n + (point.width / 16),
+		Y2:   point.thirdQuart,
+		Rect: point.box,
+	})
+	return
+}
+
+// contains checks if (x,y) lies inside of the density shape
+func (point *violinPoint) contains(x float64, y float64) (b bool) {
+	if len(point.vals) < 2 || y < point.vals[0] || y > point.vals[len(point.vals)-1] {
+		return
+	}
+	step := (point.vals[len(point.vals)-1] - point.vals[0]) / float64(len(point.vals)-1)
+	i := int((y - point.vals[0]) / step)
+	if i >= len(point.vals)-1 {
+		i = len(point.vals) - 2
+	}
+	f := (y - point.vals[i]) / step
+	hw := (point.halfWidths[i] + (f * (point.halfWidths[i+1] - point.halfWidths[i]))) * point.width / 2
+	b = math.Abs(x-point.n) <= hw
+	return
+}
+
+// outline gives the density shape as polygon; ok is false if there is no shape
+func (point *violinPoint) outline() (p renderer.Polygon, ok bool) {
+	if len(point.vals) < 2 {
+		return
+	}
+	for i := range point.vals {
+		p.X = append(p.X, point.n+point.halfWidths[i]*point.width/2)
+		p.Y = append(p.Y, point.vals[i])
+	}
+	for i := len(point.vals) - 1; i >= 0; i-- {
+		p.X = append(p.X, point.n-point.halfWidths[i]*point.width/2)
+		p.Y = append(p.Y, point.vals[i])
+	}
+	ok = true
+	return
+}
+
+// ViolinSeries shows the kernel density estimate of samples as mirrored shape at each position
+type ViolinSeries struct {
+	baseSeries
+	data      []*violinPoint
+	bandwidth float64
+	showBox   bool
+}
+
+func EmptyViolinSeries(name string, colName fyne.ThemeColorName) (ser *ViolinSeries) {
+	ser = &ViolinSeries{
+		bandwidth: 0,
+		showBox:   true,
+	}
+	ser.baseSeries = emptyBaseSeries(name, colName, ser.toggleView)
+	return
+}
+
+func (ser *ViolinSeries) CRange() (cs []string) {
+	for i := range ser.data {
+		cs = append(cs, ser.data[i].c)
+	}
+	return
+}
+
+func (ser *ViolinSeries) NRange() (isEmpty bool, min float64, max float64) {
+	isEmpty = false
+	if len(ser.data) == 0 {
+		isEmpty = true
+		return
+	}
+	min = ser.data[0].n
+	max = ser.data[0].n
+	for i := range ser.data {
+		if ser.data[i].n < min {
+			min = ser.data[i].n
+		}
+		if ser.data[i].n > max {
+			max = ser.data[i].n
+		}
+	}
+	return
+}
+
+func (ser *ViolinSeries) ValRange() (isEmpty bool, min float64, max float64) {
+	isEmpty = true
+	for i := range ser.data {
+		vals := ser.data[i].vals
+		if len(vals) == 0 {
+			continue
+		}
+		if isEmpty {
+			isEmpty = false
+			min = vals[0]
+			max = vals[len(vals)-1]
+		}
+		if vals[0] < min {
+			min = vals[0]
+		}
+		if vals[len(vals)-1] > max {
+			max = vals[len(vals)-1]
+		}
+	}
+	return
+}
+
+func (ser *ViolinSeries) ConvertCtoN(cToN func(c string) (n float64)) {
+	for i := range ser.data {
+		ser.data[i].n = cToN(ser.data[i].c)
+	}
+}
+
+func (ser *ViolinSeries) CartesianNodes(xMin float64, xMax float64, yMin float64,
+	yMax float64) (ns []renderer.CartesianNode) {
+	for i := range ser.data {
+		ns = append(ns, ser.data[i].cartesianNodes(xMin, xMax, yMin, yMax)...)
+	}
+	return
+}
+
+func (ser *ViolinSeries) CartesianEdges(xMin float64, xMax float64, yMin float64,
+	yMax float64) (es []renderer.CartesianEdge) {
+	for i := range ser.data {
+		es = append(es, ser.data[i].cartesianEdges(xMin, xMax, yMin, yMax)...)
+	}
+	return
+}
+
+func (ser *ViolinSeries) CartesianRects(xMin float64, xMax float64, yMin float64,
+	yMax float64) (as []renderer.CartesianRect) {
+	for i := range ser.data {
+		as = append(as, ser.data[i].cartesianRects(xMin, xMax, yMin, yMax)...)
+	}
+	return
+}
+
+func (ser *ViolinSeries) RasterColorCartesian(x float64, y float64) (col color.Color) {
+	col = ser.baseSeries.RasterColorCartesian(x, y)
+	if !ser.visible {
+		return
+	}
+	for i := range ser.data {
+		if ser.data[i].contains(x, y) {
+			r, g, b, _ := ser.col.RGBA()
+			col = color.RGBA64{R: uint16(r), G: uint16(g), B: uint16(b), A: 0x8888}
+			return
+		}
+	}
+	return
+}
+
+func (ser *ViolinSeries) RasterPolygons() (ps []renderer.Polygon) {
+	if !ser.visible {
+		return
+	}
+	for i := range ser.data {
+		if p, ok := ser.data[i].outline(); ok {
+			p.Col = renderer.FillColor(ser.col, 0x88)
+			ps = append(ps, p)
+		}
+	}
+	return
+}
+
+func (ser *ViolinSeries) IsPartOfChartRaster() (b bool) {
+	b = ser.visible && ser.cont != nil && !ser.cont.IsPolar()
+	return
+}
+
+func (ser *ViolinSeries) RefreshTheme() {
+	ser.col = theme.Color(ser.colName)
+	for i := range ser.data {
+		ser.data[i].setColor(ser.col)
+	}
+}
+
+func (ser *ViolinSeries) BindToChart(ch container) (err error) {
+	if ch.IsPolar() {
+		err = errors.New("violin series can not be added to polar charts")
+		return
+	}
+	err = ser.baseSeries.BindToChart(ch)
+	return
+}
+
+// SetWidth sets the maximum width of the violins of this series
+func (ser *ViolinSeries) SetWidth(width float64) {
+	for i := range ser.data {
+		ser.data[i].setWidth(width)
+	}
+}
+
+func (ser *ViolinSeries) NumberOfPoints() (n int) {
+	n = len(ser.data)
+	return
+}
+
+// Show makes all elements of the series visible
+func (ser *ViolinSeries) Show() {
+	ser.visible = true
+	for i := range ser.data {
+		ser.data[i].show()
+	}
+	ser.legendEntry.Show()
+}
+
+// Hide hides all elements of the series
+func (ser *ViolinSeries) Hide() {
+	ser.visible = false
+	for i := range ser.data {
+		ser.data[i].hide()
+	}
+	ser.legendEntry.Hide()
+}
+
+func (ser *ViolinSeries) toggleView() {
+	if ser.visible {
+		ser.Hide()
+	} else {
+		ser.Show()
+	}
+	if ser.cont != nil {
+		ser.cont.RasterRefresh()
+	}
+}
+
+// SetColor changes the color of the violin series
+func (ser *ViolinSeries) SetColor(colName fyne.ThemeColorName) {
+	ser.colName = colName
+	ser.col = theme.Color(ser.colName)
+	ser.legendEntry.SetColor(colName)
+	for i := range ser.data {
+		ser.data[i].setColor(ser.col)
+		ser.data[i].refresh()
+	}
+	if ser.cont != nil {
+		ser.cont.RasterRefresh()
+	}
+}
+
+// SetLineWidth changes the width of the outline
+// Standard value is 1
+// The provided width must be greater than zero for this method to take effect
+func (ser *ViolinSeries) SetLineWidth(lw float32) {
+	if lw < 0 {
+		return
+	}
+	for i := range ser.data {
+		ser.data[i].setLineWidth(lw)
+		ser.data[i].refresh()
+	}
+}
+
+// SetBandwidth changes the bandwidth of the gaussian kernel
+// A bandwidth of 0 selects the bandwidth automatically (Silverman's rule of thumb)
+func (ser *ViolinSeries) SetBandwidth(bw float64) (err error) {
+	if bw < 0 || math.IsNaN(bw) || math.IsInf(bw, 0) {
+		err = errors.New("invalid bandwidth")
+		return
+	}
+	ser.bandwidth = bw
+	for i := range ser.data {
+		ser.data[i].estimate(bw)
+	}
+	if ser.cont != nil {
+		ser.cont.DataChange()
+	}
+	return
+}
+
+// SetBoxVisibility defines whether a mini box with quartiles and median is displayed inside the violins
+func (ser *ViolinSeries) SetBoxVisibility(show bool) {
+	ser.showBox = show
+	for i := range ser.data {
+		ser.data[i].showBox = show
+		if show && ser.visible {
+			ser.data[i].box.Show()
+			ser.data[i].medianDot.Show()
+		} else {
+			ser.data[i].box.Hide()
+			ser.data[i].medianDot.Hide()
+		}
+	}
+}
+
+func (ser *ViolinSeries) Clear() {
+	ser.mu.Lock()
+	ser.data = []*violinPoint{}
+	ser.mu.Unlock()
+	ser.dataChanged()
+}
+
+func (ser *ViolinSeries) newPoint(samples []float64) (point *violinPoint) {
+	point = emptyViolinPoint(samples, ser.col, ser.showBox)
+	point.estimate(ser.bandwidth)
+	if !ser.visible {
+		point.hide()
+	}
+	return
+}
+
+// DeleteNumericalDataInRange deletes all violins with a x-coordinate greater than min and smaller than max
+// The return value gives the number of violins that have been removed
+func (ser *ViolinSeries) DeleteNumericalDataInRange(min float64, max float64) (c int) {
+	c = 0
+	if min > max {
+		return
+	}
+	ser.mu.Lock()
+	finalData := []*violinPoint{}
+	for i := range ser.data {
+		if ser.data[i].n > min && ser.data[i].n < max {
+			c++
+		} else {
+			finalData = append(finalData, ser.data[i])
+		}
+	}
+	if c == 0 {
+		ser.mu.Unlock()
+		return
+	}
+	ser.data = finalData
+	ser.mu.Unlock()
+	ser.dataChanged()
+	return
+}
+
+// AddNumericalData adds violins to the series.
+// The method does not check for duplicates (i.e. violins with same N)
+func (ser *ViolinSeries) AddNumericalData(input []data.NumericalSamples) (err error) {
+	if len(input) == 0 {
+		return
+	}
+	for i := range input {
+		if len(input[i].Samples) == 0 || !validSamples(input[i].Samples) {
+			err = errors.New("invalid data")
+			return
+		}
+	}
+	ser.mu.Lock()
+	for i := range input {
+		vPoint := ser.newPoint(input[i].Samples)
+		vPoint.n = input[i].N
+		ser.data = append(ser.data, vPoint)
+	}
+	ser.mu.Unlock()
+	ser.dataChanged()
+	return
+}
+
+// DeleteCategoricalDataInRange deletes all violins with one of the given category
+// The return value gives the number of violins that have been removed
+func (ser *ViolinSeries) DeleteCategoricalDataInRange(cat []string) (c int) {
+	c = 0
+	if len(cat) == 0 {
+		return
+	}
+	ser.mu.Lock()
+	finalData := []*violinPoint{}
+	for i := range ser.data {
+		del := false
+		for j := range cat {
+			if ser.data[i].c == cat[j] {
+				del = true
+				break
+			}
+		}
+		if del {
+			c++
+		} else {
+			finalData = append(finalData, ser.data[i])
+		}
+	}
+	if c == 0 {
+		ser.mu.Unlock()
+		return
+	}
+	ser.data = finalData
+	ser.mu.Unlock()
+	ser.dataChanged()
+	return
+}
+
+// AddCategoricalData adds violins to the series.
+// The method checks for duplicates (i.e. violins with same C).
+// Violins with a C that already exists, will be ignored.
+func (ser *ViolinSeries) AddCategoricalData(input []data.CategoricalSamples) (err error) {
+	if len(input) == 0 {
+		return
+	}
+	for i := range input {
+		if len(input[i].Samples) == 0 || !validSamples(input[i].Samples) {
+			err = errors.New("invalid data")
+			return
+		}
+	}
+	ser.mu.Lock()
+	for i := range input {
+		catExist := false
+		for j := range ser.data {
+			if input[i].C == ser.data[j].c {
+				catExist = true
+				break
+			}
+		}
+		if catExist {
+			continue
+		}
+		vPoint := ser.newPoint(input[i].Samples)
+		vPoint.c = input[i].C
+		ser.data = append(ser.data, vPoint)
+	}
+	ser.mu.Unlock()
+	ser.dataChanged()
+	return
+}
+
+// NumericalData gives the samples of the violins with n between nMin and nMax in ascending order
+// The series must be locked
+func (ser *ViolinSeries) NumericalData(nMin float64, nMax float64) (ss []data.NumericalSamples) {
+	for _, p := range ser.data {
+		if inNRange(p.n, nMin, nMax) {
+			ss = append(ss, data.NumericalSamples{N: p.n, Samples: append([]float64(nil), p.samples...)})
+		}
+	}
+	return
+}
+
+// CategoricalData gives the samples of the violins with n between nMin and nMax in ascending order
+// The series must be locked
+func (ser *ViolinSeries) CategoricalData(nMin float64, nMax float64) (ss []data.CategoricalSamples) {
+	for _, p := range ser.data {
+		if inNRange(p.n, nMin, nMax) {
+			ss = append(ss, data.CategoricalSamples{C: p.c, Samples: append([]float64(nil), p.samples...)})
+		}
+	}
+	return
+}
@@ -0,0 +1,71 @@
+package series
+
+import (
+	"math"
+	"testing"
+)
+
+func TestViolinQuantile(t *testing.T) {
+	var tests = []struct {
+		sorted []float64
+		q      float64
+		expVal float64
+	}{
+		{[]float64{}, 0.5, 0},
+		{[]float64{3}, 0.25, 3},
+		{[]float64{1, 2, 3, 4, 5}, 0.5, 3},
+		{[]float64{1, 2, 3, 4}, 0.5, 2.5},
+		{[]float64{1, 2, 3, 4, 5}, 0.25, 2},
+		{[]float64{1, 2, 3, 4, 5}, 1, 5},
+	}
+	for i, tt := range tests {
+		v := quantile(tt.sorted, tt.q)
+		if math.Abs(v-tt.expVal) > 0.000001 {
+			t.Errorf("wrong quantile, set %d, exp %f, have %f", i, tt.expVal, v)
+		}
+	}
+}
+
+func TestViolinBandwidth(t *testing.T) {
+	var tests = []struct {
+		sorted []float64
+		expH   float64
+	}{
+		{[]float64{}, 0},
+		{[]float64{2, 2, 2}, 1},
+		{[]float64{-50, -50}, 5},
+		{[]float64{-1, 1}, 0.9 * (1 / 1.34) * math.Pow(2, -0.2)},
+	}
+	for i, tt := range tests {
+		h := silvermanBandwidth(tt.sorted)
+		if math.Abs(h-tt.expH) > 0.000001 {
+			t.Errorf("wrong bandwidth, set %d, exp %f, have %f", i, tt.expH, h)
+		}
+	}
+}
+
+func TestViolinDensity(t *testing.T) {
+	var tests = []struct {
+		samples []float64
+		h       float64
+	}{
+		{[]float64{0}, 1},
+		{[]float64{-2, 0, 0, 3}, 0.5},
+		{[]float64{10, 20}, 2},
+	}
+	for i, tt := range tests {
+		grid := densityGrid(tt.samples, tt.h, 1000)
+		if grid[0] != tt.samples[0]-(3*tt.h) || grid[len(grid)-1] != tt.samples[len(tt.samples)-1]+(3*tt.h) {
+			t.Errorf("wrong grid range, set %d", i)
+		}
+		dens := kernelDensity(tt.samples, tt.h, grid)
+		// the density must integrate to approx. 1 (within the grid range 99.7% of a gaussian kernel)
+		integral := 0.0
+		for j := 1; j < len(grid); j++ {
+			integral += (grid[j] - grid[j-1]) * (dens[j] + dens[j-1]) / 2
+		}
+		if integral < 0.99 || integral > 1.0 {
+			t.Errorf("wrong density integral, set %d, have %f", i, integral)
+		}
+	}
+}
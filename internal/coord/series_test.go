@@ -0,0 +1,124 @@
+package coord
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/internal/coord/series"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+// visibleData reads the data of ser in the visible range of its chart like the public series do
+func visibleData[T any](ser *series.PointSeries, get func(nMin float64, nMax float64) T) (d T) {
+	nMin, nMax := ser.VisibleNRange()
+	ser.Lock()
+	d = get(nMin, nMax)
+	ser.Unlock()
+	return
+}
+
+func TestVisibleData(t *testing.T) {
+	test.NewTempApp(t)
+	base := vectorChart(t)
+	ps := base.series[0].(*series.PointSeries)
+	cs := series.EmptyCandleStickSeries("candles")
+	err := base.AddCandleStickSeries(cs)
+	if err == nil {
+		err = cs.AddNumericalData([]data.NumericalCandleStick{
+			{NStart: 0, NEnd: 1, Open: 1, Close: 2, Low: 0, High: 3},
+			{NStart: 1.5, NEnd: 2.5, Open: 2, Close: 1, Low: 0, High: 3},
+			{NStart: 7, NEnd: 8, Open: 1, Close: 2, Low: 0, High: 3},
+		})
+	}
+	if err == nil {
+		err = base.SetFromNRange(2, 6)
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if min, max := ps.VisibleNRange(); min != 2 || max != 6 {
+		t.Errorf("wrong visible range, exp 2-6, have %f-%f", min, max)
+	}
+	expPoints := []data.NumericalPoint{{N: 5, Val: 3}}
+	if points := visibleData(ps, ps.NumericalData); !reflect.DeepEqual(points, expPoints) {
+		t.Errorf("wrong visible points, exp %v, have %v", expPoints, points)
+	}
+	if points := ps.NumericalData(math.Inf(-1), math.Inf(1)); len(points) != 3 {
+		t.Errorf("wrong number of points, exp 3, have %d", len(points))
+	}
+	nMin, nMax := cs.VisibleNRange()
+	candles := cs.NumericalData(nMin, nMax)
+	if len(candles) != 1 || candles[0].NStart != 1.5 {
+		t.Errorf("wrong visible candles, have %v", candles)
+	}
+
+	// series without chart have no visible data
+	free := series.EmptyPointSeries("free", theme.ColorNamePrimary)
+	err = free.AddNumericalData([]data.NumericalPoint{{N: 3, Val: 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if points := visibleData(free, free.NumericalData); len(points) != 0 {
+		t.Errorf("visible points without chart: %v", points)
+	}
+}
+
+func TestVisibleCategoricalData(t *testing.T) {
+	test.NewTempApp(t)
+	base := EmptyBaseChart(CartesianPlane, Categorical)
+	ps := series.EmptyPointSeries("bars", theme.ColorNamePrimary)
+	err := base.AddBarSeries(ps)
+	if err == nil {
+		err = ps.AddCategoricalData([]data.CategoricalPoint{{C: "a", Val: 1}, {C: "b", Val: 2}, {C: "c", Val: 3}})
+	}
+	if err == nil {
+		err = base.SetFromCRange([]string{"c", "b"})
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	exp := []data.CategoricalPoint{{C: "b", Val: 2}, {C: "c", Val: 3}}
+	if points := visibleData(ps, ps.CategoricalData); !reflect.DeepEqual(points, exp) {
+		t.Errorf("wrong visible points, exp %v, have %v", exp, points)
+	}
+}
+
+func TestUnlink(t *testing.T) {
+	test.NewTempApp(t)
+	leader := vectorChart(t)
+	fol := EmptyBaseChart(CartesianPlane, Numerical)
+	ps := series.EmptyPointSeries("points", theme.ColorNamePrimary)
+	err := fol.AddLineSeries(ps, false)
+	if err == nil {
+		err = ps.AddNumericalData([]data.NumericalPoint{{N: 2, Val: 1}, {N: 3, Val: 2}})
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	autoMin, autoMax := fol.fromAx.NRange()
+	leader.AddFollower(fol)
+	err = leader.SetFromNRange(-5, 20)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if min, max := fol.fromAx.NRange(); min != -5 || max != 20 {
+		t.Errorf("wrong range of follower, exp -5-20, have %f-%f", min, max)
+	}
+	fol.Unlink()
+	if len(leader.followers) != 0 || fol.leader != nil {
+		t.Errorf("follower still linked")
+	}
+	if min, max := fol.fromAx.NRange(); min != autoMin || max != autoMax {
+		t.Errorf("wrong range after unlink, exp %f-%f, have %f-%f", autoMin, autoMax, min, max)
+	}
+	err = leader.SetFromNRange(0, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if min, max := fol.fromAx.NRange(); min != autoMin || max != autoMax {
+		t.Errorf("range of unlinked chart changed with leader, have %f-%f", min, max)
+	}
+}
@@ -0,0 +1,208 @@
+package coord
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/s-daehling/fyne-charts/internal/coord/axis"
+	"github.com/s-daehling/fyne-charts/internal/coord/series"
+	"github.com/s-daehling/fyne-charts/internal/spec"
+	"github.com/s-daehling/fyne-charts/pkg/style"
+)
+
+// Spec describes the configuration and the data of the chart as chart specification
+// An error is returned for radar charts and series that cannot be described
+func (base *BaseChart) Spec() (s spec.Chart, err error) {
+	if base.radar != nil {
+		err = errors.New("radar charts are not supported by specifications")
+		return
+	}
+	base.Lock()
+	defer base.Unlock()
+	s.Type = base.chartType()
+	s.Title = base.title.Text
+	s.Transposed = base.transposed
+	s.X = axisSpec(base.fromAxisSpec())
+	s.Y = axisSpec(base.toAxisSpec())
+	s.Legend = base.legendSpec()
+	for _, ser := range base.series {
+		var ss spec.Series
+		ss, err = base.seriesSpec(ser)
+		if err != nil {
+			return
+		}
+		s.Series = append(s.Series, ss)
+	}
+	return
+}
+
+func (base *BaseChart) chartType() (t spec.ChartType) {
+	polar := base.planeType == PolarPlane
+	switch {
+	case base.fromType == Numerical && polar:
+		t = spec.PolarNumerical
+	case base.fromType == Numerical:
+		t = spec.CartesianNumerical
+	case base.fromType == Temporal && polar:
+		t = spec.PolarTemporal
+	case base.fromType == Temporal:
+		t = spec.CartesianTemporal
+	case polar:
+		t = spec.PolarCategorical
+	default:
+		t = spec.CartesianCategorical
+	}
+	return
+}
+
+func (base *BaseChart) fromAxisSpec() (a spec.Axis) {
+	a.Label = base.fromAx.Name()
+	switch base.fromType {
+	case Numerical:
+		if !base.autoFromRange && base.planeType == CartesianPlane {
+			min, max := base.fromAx.NRange()
+			a.Min, a.Max = &min, &max
+		}
+		numericalTicksSpec(&a, base.fromAx)
+	case Temporal:
+		if !base.autoFromRange {
+			min, max := base.fromAx.TRange()
+			a.TMin, a.TMax = min.Format(time.RFC3339Nano), max.Format(time.RFC3339Nano)
+		}
+		if !base.fromAx.AutoTicks() {
+			ts, format := base.fromAx.TTicks()
+			for i := range ts {
+				a.Ticks = append(a.Ticks, spec.Tick{T: ts[i].T.Format(time.RFC3339Nano), SupportLine: ts[i].SupportLine})
+			}
+			a.TickFormat = format
+		} else if !base.fromAx.AutoSupportLine() {
+			a.SupportLines = new(bool)
+		}
+	case Categorical:
+		if !base.autoFromRange {
+			a.Categories = base.fromAx.CRange()
+		}
+	}
+	return
+}
+
+func (base *BaseChart) toAxisSpec() (a spec.Axis) {
+	a.Label = base.toAx.Name()
+	if !base.autoToRange {
+		min, max := base.toAx.NRange()
+		if base.planeType == CartesianPlane {
+			a.Min = &min
+		}
+		a.Max = &max
+	}
+	numericalTicksSpec(&a, base.toAx)
+	return
+}
+
+func numericalTicksSpec(a *spec.Axis, ax *axis.Axis) {
+	if ax.AutoTicks() {
+		if !ax.AutoSupportLine() {
+			a.SupportLines = new(bool)
+		}
+		return
+	}
+	for _, t := range ax.NTicks() {
+		n := t.N
+		a.Ticks = append(a.Ticks, spec.Tick{N: &n, SupportLine: t.SupportLine})
+	}
+}
+
+// axisSpec gives nil for axes without any settings
+func axisSpec(a spec.Axis) (as *spec.Axis) {
+	if reflect.DeepEqual(a, spec.Axis{}) {
+		return
+	}
+	as = &a
+	return
+}
+
+func (base *BaseChart) legendSpec() (l *spec.Legend) {
+	var ls spec.Legend
+	if !base.legend.Visible() {
+		ls.Visible = new(bool)
+	}
+	if loc := base.legend.Location(); loc != style.LegendLocationRight {
+		ls.Location = string(loc)
+	}
+	if !base.legend.Interactive() {
+		ls.Interactive = new(bool)
+	}
+	if ls != (spec.Legend{}) {
+		l = &ls
+	}
+	return
+}
+
+// seriesSpec describes the style and data of ser; the series must be locked
+func (base *BaseChart) seriesSpec(ser series.Series) (s spec.Series, err error) {
+	all := math.Inf(1)
+	s.Name = ser.Name()
+	switch ser := ser.(type) {
+	case *series.PointSeries:
+		s.Color = string(ser.ColorName())
+		lw, ds, showDot := ser.Appearance()
+		lineWidth, dotSize := float64(lw), float64(ds)
+		s.LineWidth, s.DotSize = &lineWidth, &dotSize
+		switch {
+		case ser.IsBarSeries():
+			s.Type = spec.BarSeries
+			nWidth, tWidth := ser.BarWidth()
+			if base.fromType == Numerical {
+				s.BarWidth = &nWidth
+			} else if base.fromType == Temporal {
+				w := tWidth.Seconds()
+				s.BarWidth = &w
+			}
+		case ser.IsAreaSeries():
+			s.Type = spec.Area
+			s.ShowDots = showDot
+		case ser.IsLineSeries():
+			s.Type = spec.Line
+			s.ShowDots = showDot
+		case ser.IsLollipopSeries():
+			s.Type = spec.Lollipop
+		default:
+			s.Type = spec.Scatter
+		}
+		switch base.fromType {
+		case Numerical:
+			s.Data, err = spec.Records(ser.NumericalData(-all, all))
+		case Temporal:
+			s.Data, err = spec.Records(ser.TemporalData(-all, all))
+		default:
+			s.Data, err = spec.Records(ser.CategoricalData(-all, all))
+		}
+	case *series.CandleStickSeries:
+		s.Type = spec.CandleStick
+		if base.fromType == Numerical {
+			s.Data, err = spec.Records(ser.NumericalData(-all, all))
+		} else {
+			s.Data, err = spec.Records(ser.TemporalData(-all, all))
+		}
+	case *series.BoxSeries:
+		s.Type = spec.Box
+		s.Color = string(ser.ColorName())
+		switch base.fromType {
+		case Numerical:
+			s.Data, err = spec.Records(ser.NumericalData(-all, all))
+		case Temporal:
+			s.Data, err = spec.Records(ser.TemporalData(-all, all))
+		default:
+			s.Data, err = spec.Records(ser.CategoricalData(-all, all))
+		}
+	default:
+		err = errors.New("type not supported by specifications")
+	}
+	if err != nil {
+		err = fmt.Errorf("series %s: %w", s.Name, err)
+	}
+	return
+}
@@ -1,6 +1,8 @@
 package coord
 
 import (
+	"slices"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/theme"
 	"github.com/s-daehling/fyne-charts/internal/coord/series"
@@ -8,21 +10,71 @@ import (
 )
 
 func (base *BaseChart) Refresh() {
+	base.updater.Refresh()
+}
+
+func (base *BaseChart) refresh() {
+	base.Lock()
 	base.updateRasterSeries()
+	base.Unlock()
 	if base.render != nil {
 		base.render.Refresh()
 	}
 }
 
 func (base *BaseChart) DataChange() {
+	base.updater.DataChange()
+}
+
+func (base *BaseChart) dataChange() {
+	base.Lock()
+	base.invalidateRaster()
 	base.updateRangeAndOrigin()
 	base.updateAxTicks()
 	base.updateSeriesVariables()
-	base.Refresh()
+	base.Unlock()
+	base.updateFollowers()
+	base.refresh()
+}
+
+// Lock blocks concurrent data updates of the chart and all of its series
+// Rendering holds the lock while it reads the series data
+func (base *BaseChart) Lock() {
+	base.mu.Lock()
+	for i := range base.series {
+		base.series[i].Lock()
+	}
+}
+
+// Unlock releases the lock acquired by Lock
+func (base *BaseChart) Unlock() {
+	for i := len(base.series) - 1; i >= 0; i-- {
+		base.series[i].Unlock()
+	}
+	base.mu.Unlock()
+}
+
+// BeginUpdate defers all data changes and refreshes until the matching EndUpdate
+func (base *BaseChart) BeginUpdate() {
+	base.updater.BeginUpdate()
+}
+
+// EndUpdate applies the changes since BeginUpdate
+func (base *BaseChart) EndUpdate() {
+	base.updater.EndUpdate()
+}
+
+// SetMaxRefreshRate merges updates that follow each other within 1/fps seconds into one update
+func (base *BaseChart) SetMaxRefreshRate(fps float64) (err error) {
+	err = base.updater.SetMaxRate(fps)
+	return
 }
 
 func (base *BaseChart) RasterRefresh() {
+	base.Lock()
+	base.invalidateRaster()
 	base.updateRasterSeries()
+	base.Unlock()
 	base.rast.Refresh()
 }
 
@@ -33,6 +85,9 @@ func (base *BaseChart) updateRasterSeries() {
 			rastSer = append(rastSer, ser)
 		}
 	}
+	if !slices.Equal(rastSer, base.rasterSeries) {
+		base.invalidateRaster()
+	}
 	base.rasterSeries = rastSer
 }
 
@@ -40,6 +95,18 @@ func (base *BaseChart) ChartSizeChange(fromSpace float32, toSpace float32) {
 	base.fromAx.SetSpace(fromSpace)
 	base.toAx.SetSpace(toSpace)
 	base.updateAxTicks()
+	base.updateSampling()
+}
+
+// updateSampling passes the displayed from-axis range and its size in pixels to the point series for downsampling
+func (base *BaseChart) updateSampling() {
+	min, max := base.fromAx.NRange()
+	pixels := base.fromAx.Space()
+	for i := range base.series {
+		if ps, ok := base.series[i].(*series.PointSeries); ok {
+			ps.SetSamplingRange(min, max, pixels)
+		}
+	}
 }
 
 func (base *BaseChart) updateRangeAndOrigin() {
@@ -64,6 +131,7 @@ func (base *BaseChart) updateRangeAndOrigin() {
 		if base.autoOrigin {
 			base.calculateAutoTOrigin()
 		}
+		base.updateRowAxis()
 	case Categorical:
 		if base.autoToRange {
 			base.calculateAutoToRange()
@@ -88,7 +156,12 @@ func (base *BaseChart) updateAxTicks() {
 		base.fromAx.AutoCTicks()
 		base.fromAx.ConvertCTickstoN()
 	}
-	base.toAx.AutoNTicks()
+	if base.rowAxis {
+		base.toAx.AutoCTicks()
+		base.toAx.ConvertCTickstoN()
+	} else {
+		base.toAx.AutoNTicks()
+	}
 }
 
 func (base *BaseChart) updateSeriesVariables() {
@@ -106,6 +179,11 @@ func (base *BaseChart) updateSeriesVariables() {
 			if n > maxBoxPoints {
 				maxBoxPoints = n
 			}
+		} else if vs, ok := base.series[i].(*series.ViolinSeries); ok {
+			n := vs.NumberOfPoints()
+			if n > maxBoxPoints {
+				maxBoxPoints = n
+			}
 		}
 	}
 	nFromMin, nFromMax := base.fromAx.NRange()
@@ -143,6 +221,12 @@ func (base *BaseChart) updateSeriesVariables() {
 			sbs.UpdateValOffset()
 		} else if bs, ok := base.series[i].(*series.BoxSeries); ok {
 			bs.SetWidth(boxWidth)
+		} else if vs, ok := base.series[i].(*series.ViolinSeries); ok {
+			vs.SetWidth(boxWidth)
+		} else if rs, ok := base.series[i].(*series.RadarSeries); ok {
+			rs.SetOuterRadius(base.toAx.NOrigin())
+		} else if gs, ok := base.series[i].(*series.GanttSeries); ok && base.rowAxis {
+			gs.ConvertRowsToN(base.toAx.CtoN)
 		}
 	}
 
@@ -156,6 +240,7 @@ func (base *BaseChart) updateSeriesVariables() {
 			base.series[i].ConvertCtoN(base.fromAx.CtoN)
 		}
 	}
+	base.updateSampling()
 }
 
 func (base *BaseChart) refreshAxisLabels() {
@@ -196,4 +281,54 @@ func (base *BaseChart) RefreshTheme() {
 	for i := range base.series {
 		base.series[i].RefreshTheme()
 	}
+	if base.rasterThemeChanged() {
+		base.invalidateRaster()
+	}
+}
+
+// AddFollower links the from-axis range of fol to the from-axis range of this chart
+// Only charts with the same plane and from type can be linked; each chart can follow only one other chart
+func (base *BaseChart) AddFollower(fol *BaseChart) {
+	if fol == nil || fol == base || fol.leader != nil || fol.planeType != base.planeType ||
+		fol.fromType != base.fromType || base.fromType == Categorical {
+		return
+	}
+	for ch := base; ch != nil; ch = ch.leader {
+		if ch == fol {
+			return
+		}
+	}
+	fol.leader = base
+	base.followers = append(base.followers, fol)
+	base.updateFollowers()
+}
+
+// Unlink stops following the from-axis range of the chart this chart has been added to with AddFollower
+// The from-axis range is calculated automatically again
+func (base *BaseChart) Unlink() {
+	ld := base.leader
+	if ld == nil {
+		return
+	}
+	for i := range ld.followers {
+		if ld.followers[i] == base {
+			ld.followers = slices.Delete(ld.followers, i, i+1)
+			break
+		}
+	}
+	base.leader = nil
+	base.SetAutoFromRange()
+}
+
+func (base *BaseChart) updateFollowers() {
+	for _, fol := range base.followers {
+		switch base.fromType {
+		case Numerical:
+			min, max := base.fromAx.NRange()
+			fol.SetFromNRange(min, max)
+		case Temporal:
+			min, max := base.fromAx.TRange()
+			fol.SetFromTRange(min, max)
+		}
+	}
 }
@@ -0,0 +1,83 @@
+package export
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/software"
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+)
+
+// variantTheme is a theme that always uses the same variant
+type variantTheme struct {
+	fyne.Theme
+	variant fyne.ThemeVariant
+}
+
+func (t *variantTheme) Color(n fyne.ThemeColorName, _ fyne.ThemeVariant) color.Color {
+	return t.Theme.Color(n, t.variant)
+}
+
+// CurrentVariant gives the theme variant of the app
+func CurrentVariant() (v fyne.ThemeVariant) {
+	if a := fyne.CurrentApp(); a != nil {
+		v = a.Settings().ThemeVariant()
+	}
+	return
+}
+
+// Chart describes the content of a chart for exports
+// Content is the live content of the chart; it is laid out with the size of the export and restored afterwards,
+// but it is not moved to another canvas. Refresh updates the objects of the chart after a change of size or theme
+// Texts gives the texts behind images, so that vector exports write them as text, and Areas the areas on rasters,
+// so that vector exports write them as paths
+type Chart struct {
+	Content fyne.CanvasObject
+	Refresh func()
+	Texts   func() []renderer.ImageText
+	Areas   func() []renderer.RasterArea
+}
+
+// refresh updates the chart and all other widgets of its content
+func (ch Chart) refresh() {
+	if ch.Refresh != nil {
+		ch.Refresh()
+	}
+	refreshWidgets(ch.Content)
+}
+
+// Image renders a snapshot of the chart with the given size, scale and theme variant offscreen
+// The theme of the app and the live content of the chart are not changed
+func Image(ch Chart, size fyne.Size, scale float32, variant fyne.ThemeVariant) (img image.Image, err error) {
+	s, err := takeSnapshot(ch, size, scale, variant, false)
+	if err != nil {
+		return
+	}
+	bg := canvas.NewRectangle(s.bg)
+	bg.Resize(size)
+	c := software.NewCanvas()
+	c.SetPadded(false)
+	c.SetScale(scale)
+	c.SetContent(container.NewWithoutLayout(append([]fyne.CanvasObject{bg}, s.objs...)...))
+	c.Resize(size)
+	img = c.Capture()
+	return
+}
+
+// WritePNG encodes img as PNG file at path
+func WritePNG(path string, img image.Image) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return
+	}
+	err = png.Encode(f, img)
+	if cErr := f.Close(); err == nil {
+		err = cErr
+	}
+	return
+}
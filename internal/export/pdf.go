@@ -0,0 +1,337 @@
+package export
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"math"
+	"strings"
+
+	"fyne.io/fyne/v2"
+)
+
+// control point distance for approximating a quarter ellipse with a cubic bezier curve
+const bezierKappa = 0.5523
+
+// the standard fonts of PDF that are used for text whose font cannot be embedded; they only cover Latin-1
+var pdfFonts = []string{"Helvetica", "Helvetica-Bold", "Helvetica-Oblique", "Helvetica-BoldOblique", "Courier"}
+
+type pdfPainter struct {
+	height  float32
+	width   float32
+	content bytes.Buffer
+	alphas  []uint8
+	images  []image.Image
+	fonts   []*pdfEmbeddedFont
+	fontIDs map[string]int // index of the embedded font by the name of its resource, -1 if it cannot be embedded
+}
+
+func newPDFPainter(size fyne.Size) (p *pdfPainter) {
+	p = &pdfPainter{
+		width:   size.Width,
+		height:  size.Height,
+		fontIDs: make(map[string]int),
+	}
+	return
+}
+
+func (p *pdfPainter) rect(x, y, w, h float32, fill color.Color, stroke color.Color, strokeWidth float32,
+	radius float32) {
+	path := func() {
+		fmt.Fprintf(&p.content, "%s %s %s %s re\n", num(x), num(p.height-y-h), num(w), num(h))
+	}
+	p.paint(path, fill, stroke, strokeWidth)
+}
+
+func (p *pdfPainter) line(x1, y1, x2, y2 float32, stroke color.Color, strokeWidth float32) {
+	path := func() {
+		fmt.Fprintf(&p.content, "%s %s m %s %s l\n", num(x1), num(p.height-y1), num(x2), num(p.height-y2))
+	}
+	p.paint(path, nil, stroke, strokeWidth)
+}
+
+func (p *pdfPainter) ellipse(cx, cy, rx, ry float32, fill color.Color, stroke color.Color, strokeWidth float32) {
+	cy = p.height - cy
+	kx, ky := rx*bezierKappa, ry*bezierKappa
+	path := func() {
+		fmt.Fprintf(&p.content, "%s %s m\n", num(cx+rx), num(cy))
+		fmt.Fprintf(&p.content, "%s %s %s %s %s %s c\n", num(cx+rx), num(cy+ky), num(cx+kx), num(cy+ry), num(cx),
+			num(cy+ry))
+		fmt.Fprintf(&p.content, "%s %s %s %s %s %s c\n", num(cx-kx), num(cy+ry), num(cx-rx), num(cy+ky), num(cx-rx),
+			num(cy))
+		fmt.Fprintf(&p.content, "%s %s %s %s %s %s c\n", num(cx-rx), num(cy-ky), num(cx-kx), num(cy-ry), num(cx),
+			num(cy-ry))
+		fmt.Fprintf(&p.content, "%s %s %s %s %s %s c\n", num(cx+kx), num(cy-ry), num(cx+rx), num(cy-ky), num(cx+rx),
+			num(cy))
+	}
+	p.paint(path, fill, stroke, strokeWidth)
+}
+
+func (p *pdfPainter) text(t textItem) {
+	if t.s == "" || !visible(t.col) {
+		return
+	}
+	// the text matrix rotates around the center of the box and places the baseline start relative to it
+	sin, cos := math.Sincos(t.rot * math.Pi / 180)
+	cx, cy := float64(t.x+t.w/2), float64(p.height-t.y-t.h/2)
+	dx, dy := -float64(t.w)/2, float64(t.h/2-t.baseline)
+	e := cx + cos*dx - sin*dy
+	f := cy + sin*dx + cos*dy
+	r, g, b, a := rgba(t.col)
+	font, str := fmt.Sprintf("/F%d", pdfFont(t.style)), "("+pdfString(t.s)+")"
+	if i := p.embeddedFont(t.font); i >= 0 {
+		font, str = fmt.Sprintf("/E%d", i), "<"+p.fonts[i].glyphs(t.s)+">"
+	}
+	fmt.Fprintf(&p.content, "q\n%s\nBT\n%s %s Tf\n%s rg\n%s %s %s %s %s %s Tm\n%s Tj\nET\nQ\n",
+		p.alphaState(a), font, num(t.size), pdfRGB(r, g, b), num(float32(cos)), num(float32(sin)),
+		num(float32(-sin)), num(float32(cos)), num(float32(e)), num(float32(f)), str)
+}
+
+// embeddedFont gives the index of the embedded font for res or -1 if the standard fonts are used instead
+func (p *pdfPainter) embeddedFont(res fyne.Resource) (i int) {
+	if res == nil {
+		i = -1
+		return
+	}
+	i, ok := p.fontIDs[res.Name()]
+	if ok {
+		return
+	}
+	i = -1
+	if f, err := newPDFEmbeddedFont(res); err == nil {
+		p.fonts = append(p.fonts, f)
+		i = len(p.fonts) - 1
+	}
+	p.fontIDs[res.Name()] = i
+	return
+}
+
+func (p *pdfPainter) image(x, y, w, h float32, img image.Image) {
+	p.images = append(p.images, img)
+	fmt.Fprintf(&p.content, "q\n%s 0 0 %s %s %s cm\n/Im%d Do\nQ\n", num(w), num(h), num(x), num(p.height-y-h),
+		len(p.images)-1)
+}
+
+func (p *pdfPainter) polygon(pts []fyne.Position, fill color.Color) {
+	if len(pts) < 3 || !visible(fill) {
+		return
+	}
+	r, g, b, a := rgba(fill)
+	fmt.Fprintf(&p.content, "q\n%s\n%s rg\n", p.alphaState(a), pdfRGB(r, g, b))
+	for i := range pts {
+		op := "l"
+		if i == 0 {
+			op = "m"
+		}
+		fmt.Fprintf(&p.content, "%s %s %s\n", num(pts[i].X), num(p.height-pts[i].Y), op)
+	}
+	p.content.WriteString("h\nf*\nQ\n")
+}
+
+// paint fills and strokes the path that is written by path; fill and stroke may have different opacities
+func (p *pdfPainter) paint(path func(), fill color.Color, stroke color.Color, strokeWidth float32) {
+	if visible(fill) {
+		r, g, b, a := rgba(fill)
+		fmt.Fprintf(&p.content, "q\n%s\n%s rg\n", p.alphaState(a), pdfRGB(r, g, b))
+		path()
+		p.content.WriteString("f\nQ\n")
+	}
+	if visible(stroke) && strokeWidth > 0 {
+		r, g, b, a := rgba(stroke)
+		fmt.Fprintf(&p.content, "q\n%s\n%s RG\n%s w\n", p.alphaState(a), pdfRGB(r, g, b), num(strokeWidth))
+		path()
+		p.content.WriteString("S\nQ\n")
+	}
+}
+
+// alphaState gives the operator that selects the graphics state with opacity a
+func (p *pdfPainter) alphaState(a uint8) (op string) {
+	for i := range p.alphas {
+		if p.alphas[i] == a {
+			op = fmt.Sprintf("/GS%d gs", i)
+			return
+		}
+	}
+	p.alphas = append(p.alphas, a)
+	op = fmt.Sprintf("/GS%d gs", len(p.alphas)-1)
+	return
+}
+
+func (p *pdfPainter) finish(w io.Writer) (err error) {
+	var out bytes.Buffer
+	var offsets []int
+	begin := func() (n int) {
+		offsets = append(offsets, out.Len())
+		n = len(offsets)
+		fmt.Fprintf(&out, "%d 0 obj\n", n)
+		return
+	}
+	stream := func(dict string, data []byte) {
+		if dict != "" {
+			dict += " "
+		}
+		fmt.Fprintf(&out, "<< %s/Length %d >>\nstream\n", dict, len(data))
+		out.Write(data)
+		out.WriteString("\nendstream\nendobj\n")
+	}
+
+	// object numbers: 1 catalog, 2 pages, 3 page, 4 content, then fonts, graphics states, images and
+	// embedded fonts with five objects each
+	fontObj := 5
+	gsObj := fontObj + len(pdfFonts)
+	imgObj := gsObj + len(p.alphas)
+	embObj := imgObj + 2*len(p.images)
+	var res strings.Builder
+	res.WriteString("/Font <<")
+	for i := range pdfFonts {
+		fmt.Fprintf(&res, " /F%d %d 0 R", i, fontObj+i)
+	}
+	for i := range p.fonts {
+		fmt.Fprintf(&res, " /E%d %d 0 R", i, embObj+5*i)
+	}
+	res.WriteString(" >> /ExtGState <<")
+	for i := range p.alphas {
+		fmt.Fprintf(&res, " /GS%d %d 0 R", i, gsObj+i)
+	}
+	res.WriteString(" >> /XObject <<")
+	for i := range p.images {
+		fmt.Fprintf(&res, " /Im%d %d 0 R", i, imgObj+2*i)
+	}
+	res.WriteString(" >>")
+
+	out.WriteString("%PDF-1.4\n")
+	begin()
+	out.WriteString("<< /Type /Catalog /Pages 2 0 R >>\nendobj\n")
+	begin()
+	out.WriteString("<< /Type /Pages /Kids [3 0 R] /Count 1 >>\nendobj\n")
+	begin()
+	fmt.Fprintf(&out, "<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << %s >> /Contents 4 0 R >>\nendobj\n",
+		num(p.width), num(p.height), res.String())
+	begin()
+	stream("", p.content.Bytes())
+	for _, f := range pdfFonts {
+		begin()
+		fmt.Fprintf(&out, "<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>\nendobj\n", f)
+	}
+	for _, a := range p.alphas {
+		begin()
+		opacity := num(float32(a) / 255)
+		fmt.Fprintf(&out, "<< /Type /ExtGState /ca %s /CA %s >>\nendobj\n", opacity, opacity)
+	}
+	for _, img := range p.images {
+		rgb, alpha := pdfImageData(img)
+		b := img.Bounds()
+		n := begin()
+		stream(fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB "+
+			"/BitsPerComponent 8 /Filter /FlateDecode /SMask %d 0 R", b.Dx(), b.Dy(), n+1), rgb)
+		begin()
+		stream(fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceGray "+
+			"/BitsPerComponent 8 /Filter /FlateDecode", b.Dx(), b.Dy()), alpha)
+	}
+	for i, f := range p.fonts {
+		var data []byte
+		data, err = f.subset()
+		if err != nil {
+			return
+		}
+		// subsets are tagged with six upper case letters that differ between the fonts of the document
+		name := fmt.Sprintf("%s+%s", subsetTag(i), f.name)
+		n := begin()
+		fmt.Fprintf(&out, "<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H "+
+			"/DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>\nendobj\n", name, n+1, n+4)
+		begin()
+		fmt.Fprintf(&out, "<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s "+
+			"/CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> /FontDescriptor %d 0 R "+
+			"/W %s /CIDToGIDMap /Identity >>\nendobj\n", name, n+2, f.widths())
+		begin()
+		fmt.Fprintf(&out, "<< /Type /FontDescriptor /FontName /%s %s /FontFile2 %d 0 R >>\nendobj\n", name,
+			f.descriptor(), n+3)
+		begin()
+		stream(fmt.Sprintf("/Filter /FlateDecode /Length1 %d", len(data)), deflate(data))
+		begin()
+		stream("/Filter /FlateDecode", deflate(f.toUnicode()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, o := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", o)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	_, err = out.WriteTo(w)
+	return
+}
+
+// pdfImageData gives the compressed color and alpha channels of img
+func pdfImageData(img image.Image) (rgb []byte, alpha []byte) {
+	var rgbBuf, alphaBuf bytes.Buffer
+	rgbW := zlib.NewWriter(&rgbBuf)
+	alphaW := zlib.NewWriter(&alphaBuf)
+	b := img.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			r, g, bl, a := rgba(img.At(x, y))
+			rgbW.Write([]byte{r, g, bl})
+			alphaW.Write([]byte{a})
+		}
+	}
+	rgbW.Close()
+	alphaW.Close()
+	rgb = rgbBuf.Bytes()
+	alpha = alphaBuf.Bytes()
+	return
+}
+
+func pdfRGB(r uint8, g uint8, b uint8) (s string) {
+	s = fmt.Sprintf("%s %s %s", num(float32(r)/255), num(float32(g)/255), num(float32(b)/255))
+	return
+}
+
+// pdfFont gives the index of the standard font for s
+func pdfFont(s fyne.TextStyle) (i int) {
+	switch {
+	case s.Monospace:
+		i = 4
+	case s.Bold && s.Italic:
+		i = 3
+	case s.Italic:
+		i = 2
+	case s.Bold:
+		i = 1
+	}
+	return
+}
+
+// subsetTag gives the tag of the i-th font subset of a document
+func subsetTag(i int) (tag string) {
+	b := []byte("AAAAAA")
+	for j := len(b) - 1; j >= 0 && i > 0; j-- {
+		b[j] += byte(i % 26)
+		i /= 26
+	}
+	tag = string(b)
+	return
+}
+
+// pdfString escapes s for a literal string in WinAnsi encoding; characters outside of Latin-1 are replaced by '?'
+func pdfString(s string) (e string) {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	e = b.String()
+	return
+}
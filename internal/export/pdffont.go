@@ -0,0 +1,336 @@
+package export
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"fyne.io/fyne/v2"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// pdfEmbeddedFont is a TrueType font whose glyphs are embedded in a PDF document as subset
+// Texts select glyphs by their index, so that all characters of the font can be written
+type pdfEmbeddedFont struct {
+	name  string
+	data  []byte
+	font  *sfnt.Font
+	buf   sfnt.Buffer
+	runes map[uint16]rune // used glyphs and the characters they are written for
+}
+
+// newPDFEmbeddedFont parses the font of res; only TrueType fonts with glyph outlines can be embedded
+func newPDFEmbeddedFont(res fyne.Resource) (f *pdfEmbeddedFont, err error) {
+	data := res.Content()
+	if _, err = fontTables(data); err != nil {
+		return
+	}
+	sf, err := sfnt.Parse(data)
+	if err != nil {
+		return
+	}
+	f = &pdfEmbeddedFont{data: data, font: sf, runes: make(map[uint16]rune)}
+	f.name, _ = sf.Name(&f.buf, sfnt.NameIDPostScript)
+	f.name = strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' || strings.ContainsRune("()<>[]{}/%#", r) {
+			return -1
+		}
+		return r
+	}, f.name)
+	if f.name == "" {
+		f.name = "Font"
+	}
+	return
+}
+
+// glyphs gives the glyph indices of s as hex string; characters without glyph are written as the missing glyph
+func (f *pdfEmbeddedFont) glyphs(s string) (hex string) {
+	var b strings.Builder
+	for _, r := range s {
+		gid, err := f.font.GlyphIndex(&f.buf, r)
+		if err != nil {
+			gid = 0
+		}
+		if _, ok := f.runes[uint16(gid)]; !ok && gid != 0 {
+			f.runes[uint16(gid)] = r
+		}
+		fmt.Fprintf(&b, "%04X", uint16(gid))
+	}
+	hex = b.String()
+	return
+}
+
+// sortedGlyphs gives the used glyphs in ascending order
+func (f *pdfEmbeddedFont) sortedGlyphs() (gids []uint16) {
+	for gid := range f.runes {
+		gids = append(gids, gid)
+	}
+	sort.Slice(gids, func(i, j int) bool { return gids[i] < gids[j] })
+	return
+}
+
+// widths gives the W array of the CID font with the advances of the used glyphs in 1/1000 em
+func (f *pdfEmbeddedFont) widths() (w string) {
+	var b strings.Builder
+	b.WriteString("[")
+	for _, gid := range append([]uint16{0}, f.sortedGlyphs()...) {
+		adv, err := f.font.GlyphAdvance(&f.buf, sfnt.GlyphIndex(gid), fixed.I(1000), font.HintingNone)
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(&b, " %d [%d]", gid, adv.Round())
+	}
+	b.WriteString(" ]")
+	w = b.String()
+	return
+}
+
+// descriptor gives the entries of the font descriptor that describe the metrics of the font in 1/1000 em
+func (f *pdfEmbeddedFont) descriptor() (d string) {
+	ppem := fixed.I(1000)
+	bounds, _ := f.font.Bounds(&f.buf, ppem, font.HintingNone)
+	m, _ := f.font.Metrics(&f.buf, ppem, font.HintingNone)
+	d = fmt.Sprintf("/Flags 32 /FontBBox [%d %d %d %d] /ItalicAngle 0 /Ascent %d /Descent %d /CapHeight %d "+
+		"/StemV 80", bounds.Min.X.Round(), -bounds.Max.Y.Round(), bounds.Max.X.Round(), -bounds.Min.Y.Round(),
+		m.Ascent.Round(), -m.Descent.Round(), m.Ascent.Round())
+	return
+}
+
+// toUnicode gives the CMap that maps the used glyphs back to their characters, so that the text can be copied
+func (f *pdfEmbeddedFont) toUnicode() (cmap []byte) {
+	var b bytes.Buffer
+	b.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n" +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n" +
+		"/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n" +
+		"1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	gids := f.sortedGlyphs()
+	// a bfchar block has at most 100 entries
+	for start := 0; start < len(gids); start += 100 {
+		end := min(start+100, len(gids))
+		fmt.Fprintf(&b, "%d beginbfchar\n", end-start)
+		for _, gid := range gids[start:end] {
+			fmt.Fprintf(&b, "<%04X> <", gid)
+			for _, u := range utf16.Encode([]rune{f.runes[gid]}) {
+				fmt.Fprintf(&b, "%04X", u)
+			}
+			b.WriteString(">\n")
+		}
+		b.WriteString("endbfchar\n")
+	}
+	b.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n")
+	cmap = b.Bytes()
+	return
+}
+
+// fontTables gives the tables of the TrueType font data by their tag
+func fontTables(data []byte) (tables map[string][]byte, err error) {
+	if len(data) < 12 || binary.BigEndian.Uint32(data) != 0x00010000 && string(data[:4]) != "true" {
+		err = errors.New("no TrueType font")
+		return
+	}
+	n := int(binary.BigEndian.Uint16(data[4:]))
+	if len(data) < 12+16*n {
+		err = errors.New("invalid font")
+		return
+	}
+	tables = make(map[string][]byte)
+	for i := 0; i < n; i++ {
+		rec := data[12+16*i:]
+		off := int(binary.BigEndian.Uint32(rec[8:]))
+		length := int(binary.BigEndian.Uint32(rec[12:]))
+		if off < 0 || length < 0 || off+length > len(data) {
+			err = errors.New("invalid font")
+			return
+		}
+		tables[string(rec[:4])] = data[off : off+length]
+	}
+	for _, tag := range []string{"head", "hhea", "hmtx", "maxp", "loca", "glyf"} {
+		if _, ok := tables[tag]; !ok {
+			err = errors.New("font without " + tag + " table")
+			return
+		}
+	}
+	if len(tables["head"]) < 54 || len(tables["maxp"]) < 6 {
+		err = errors.New("invalid font")
+	}
+	return
+}
+
+// subset gives the TrueType font data with the outlines of the used glyphs only
+// Glyph indices are kept, so that the glyphs are selected by the same indices as in the font
+func (f *pdfEmbeddedFont) subset() (data []byte, err error) {
+	tables, err := fontTables(f.data)
+	if err != nil {
+		return
+	}
+	numGlyphs := int(binary.BigEndian.Uint16(tables["maxp"][4:]))
+	longLoca := binary.BigEndian.Uint16(tables["head"][50:]) == 1
+	loca, glyf := tables["loca"], tables["glyf"]
+	outline := func(gid int) (g []byte) {
+		var start, end int
+		switch {
+		case gid >= numGlyphs:
+			return
+		case longLoca && len(loca) >= 4*gid+8:
+			start = int(binary.BigEndian.Uint32(loca[4*gid:]))
+			end = int(binary.BigEndian.Uint32(loca[4*gid+4:]))
+		case !longLoca && len(loca) >= 2*gid+4:
+			start = 2 * int(binary.BigEndian.Uint16(loca[2*gid:]))
+			end = 2 * int(binary.BigEndian.Uint16(loca[2*gid+2:]))
+		}
+		if start < end && end <= len(glyf) {
+			g = glyf[start:end]
+		}
+		return
+	}
+
+	// composite glyphs need the glyphs they are made of
+	used := map[int]bool{0: true}
+	todo := []int{0}
+	for gid := range f.runes {
+		todo = append(todo, int(gid))
+	}
+	for len(todo) > 0 {
+		gid := todo[len(todo)-1]
+		todo = todo[:len(todo)-1]
+		used[gid] = true
+		for _, c := range components(outline(gid)) {
+			if !used[c] {
+				todo = append(todo, c)
+			}
+		}
+	}
+
+	var newGlyf bytes.Buffer
+	newLoca := make([]byte, 4*(numGlyphs+1))
+	for gid := 0; gid < numGlyphs; gid++ {
+		if used[gid] {
+			newGlyf.Write(outline(gid))
+			for newGlyf.Len()%4 != 0 {
+				newGlyf.WriteByte(0)
+			}
+		}
+		binary.BigEndian.PutUint32(newLoca[4*gid+4:], uint32(newGlyf.Len()))
+	}
+	head := append([]byte(nil), tables["head"]...)
+	binary.BigEndian.PutUint32(head[8:], 0) // checksum adjustment
+	binary.BigEndian.PutUint16(head[50:], 1)
+
+	sub := map[string][]byte{
+		"head": head,
+		"hhea": tables["hhea"],
+		"hmtx": tables["hmtx"],
+		"maxp": tables["maxp"],
+		"loca": newLoca,
+		"glyf": newGlyf.Bytes(),
+	}
+	// the hinting programs are kept, because the glyphs may use them, and so are the tables that some viewers
+	// expect in every TrueType font
+	for _, tag := range []string{"cvt ", "fpgm", "prep", "cmap", "OS/2", "post", "name"} {
+		if t, ok := tables[tag]; ok {
+			sub[tag] = t
+		}
+	}
+	data = fontData(sub)
+	return
+}
+
+// components gives the glyphs that the composite glyph g is made of; simple glyphs have none
+func components(g []byte) (gids []int) {
+	if len(g) < 10 || int16(binary.BigEndian.Uint16(g)) >= 0 {
+		return
+	}
+	const (
+		argsAreWords  = 0x0001
+		haveScale     = 0x0008
+		moreComps     = 0x0020
+		haveXYScale   = 0x0040
+		haveTwoByTwo  = 0x0080
+		compHeaderLen = 4
+	)
+	p := 10
+	for p+compHeaderLen <= len(g) {
+		flags := binary.BigEndian.Uint16(g[p:])
+		gids = append(gids, int(binary.BigEndian.Uint16(g[p+2:])))
+		p += compHeaderLen
+		if flags&argsAreWords != 0 {
+			p += 4
+		} else {
+			p += 2
+		}
+		switch {
+		case flags&haveScale != 0:
+			p += 2
+		case flags&haveXYScale != 0:
+			p += 4
+		case flags&haveTwoByTwo != 0:
+			p += 8
+		}
+		if flags&moreComps == 0 {
+			break
+		}
+	}
+	return
+}
+
+// fontData writes the tables as TrueType font
+func fontData(tables map[string][]byte) (data []byte) {
+	var tags []string
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	n := len(tags)
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= n {
+		entrySelector++
+	}
+	searchRange := 16 << entrySelector
+
+	var b bytes.Buffer
+	binary.Write(&b, binary.BigEndian, []uint32{0x00010000})
+	binary.Write(&b, binary.BigEndian, []uint16{uint16(n), uint16(searchRange), uint16(entrySelector),
+		uint16(16*n - searchRange)})
+	off := 12 + 16*n
+	for _, tag := range tags {
+		t := tables[tag]
+		b.WriteString(tag)
+		binary.Write(&b, binary.BigEndian, []uint32{checksum(t), uint32(off), uint32(len(t))})
+		off += (len(t) + 3) &^ 3
+	}
+	for _, tag := range tags {
+		b.Write(tables[tag])
+		for b.Len()%4 != 0 {
+			b.WriteByte(0)
+		}
+	}
+	data = b.Bytes()
+	return
+}
+
+// checksum gives the TrueType checksum of a table
+func checksum(t []byte) (sum uint32) {
+	for i := 0; i < len(t); i += 4 {
+		var word [4]byte
+		copy(word[:], t[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return
+}
+
+// deflate compresses data for a stream with the FlateDecode filter
+func deflate(data []byte) (c []byte) {
+	var b bytes.Buffer
+	w := zlib.NewWriter(&b)
+	w.Write(data)
+	w.Close()
+	c = b.Bytes()
+	return
+}
@@ -0,0 +1,217 @@
+package export
+
+import (
+	"errors"
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+)
+
+// snapshot is a copy of the canvas objects of a chart at the size of an export
+// The copies have absolute positions and the colors of the theme variant of the export
+type snapshot struct {
+	bg    color.Color
+	objs  []fyne.CanvasObject
+	texts map[*canvas.Image]renderer.ImageText  // texts behind the copied images
+	areas map[*canvas.Raster][]renderer.Outline // outlines of the areas on the copied rasters
+}
+
+// takeSnapshot lays out the content of ch with the given size and copies its objects
+// while the theme of the app with the given variant is applied to the content only
+// Rasters are copied as images with scale pixels per unit; transparent rasters are left out
+// With outlines, rasters whose areas are given by the chart are copied with the outlines of the areas instead
+// The content keeps its parent; its size and colors are restored before takeSnapshot returns
+func takeSnapshot(ch Chart, size fyne.Size, scale float32, variant fyne.ThemeVariant,
+	outlines bool) (s snapshot, err error) {
+	if size.Width <= 0 || size.Height <= 0 {
+		err = errors.New("invalid size")
+		return
+	}
+	if scale <= 0 {
+		err = errors.New("invalid scale")
+		return
+	}
+	a := fyne.CurrentApp()
+	if a == nil {
+		err = errors.New("no app, create one with app.New or test.NewApp before exporting")
+		return
+	}
+	if ch.Content == nil {
+		err = errors.New("chart not initialized")
+		return
+	}
+	oldSize := ch.Content.Size()
+	s = snapshot{
+		texts: make(map[*canvas.Image]renderer.ImageText),
+		areas: make(map[*canvas.Raster][]renderer.Outline),
+	}
+	withTheme(&variantTheme{Theme: a.Settings().Theme(), variant: variant}, func() {
+		ch.Content.Resize(size)
+		ch.refresh()
+		s.bg = theme.Color(theme.ColorNameBackground)
+		its := make(map[*canvas.Image]renderer.ImageText)
+		if ch.Texts != nil {
+			for _, it := range ch.Texts() {
+				its[it.Image] = it
+			}
+		}
+		ras := make(map[*canvas.Raster][]renderer.Outline)
+		if outlines && ch.Areas != nil {
+			for _, ra := range ch.Areas() {
+				ras[ra.Raster] = ra.Outlines
+			}
+		}
+		s.add(ch.Content, fyne.NewPos(0, 0).Subtract(ch.Content.Position()), scale, its, ras)
+	})
+	ch.Content.Resize(oldSize)
+	ch.refresh()
+	return
+}
+
+// add copies obj and its children; offset is the absolute position of the parent of obj
+func (s *snapshot) add(obj fyne.CanvasObject, offset fyne.Position, scale float32,
+	its map[*canvas.Image]renderer.ImageText, ras map[*canvas.Raster][]renderer.Outline) {
+	if obj == nil || !obj.Visible() {
+		return
+	}
+	pos := offset.Add(obj.Position())
+	size := obj.Size()
+	var cp fyne.CanvasObject
+	switch o := obj.(type) {
+	case *fyne.Container:
+		for _, child := range o.Objects {
+			s.add(child, pos, scale, its, ras)
+		}
+	case Widget:
+		for _, child := range o.ExportObjects() {
+			s.add(child, pos, scale, its, ras)
+		}
+	case fyne.Widget:
+		// widgets of fyne, e.g. buttons, are copied as a renderer of their own shows them with the current theme
+		r := o.CreateRenderer()
+		r.Layout(size)
+		for _, child := range r.Objects() {
+			s.add(child, pos, scale, its, ras)
+		}
+		r.Destroy()
+	case *canvas.Rectangle:
+		c := *o
+		cp = &c
+	case *canvas.Line:
+		c := *o
+		cp = &c
+	case *canvas.Circle:
+		c := *o
+		cp = &c
+	case *canvas.Text:
+		if o.Text == "" {
+			return
+		}
+		c := *o
+		if c.Color == nil {
+			c.Color = theme.Color(theme.ColorNameForeground)
+		}
+		cp = &c
+	case *canvas.Image:
+		c := *o
+		if it, ok := its[o]; ok && it.Text != nil {
+			t := *it.Text
+			if t.Color == nil {
+				t.Color = theme.Color(theme.ColorNameForeground)
+			}
+			s.texts[&c] = renderer.ImageText{Image: &c, Text: &t, Rot: it.Rot}
+		}
+		cp = &c
+	case *canvas.Raster:
+		if areas, ok := ras[o]; ok {
+			if len(areas) == 0 {
+				return
+			}
+			c := *o
+			s.areas[&c] = areas
+			cp = &c
+			break
+		}
+		w := int(math.Ceil(float64(size.Width * scale)))
+		h := int(math.Ceil(float64(size.Height * scale)))
+		if w < 1 || h < 1 {
+			return
+		}
+		img := o.Generator(w, h)
+		if transparent(img) {
+			return
+		}
+		c := canvas.NewImageFromImage(img)
+		c.Resize(size)
+		cp = c
+	}
+	if cp == nil {
+		return
+	}
+	cp.Move(pos)
+	s.objs = append(s.objs, cp)
+}
+
+// refreshWidgets refreshes the widgets below obj that are not refreshed by the chart, so that they pick up the
+// theme that is currently applied
+func refreshWidgets(obj fyne.CanvasObject) {
+	switch o := obj.(type) {
+	case *fyne.Container:
+		for _, child := range o.Objects {
+			refreshWidgets(child)
+		}
+	case Widget:
+		for _, child := range o.ExportObjects() {
+			refreshWidgets(child)
+		}
+	case fyne.Widget:
+		o.Refresh()
+	}
+}
+
+// withTheme calls f while th is applied to everything that is laid out or refreshed by f
+// The theme of the app is not changed; th is applied like by a theme override container
+func withTheme(th fyne.Theme, f func()) {
+	scope := &themeScope{f: f}
+	scope.ExtendBaseWidget(scope)
+	container.NewThemeOverride(scope, th).Resize(fyne.NewSize(1, 1))
+}
+
+// themeScope is a widget that calls f when it is laid out for the first time
+type themeScope struct {
+	widget.BaseWidget
+	f func()
+}
+
+func (scope *themeScope) CreateRenderer() fyne.WidgetRenderer {
+	return &themeScopeRenderer{scope: scope}
+}
+
+type themeScopeRenderer struct {
+	scope *themeScope
+}
+
+func (r *themeScopeRenderer) Layout(_ fyne.Size) {
+	if f := r.scope.f; f != nil {
+		r.scope.f = nil
+		f()
+	}
+}
+
+func (r *themeScopeRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(0, 0)
+}
+
+func (r *themeScopeRenderer) Refresh() {}
+
+func (r *themeScopeRenderer) Objects() []fyne.CanvasObject {
+	return nil
+}
+
+func (r *themeScopeRenderer) Destroy() {}
@@ -0,0 +1,164 @@
+package export
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"strconv"
+
+	"fyne.io/fyne/v2"
+)
+
+type svgPainter struct {
+	buf bytes.Buffer
+}
+
+func newSVGPainter(size fyne.Size) (p *svgPainter) {
+	p = &svgPainter{}
+	w, h := num(size.Width), num(size.Height)
+	fmt.Fprintf(&p.buf, "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	fmt.Fprintf(&p.buf, "<svg xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\" "+
+		"width=\"%s\" height=\"%s\" viewBox=\"0 0 %s %s\">\n", w, h, w, h)
+	return
+}
+
+func (p *svgPainter) rect(x, y, w, h float32, fill color.Color, stroke color.Color, strokeWidth float32,
+	radius float32) {
+	if !visible(fill) && (!visible(stroke) || strokeWidth <= 0) {
+		return
+	}
+	fmt.Fprintf(&p.buf, "<rect x=\"%s\" y=\"%s\" width=\"%s\" height=\"%s\"", num(x), num(y), num(w), num(h))
+	if radius > 0 {
+		fmt.Fprintf(&p.buf, " rx=\"%s\"", num(radius))
+	}
+	p.paint(fill, stroke, strokeWidth)
+	p.buf.WriteString("/>\n")
+}
+
+func (p *svgPainter) line(x1, y1, x2, y2 float32, stroke color.Color, strokeWidth float32) {
+	if !visible(stroke) || strokeWidth <= 0 {
+		return
+	}
+	fmt.Fprintf(&p.buf, "<line x1=\"%s\" y1=\"%s\" x2=\"%s\" y2=\"%s\"", num(x1), num(y1), num(x2), num(y2))
+	p.paint(nil, stroke, strokeWidth)
+	p.buf.WriteString("/>\n")
+}
+
+func (p *svgPainter) ellipse(cx, cy, rx, ry float32, fill color.Color, stroke color.Color, strokeWidth float32) {
+	if !visible(fill) && (!visible(stroke) || strokeWidth <= 0) {
+		return
+	}
+	fmt.Fprintf(&p.buf, "<ellipse cx=\"%s\" cy=\"%s\" rx=\"%s\" ry=\"%s\"", num(cx), num(cy), num(rx), num(ry))
+	p.paint(fill, stroke, strokeWidth)
+	p.buf.WriteString("/>\n")
+}
+
+func (p *svgPainter) text(t textItem) {
+	if t.s == "" || !visible(t.col) {
+		return
+	}
+	fmt.Fprintf(&p.buf, "<text x=\"%s\" y=\"%s\" font-size=\"%s\" font-family=\"%s\"", num(t.x),
+		num(t.y+t.baseline), num(t.size), svgFontFamily(t.style))
+	if t.style.Bold {
+		p.buf.WriteString(" font-weight=\"bold\"")
+	}
+	if t.style.Italic {
+		p.buf.WriteString(" font-style=\"italic\"")
+	}
+	if t.rot != 0 {
+		fmt.Fprintf(&p.buf, " transform=\"rotate(%s %s %s)\"", num(float32(-t.rot)), num(t.x+t.w/2),
+			num(t.y+t.h/2))
+	}
+	p.paint(t.col, nil, 0)
+	p.buf.WriteString(">")
+	xml.EscapeText(&p.buf, []byte(t.s))
+	p.buf.WriteString("</text>\n")
+}
+
+func (p *svgPainter) image(x, y, w, h float32, img image.Image) {
+	var data bytes.Buffer
+	if png.Encode(&data, img) != nil {
+		return
+	}
+	fmt.Fprintf(&p.buf, "<image x=\"%s\" y=\"%s\" width=\"%s\" height=\"%s\" preserveAspectRatio=\"none\" "+
+		"xlink:href=\"data:image/png;base64,%s\"/>\n", num(x), num(y), num(w), num(h),
+		base64.StdEncoding.EncodeToString(data.Bytes()))
+}
+
+func (p *svgPainter) polygon(pts []fyne.Position, fill color.Color) {
+	if len(pts) < 3 || !visible(fill) {
+		return
+	}
+	p.buf.WriteString("<path d=\"")
+	for i := range pts {
+		op := "L"
+		if i == 0 {
+			op = "M"
+		}
+		fmt.Fprintf(&p.buf, "%s%s %s ", op, num(pts[i].X), num(pts[i].Y))
+	}
+	p.buf.WriteString("Z\" fill-rule=\"evenodd\"")
+	p.paint(fill, nil, 0)
+	p.buf.WriteString("/>\n")
+}
+
+func (p *svgPainter) finish(w io.Writer) (err error) {
+	p.buf.WriteString("</svg>\n")
+	_, err = p.buf.WriteTo(w)
+	return
+}
+
+// paint writes the fill and stroke attributes
+func (p *svgPainter) paint(fill color.Color, stroke color.Color, strokeWidth float32) {
+	if visible(fill) {
+		hex, opacity := svgColor(fill)
+		fmt.Fprintf(&p.buf, " fill=\"%s\"", hex)
+		if opacity != "" {
+			fmt.Fprintf(&p.buf, " fill-opacity=\"%s\"", opacity)
+		}
+	} else {
+		p.buf.WriteString(" fill=\"none\"")
+	}
+	if visible(stroke) && strokeWidth > 0 {
+		hex, opacity := svgColor(stroke)
+		fmt.Fprintf(&p.buf, " stroke=\"%s\" stroke-width=\"%s\"", hex, num(strokeWidth))
+		if opacity != "" {
+			fmt.Fprintf(&p.buf, " stroke-opacity=\"%s\"", opacity)
+		}
+	}
+}
+
+// svgColor gives the hex notation of col and its opacity; the opacity is empty if col is opaque
+func svgColor(col color.Color) (hex string, opacity string) {
+	r, g, b, a := rgba(col)
+	hex = fmt.Sprintf("#%02x%02x%02x", r, g, b)
+	if a < 0xff {
+		opacity = strconv.FormatFloat(math.Round(float64(a)/255*1000)/1000, 'f', -1, 64)
+	}
+	return
+}
+
+func svgFontFamily(s fyne.TextStyle) (f string) {
+	f = "sans-serif"
+	if s.Monospace {
+		f = "monospace"
+	}
+	return
+}
+
+// num formats v with at most two decimals
+func num(v float32) (s string) {
+	r := math.Round(float64(v)*100) / 100
+	if r == 0 {
+		// avoid negative zero
+		r = 0
+	}
+	s = strconv.FormatFloat(r, 'f', -1, 64)
+	return
+}
@@ -0,0 +1,174 @@
+package export
+
+import (
+	"image"
+	"image/color"
+	"io"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+)
+
+// Widget is implemented by widgets whose canvas objects are exported
+// The objects of other widgets are taken from their renderer
+type Widget interface {
+	ExportObjects() (objs []fyne.CanvasObject)
+}
+
+// painter draws the primitives of a vector export; coordinates are in fyne units with the origin at the top left
+type painter interface {
+	rect(x, y, w, h float32, fill color.Color, stroke color.Color, strokeWidth float32, radius float32)
+	line(x1, y1, x2, y2 float32, stroke color.Color, strokeWidth float32)
+	ellipse(cx, cy, rx, ry float32, fill color.Color, stroke color.Color, strokeWidth float32)
+	text(t textItem)
+	image(x, y, w, h float32, img image.Image)
+	polygon(pts []fyne.Position, fill color.Color) // filled with the even-odd rule
+	finish(w io.Writer) (err error)
+}
+
+// textItem is a text with the top left corner of its unrotated box at x, y
+type textItem struct {
+	s        string
+	x        float32
+	y        float32
+	w        float32
+	h        float32
+	baseline float32 // distance between top and baseline
+	size     float32
+	style    fyne.TextStyle
+	font     fyne.Resource
+	col      color.Color
+	rot      float64 // counter-clockwise rotation around the center of the box in degrees
+}
+
+// WriteSVG writes a snapshot of the chart with the given size and theme variant as SVG document to w
+func WriteSVG(w io.Writer, ch Chart, size fyne.Size, variant fyne.ThemeVariant) (err error) {
+	err = writeVector(w, newSVGPainter(size), ch, size, variant)
+	return
+}
+
+// WritePDF writes a snapshot of the chart with the given size and theme variant as single page PDF document to w
+// One fyne unit is one point on the page
+func WritePDF(w io.Writer, ch Chart, size fyne.Size, variant fyne.ThemeVariant) (err error) {
+	err = writeVector(w, newPDFPainter(size), ch, size, variant)
+	return
+}
+
+func writeVector(w io.Writer, p painter, ch Chart, size fyne.Size, variant fyne.ThemeVariant) (err error) {
+	// rasters are copied with two pixels per unit to keep them sharp in print
+	s, err := takeSnapshot(ch, size, 2, variant, true)
+	if err != nil {
+		return
+	}
+	p.rect(0, 0, size.Width, size.Height, s.bg, nil, 0, 0)
+	for _, obj := range s.objs {
+		draw(p, obj, s.texts, s.areas)
+	}
+	err = p.finish(w)
+	return
+}
+
+// draw paints a copied object of a snapshot, whose position is absolute
+func draw(p painter, obj fyne.CanvasObject, its map[*canvas.Image]renderer.ImageText,
+	ras map[*canvas.Raster][]renderer.Outline) {
+	pos := obj.Position()
+	size := obj.Size()
+	switch o := obj.(type) {
+	case *canvas.Rectangle:
+		p.rect(pos.X, pos.Y, size.Width, size.Height, o.FillColor, o.StrokeColor, o.StrokeWidth, o.CornerRadius)
+	case *canvas.Line:
+		p.line(o.Position1.X, o.Position1.Y, o.Position2.X, o.Position2.Y, o.StrokeColor, o.StrokeWidth)
+	case *canvas.Circle:
+		p1, p2 := o.Position1, o.Position2
+		p.ellipse((p1.X+p2.X)/2, (p1.Y+p2.Y)/2, (p2.X-p1.X)/2, (p2.Y-p1.Y)/2, o.FillColor, o.StrokeColor,
+			o.StrokeWidth)
+	case *canvas.Text:
+		bounds := o.MinSize()
+		x, y := pos.X, pos.Y
+		switch o.Alignment {
+		case fyne.TextAlignTrailing:
+			x += size.Width - bounds.Width
+		case fyne.TextAlignCenter:
+			x += (size.Width - bounds.Width) / 2
+		}
+		if size.Height > bounds.Height {
+			y += (size.Height - bounds.Height) / 2
+		}
+		p.text(newTextItem(o, x, y, 0))
+	case *canvas.Image:
+		if it, ok := its[o]; ok {
+			bounds := it.Text.MinSize()
+			p.text(newTextItem(it.Text, pos.X+(size.Width-bounds.Width)/2, pos.Y+(size.Height-bounds.Height)/2,
+				it.Rot))
+		} else if o.Image != nil {
+			p.image(pos.X, pos.Y, size.Width, size.Height, o.Image)
+		}
+	case *canvas.Raster:
+		for _, outline := range ras[o] {
+			pts := make([]fyne.Position, len(outline.Points))
+			for i := range outline.Points {
+				pts[i] = pos.Add(outline.Points[i])
+			}
+			p.polygon(pts, outline.Col)
+		}
+	}
+}
+
+func newTextItem(t *canvas.Text, x float32, y float32, rot float64) (ti textItem) {
+	bounds := t.MinSize()
+	baseline := bounds.Height * 0.8
+	if a := fyne.CurrentApp(); a != nil {
+		_, baseline = a.Driver().RenderedTextSize(t.Text, t.TextSize, t.TextStyle, t.FontSource)
+	}
+	font := t.FontSource
+	if font == nil {
+		font = theme.Current().Font(t.TextStyle)
+	}
+	ti = textItem{
+		s:        t.Text,
+		x:        x,
+		y:        y,
+		w:        bounds.Width,
+		h:        bounds.Height,
+		baseline: baseline,
+		size:     t.TextSize,
+		style:    t.TextStyle,
+		font:     font,
+		col:      t.Color,
+		rot:      rot,
+	}
+	return
+}
+
+// visible reports whether col is set and not fully transparent
+func visible(col color.Color) (b bool) {
+	if col == nil {
+		return
+	}
+	_, _, _, a := col.RGBA()
+	b = a > 0
+	return
+}
+
+// transparent reports whether all pixels of img are fully transparent
+func transparent(img image.Image) (b bool) {
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if _, _, _, a := img.At(x, y).RGBA(); a > 0 {
+				return
+			}
+		}
+	}
+	b = true
+	return
+}
+
+// rgba gives the non-premultiplied components of col
+func rgba(col color.Color) (r uint8, g uint8, b uint8, a uint8) {
+	c := color.NRGBAModel.Convert(col).(color.NRGBA)
+	r, g, b, a = c.R, c.G, c.B, c.A
+	return
+}
@@ -73,11 +73,28 @@ func (l *Legend) RemoveEntry(name string, super string) {
 	l.Refresh()
 }
 
+// ExportObjects returns the entries that are drawn
+func (l *Legend) ExportObjects() (canObj []fyne.CanvasObject) {
+	for i := range l.les {
+		if (l.location == style.LegendLocationBottom || l.location == style.LegendLocationTop) && !l.les[i].showBox {
+			continue
+		}
+		canObj = append(canObj, l.les[i])
+	}
+	return
+}
+
 func (l *Legend) Location() (loc style.LegendLocation) {
 	loc = l.location
 	return
 }
 
+// Interactive reports whether series can be shown and hidden by tapping their legend entry
+func (l *Legend) Interactive() (b bool) {
+	b = l.interactive
+	return
+}
+
 func (l *Legend) SetStyle(loc style.LegendLocation, s style.ChartTextStyle, interactive bool) {
 	l.location = loc
 	l.style = s
@@ -176,12 +193,7 @@ func (lr *legendRenderer) Refresh() {
 }
 
 func (lr *legendRenderer) Objects() (canObj []fyne.CanvasObject) {
-	for i := range lr.l.les {
-		if (lr.l.location == style.LegendLocationBottom || lr.l.location == style.LegendLocationTop) && !lr.l.les[i].showBox {
-			continue
-		}
-		canObj = append(canObj, lr.l.les[i])
-	}
+	canObj = lr.l.ExportObjects()
 	return
 }
 
@@ -271,6 +283,15 @@ func (le *LegendEntry) RefreshTheme() {
 	le.box.refreshTheme()
 }
 
+// ExportObjects returns the box and the label of the entry
+func (le *LegendEntry) ExportObjects() (canObj []fyne.CanvasObject) {
+	if le.showBox {
+		canObj = append(canObj, le.box)
+	}
+	canObj = append(canObj, le.label)
+	return
+}
+
 func (le *LegendEntry) SetSuper(super string) {
 	le.super = super
 }
@@ -378,10 +399,7 @@ func (ler *legendEntryRenderer) Refresh() {
 }
 
 func (ler *legendEntryRenderer) Objects() (canObj []fyne.CanvasObject) {
-	if ler.le.showBox {
-		canObj = append(canObj, ler.le.box)
-	}
-	canObj = append(canObj, ler.le.label)
+	canObj = ler.le.ExportObjects()
 	return
 }
 
@@ -415,6 +433,12 @@ func (box *legendBox) CreateRenderer() fyne.WidgetRenderer {
 	return widget.NewSimpleRenderer(c)
 }
 
+// ExportObjects returns the rectangle and the circle, which fill the box
+func (box *legendBox) ExportObjects() (canObj []fyne.CanvasObject) {
+	canObj = []fyne.CanvasObject{box.rect, box.circle}
+	return
+}
+
 func (box *legendBox) refreshTheme() {
 	box.rect.FillColor = theme.Color(box.colName)
 	box.circle.FillColor = theme.Color(box.colName)
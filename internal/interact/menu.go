@@ -0,0 +1,182 @@
+package interact
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"io"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/storage"
+	"fyne.io/fyne/v2/widget"
+)
+
+// MenuChart is implemented by charts that offer the standard actions of the context menu
+type MenuChart interface {
+	ExportImage(size fyne.Size, scale float32) (img image.Image, err error)
+	WriteSVG(w io.Writer, size fyne.Size) (err error)
+	DataCSV() (s string)
+	CSVSupported() (b bool)
+	Zoomed() (b bool)
+	ResetZoom()
+	LegendVisible() (b bool)
+	ShowLegend()
+	HideLegend()
+}
+
+// ContextMenu is the configuration of the menu that is shown on right-click or long-press on a chart
+type ContextMenu struct {
+	enabled   bool
+	items     []*fyne.MenuItem
+	copyImage func(img image.Image) (err error)
+}
+
+func NewContextMenu() (cm *ContextMenu) {
+	cm = &ContextMenu{}
+	return
+}
+
+func (cm *ContextMenu) Enabled() (b bool) {
+	b = cm.enabled
+	return
+}
+
+func (cm *ContextMenu) SetEnabled(b bool) {
+	cm.enabled = b
+}
+
+// AddItem appends an item of the app after the standard actions
+func (cm *ContextMenu) AddItem(item *fyne.MenuItem) {
+	cm.items = append(cm.items, item)
+}
+
+// SetImageCopier adds copy image to the menu; without it, the menu offers to copy the chart as SVG document text,
+// because the clipboard of Fyne only supports text
+func (cm *ContextMenu) SetImageCopier(f func(img image.Image) (err error)) {
+	cm.copyImage = f
+}
+
+// Menu builds the menu for ch; content is the object that is exported, its current size is used for the export
+func (cm *ContextMenu) Menu(ch MenuChart, content fyne.CanvasObject) (m *fyne.Menu) {
+	if !cm.enabled {
+		return
+	}
+	size := content.Size()
+	scale := float32(1)
+	var win fyne.Window
+	if a := fyne.CurrentApp(); a != nil {
+		if c := a.Driver().CanvasForObject(content); c != nil {
+			scale = c.Scale()
+			win = windowForCanvas(c)
+		}
+	}
+	copyImage := fyne.NewMenuItem("Copy as SVG", func() {
+		var buf bytes.Buffer
+		err := ch.WriteSVG(&buf, size)
+		if err == nil {
+			fyne.CurrentApp().Clipboard().SetContent(buf.String())
+		}
+		showError(err, win)
+	})
+	if cm.copyImage != nil {
+		copyImage = fyne.NewMenuItem("Copy image", func() {
+			img, err := ch.ExportImage(size, scale)
+			if err == nil {
+				err = cm.copyImage(img)
+			}
+			showError(err, win)
+		})
+	}
+	savePNG := fyne.NewMenuItem("Save as PNG...", func() {
+		saveFile(win, "chart.png", func(w io.Writer) (err error) {
+			img, err := ch.ExportImage(size, scale)
+			if err != nil {
+				return
+			}
+			err = png.Encode(w, img)
+			return
+		})
+	})
+	saveSVG := fyne.NewMenuItem("Save as SVG...", func() {
+		saveFile(win, "chart.svg", func(w io.Writer) (err error) {
+			err = ch.WriteSVG(w, size)
+			return
+		})
+	})
+	items := []*fyne.MenuItem{copyImage, savePNG, saveSVG}
+	// the action is left out if the data of some series would be missing
+	if ch.CSVSupported() {
+		items = append(items, fyne.NewMenuItem("Copy data as CSV", func() {
+			fyne.CurrentApp().Clipboard().SetContent(ch.DataCSV())
+		}))
+	}
+	resetZoom := fyne.NewMenuItem("Reset zoom", ch.ResetZoom)
+	resetZoom.Disabled = !ch.Zoomed()
+	legend := fyne.NewMenuItem("Hide legend", ch.HideLegend)
+	if !ch.LegendVisible() {
+		legend = fyne.NewMenuItem("Show legend", ch.ShowLegend)
+	}
+	items = append(items, fyne.NewMenuItemSeparator(), resetZoom, legend)
+	m = fyne.NewMenu("", items...)
+	if len(cm.items) > 0 {
+		m.Items = append(m.Items, fyne.NewMenuItemSeparator())
+		m.Items = append(m.Items, cm.items...)
+	}
+	return
+}
+
+// Show shows the menu at the absolute position pos on the canvas of obj
+func (cm *ContextMenu) Show(ch MenuChart, content fyne.CanvasObject, obj fyne.CanvasObject, pos fyne.Position) {
+	a := fyne.CurrentApp()
+	if a == nil {
+		return
+	}
+	c := a.Driver().CanvasForObject(obj)
+	m := cm.Menu(ch, content)
+	if c == nil || m == nil {
+		return
+	}
+	widget.ShowPopUpMenuAtPosition(m, c, pos)
+}
+
+// saveFile asks for a file with a dialog on win and writes it with write
+func saveFile(win fyne.Window, name string, write func(w io.Writer) (err error)) {
+	if win == nil {
+		return
+	}
+	d := dialog.NewFileSave(func(uc fyne.URIWriteCloser, err error) {
+		if err != nil || uc == nil {
+			showError(err, win)
+			return
+		}
+		err = write(uc)
+		if cErr := uc.Close(); err == nil {
+			err = cErr
+		}
+		if err != nil {
+			// do not leave an incomplete file behind
+			storage.Delete(uc.URI())
+		}
+		showError(err, win)
+	}, win)
+	d.SetFileName(name)
+	d.Show()
+}
+
+func showError(err error, win fyne.Window) {
+	if err == nil || win == nil {
+		return
+	}
+	dialog.ShowError(err, win)
+}
+
+func windowForCanvas(c fyne.Canvas) (win fyne.Window) {
+	for _, w := range fyne.CurrentApp().Driver().AllWindows() {
+		if w.Canvas() == c {
+			win = w
+			return
+		}
+	}
+	return
+}
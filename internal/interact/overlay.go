@@ -17,6 +17,16 @@ type chart interface {
 	MouseOut()
 }
 
+// tapChart is implemented by charts that react to taps on the drawing area
+type tapChart interface {
+	Tap(pX, pY, w, h float32)
+}
+
+// menuChart is implemented by charts that show a context menu on secondary taps
+type menuChart interface {
+	ShowContextMenu(obj fyne.CanvasObject, pos fyne.Position)
+}
+
 type Overlay struct {
 	widget.BaseWidget
 	chart chart
@@ -39,8 +49,22 @@ func (ol *Overlay) CreateRenderer() (r fyne.WidgetRenderer) {
 	return
 }
 
-func (ol *Overlay) Tapped(_ *fyne.PointEvent) {
+func (ol *Overlay) Tapped(pe *fyne.PointEvent) {
+	tc, ok := ol.chart.(tapChart)
+	if !ok {
+		return
+	}
+	size := ol.rect.Size()
+	tc.Tap(pe.Position.X, pe.Position.Y, size.Width, size.Height)
+}
 
+// TappedSecondary shows the context menu of the chart on right-click or long-press
+func (ol *Overlay) TappedSecondary(pe *fyne.PointEvent) {
+	mc, ok := ol.chart.(menuChart)
+	if !ok {
+		return
+	}
+	mc.ShowContextMenu(ol, pe.AbsolutePosition)
 }
 
 func (ol *Overlay) MouseIn(me *desktop.MouseEvent) {
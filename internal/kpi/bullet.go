@@ -0,0 +1,190 @@
+package kpi
+
+import (
+	"math"
+
+	"github.com/s-daehling/fyne-charts/internal/coord/axis"
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+	"github.com/s-daehling/fyne-charts/pkg/style"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+// Bullet is a horizontal bullet chart with a value bar, a target marker and qualitative ranges; it is drawn by the cartesian renderer
+type Bullet struct {
+	baseChart
+	ax            *axis.Axis
+	rangeRects    []*canvas.Rectangle
+	bar           *canvas.Rectangle
+	barColName    fyne.ThemeColorName
+	target        float64
+	hasTarget     bool
+	marker        *canvas.Line
+	markerColName fyne.ThemeColorName
+}
+
+func EmptyBullet() (b *Bullet) {
+	b = &Bullet{
+		baseChart:     emptyBaseChart(),
+		ax:            axis.EmptyAxis("", axis.CartesianHorAxis),
+		bar:           canvas.NewRectangle(theme.Color(theme.ColorNameForeground)),
+		barColName:    theme.ColorNameForeground,
+		marker:        canvas.NewLine(theme.Color(theme.ColorNameError)),
+		markerColName: theme.ColorNameError,
+	}
+	as := style.DefaultAxisStyle()
+	as.LineShowArrow = false
+	b.ax.SetAxisStyle(as)
+	b.ax.SetAutoTicks(false)
+	b.marker.StrokeWidth = 3
+	b.ExtendBaseWidget(b)
+	b.initContainer(b)
+	return
+}
+
+func (b *Bullet) CreateRenderer() (r fyne.WidgetRenderer) {
+	b.render = renderer.EmptyCartesianRenderer(b)
+	r = b.render
+	return
+}
+
+// SetTarget sets the value at which the target marker is drawn
+func (b *Bullet) SetTarget(t float64) {
+	b.target = t
+	b.hasTarget = true
+	b.Refresh()
+}
+
+// HideTarget removes the target marker
+func (b *Bullet) HideTarget() {
+	b.hasTarget = false
+	b.Refresh()
+}
+
+func (b *Bullet) SetBarColor(colName fyne.ThemeColorName) {
+	b.barColName = colName
+	b.bar.FillColor = theme.Color(colName)
+	b.bar.Refresh()
+}
+
+func (b *Bullet) SetTargetColor(colName fyne.ThemeColorName) {
+	b.markerColName = colName
+	b.marker.StrokeColor = theme.Color(colName)
+	b.marker.Refresh()
+}
+
+func (b *Bullet) SetAxisStyle(s style.AxisStyle) {
+	b.ax.SetAxisStyle(s)
+	b.Refresh()
+}
+
+func (b *Bullet) CartesianObjects() (canObj []fyne.CanvasObject) {
+	rs := b.CartesianRects()
+	for i := range rs {
+		canObj = append(canObj, rs[i].Rect)
+	}
+	es := b.CartesianEdges()
+	for i := range es {
+		canObj = append(canObj, es[i].Line)
+	}
+	ts := b.CartesianTexts()
+	for i := range ts {
+		canObj = append(canObj, ts[i].Text)
+	}
+	canObj = append(canObj, b.ax.Objects()...)
+	return
+}
+
+func (b *Bullet) CartesianNodes() (ns []renderer.CartesianNode) {
+	return
+}
+
+func (b *Bullet) CartesianEdges() (es []renderer.CartesianEdge) {
+	if !b.hasTarget || b.target < b.min || b.target > b.max {
+		return
+	}
+	es = append(es, renderer.CartesianEdge{
+		X1:   b.target,
+		Y1:   0.1,
+		X2:   b.target,
+		Y2:   0.7,
+		Line: b.marker,
+	})
+	return
+}
+
+func (b *Bullet) CartesianRects() (rs []renderer.CartesianRect) {
+	for len(b.rangeRects) < len(b.ranges) {
+		b.rangeRects = append(b.rangeRects, canvas.NewRectangle(theme.Color(theme.ColorNameForeground)))
+	}
+	for i := range b.ranges {
+		if b.ranges[i].Max < b.min || b.ranges[i].Min > b.max {
+			continue
+		}
+		b.rangeRects[i].FillColor = theme.Color(b.ranges[i].ColName)
+		rs = append(rs, renderer.CartesianRect{
+			X1:   math.Max(b.ranges[i].Min, b.min),
+			Y1:   0,
+			X2:   math.Min(b.ranges[i].Max, b.max),
+			Y2:   0.8,
+			Rect: b.rangeRects[i],
+		})
+	}
+	rs = append(rs, renderer.CartesianRect{
+		X1:   b.min,
+		Y1:   0.25,
+		X2:   b.clampedValue(),
+		Y2:   0.55,
+		Rect: b.bar,
+	})
+	return
+}
+
+func (b *Bullet) CartesianTexts() (ts []renderer.CartesianText) {
+	b.updateValueText()
+	ts = append(ts, renderer.CartesianText{X: b.clampedValue(), Y: 0.9, Text: b.valText})
+	return
+}
+
+func (b *Bullet) CartesianOrientation() (trans bool) {
+	trans = false
+	return
+}
+
+func (b *Bullet) Raster() (rs *canvas.Raster) {
+	rs = nil
+	return
+}
+
+func (b *Bullet) FromAxisElements() (min float64, max float64, origin float64,
+	ticks []renderer.Tick, arrow renderer.Arrow, show bool) {
+	b.ax.SetNRange(b.min, b.max)
+	b.ax.SetNOrigin(b.min)
+	min, max = b.min, b.max
+	origin = b.min
+	ticks = b.ax.Ticks()
+	arrow = b.ax.Arrow()
+	show = b.ax.Visible()
+	return
+}
+
+func (b *Bullet) ToAxisElements() (min float64, max float64, origin float64,
+	ticks []renderer.Tick, arrow renderer.Arrow, show bool) {
+	min, max = 0, 1
+	return
+}
+
+func (b *Bullet) ChartSizeChange(fromSpace float32, toSpace float32) {
+	b.ax.SetNRange(b.min, b.max)
+	b.ax.SetSpace(fromSpace)
+	b.ax.AutoNTicks()
+}
+
+func (b *Bullet) RefreshTheme() {
+	b.refreshBaseTheme()
+	b.ax.RefreshTheme()
+	b.bar.FillColor = theme.Color(b.barColName)
+	b.marker.StrokeColor = theme.Color(b.markerColName)
+}
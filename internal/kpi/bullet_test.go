@@ -0,0 +1,111 @@
+package kpi
+
+import (
+	"math"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+func TestBulletValue(t *testing.T) {
+	test.NewTempApp(t)
+	var tests = []struct {
+		val     float64
+		expBar  float64
+		expText string
+	}{
+		{70, 70, "70"},
+		{150, 100, "150"},
+		{-20, 0, "-20"},
+	}
+	b := EmptyBullet()
+	b.SetAnimationDuration(0)
+	for i, tt := range tests {
+		err := b.SetValue(tt.val)
+		if err != nil {
+			t.Errorf("unexpected error, set %d: %s", i, err)
+			continue
+		}
+		// the bar is the last rect and clamped to the range of the chart, the text shows the value
+		rs := b.CartesianRects()
+		bar := rs[len(rs)-1]
+		if bar.X1 != 0 || bar.X2 != tt.expBar {
+			t.Errorf("wrong bar, set %d, exp 0 to %f, have %f to %f", i, tt.expBar, bar.X1, bar.X2)
+		}
+		ts := b.CartesianTexts()
+		if len(ts) != 1 || ts[0].Text.Text != tt.expText || ts[0].X != tt.expBar {
+			t.Errorf("wrong value text, set %d, exp %s at %f", i, tt.expText, tt.expBar)
+		}
+	}
+	if err := b.SetValue(math.NaN()); err == nil {
+		t.Errorf("no error for invalid value")
+	}
+	if b.Value() != -20 {
+		t.Errorf("value changed by invalid value, have %f", b.Value())
+	}
+}
+
+func TestBulletTarget(t *testing.T) {
+	test.NewTempApp(t)
+	b := EmptyBullet()
+	if len(b.CartesianEdges()) != 0 {
+		t.Errorf("target marker drawn without target")
+	}
+	b.SetTarget(80)
+	es := b.CartesianEdges()
+	if len(es) != 1 || es[0].X1 != 80 || es[0].X2 != 80 {
+		t.Errorf("wrong target marker, exp one edge at 80, have %v", es)
+	}
+	// targets outside the range of the chart are not drawn
+	b.SetTarget(120)
+	if len(b.CartesianEdges()) != 0 {
+		t.Errorf("target marker outside range drawn")
+	}
+	b.SetTarget(30)
+	b.HideTarget()
+	if len(b.CartesianEdges()) != 0 {
+		t.Errorf("hidden target marker drawn")
+	}
+}
+
+func TestBulletRanges(t *testing.T) {
+	test.NewTempApp(t)
+	var tests = []struct {
+		ranges     []data.ValueRange
+		expSuccess bool
+		expRects   [][2]float64
+	}{
+		{[]data.ValueRange{
+			{Min: 0, Max: 50, ColName: theme.ColorNameError},
+			{Min: 40, Max: 120, ColName: theme.ColorNameSuccess},
+			{Min: 150, Max: 200, ColName: theme.ColorNamePrimary},
+		}, true, [][2]float64{{0, 50}, {40, 100}}},
+		{[]data.ValueRange{{Min: 50, Max: 50}}, false, nil},
+		{[]data.ValueRange{{Min: 0, Max: math.NaN()}}, false, nil},
+		{[]data.ValueRange{{Min: math.Inf(-1), Max: 10}}, false, nil},
+		{[]data.ValueRange{{Min: 0, Max: math.Inf(1)}}, false, nil},
+		{[]data.ValueRange{}, true, nil},
+	}
+	for i, tt := range tests {
+		b := EmptyBullet()
+		err := b.SetRanges(tt.ranges)
+		if err != nil && tt.expSuccess {
+			t.Errorf("setting ranges failed incorrectly, set %d: %s", i, err)
+		} else if err == nil && !tt.expSuccess {
+			t.Errorf("setting ranges succeeded incorrectly, set %d", i)
+		}
+		// the bands are drawn before the bar and clamped to the range of the chart
+		rs := b.CartesianRects()
+		if len(rs) != len(tt.expRects)+1 {
+			t.Errorf("wrong number of rects, set %d, exp %d, have %d", i, len(tt.expRects)+1, len(rs))
+			continue
+		}
+		for j := range tt.expRects {
+			if rs[j].X1 != tt.expRects[j][0] || rs[j].X2 != tt.expRects[j][1] {
+				t.Errorf("wrong band %d, set %d, exp %v, have %f to %f", j, i, tt.expRects[j], rs[j].X1, rs[j].X2)
+			}
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package kpi
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/s-daehling/fyne-charts/internal/interact"
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+	"github.com/s-daehling/fyne-charts/pkg/style"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// baseChart holds everything gauge and bullet charts have in common: a value within a range, colored ranges and the value label
+type baseChart struct {
+	widget.BaseWidget
+	title        *canvas.Text
+	titleStyle   style.ChartTextStyle
+	render       fyne.WidgetRenderer
+	mainCont     *fyne.Container
+	min          float64
+	max          float64
+	val          float64
+	displayedVal float64
+	precision    int
+	ranges       []data.ValueRange
+	valText      *canvas.Text
+	valTextStyle style.ChartTextStyle
+	anim         *fyne.Animation
+	animDuration time.Duration
+}
+
+func emptyBaseChart() (base baseChart) {
+	base = baseChart{
+		title:        canvas.NewText("", theme.Color(theme.ColorNameForeground)),
+		min:          0,
+		max:          100,
+		precision:    0,
+		valText:      canvas.NewText("", theme.Color(theme.ColorNameForeground)),
+		animDuration: 500 * time.Millisecond,
+	}
+	base.SetTitleStyle(style.DefaultTitleStyle())
+	base.SetValueTextStyle(style.ChartTextStyle{
+		Alignment: fyne.TextAlignCenter,
+		ColorName: theme.ColorNameForeground,
+		SizeName:  theme.SizeNameSubHeadingText,
+		TextStyle: fyne.TextStyle{Bold: true},
+	})
+	return
+}
+
+// initContainer places the chart widget below its title; must be called after ExtendBaseWidget
+func (base *baseChart) initContainer(chart fyne.CanvasObject) {
+	base.mainCont = container.NewBorder(base.title, nil, nil, nil, chart)
+}
+
+func (base *baseChart) MainContainer() (cont *fyne.Container) {
+	cont = base.mainCont
+	return
+}
+
+func (base *baseChart) Refresh() {
+	if base.render != nil {
+		base.render.Refresh()
+	}
+}
+
+// Lock has nothing to do; the value of a kpi chart is only changed on the main goroutine
+func (base *baseChart) Lock() {}
+
+// Unlock has nothing to do
+func (base *baseChart) Unlock() {}
+
+func (base *baseChart) SetTitle(l string) {
+	base.title.Text = l
+	if l == "" && !base.title.Hidden {
+		base.title.Hide()
+	} else if l != "" && base.title.Hidden {
+		base.title.Show()
+	}
+	base.title.Refresh()
+}
+
+func (base *baseChart) SetTitleStyle(ts style.ChartTextStyle) {
+	base.titleStyle = ts
+	base.title.Alignment = ts.Alignment
+	base.title.TextSize = theme.Size(ts.SizeName)
+	base.title.Color = theme.Color(ts.ColorName)
+	base.title.TextStyle = ts.TextStyle
+	base.title.Refresh()
+}
+
+func (base *baseChart) SetValueTextStyle(ts style.ChartTextStyle) {
+	base.valTextStyle = ts
+	base.valText.TextSize = theme.Size(ts.SizeName)
+	base.valText.Color = theme.Color(ts.ColorName)
+	base.valText.TextStyle = ts.TextStyle
+	base.valText.Refresh()
+}
+
+func (base *baseChart) SetValuePrecision(prec int) {
+	if prec < 0 {
+		return
+	}
+	base.precision = prec
+	base.Refresh()
+}
+
+func (base *baseChart) SetRange(min float64, max float64) (err error) {
+	if !validRange(min, max) {
+		err = errors.New("invalid range")
+		return
+	}
+	base.min = min
+	base.max = max
+	base.Refresh()
+	return
+}
+
+func (base *baseChart) SetRanges(rs []data.ValueRange) (err error) {
+	for i := range rs {
+		if !validRange(rs[i].Min, rs[i].Max) {
+			err = errors.New("invalid range")
+			return
+		}
+	}
+	base.ranges = append([]data.ValueRange{}, rs...)
+	base.Refresh()
+	return
+}
+
+// validRange checks that min and max are finite and min is smaller than max
+func validRange(min float64, max float64) (b bool) {
+	b = min < max && !math.IsNaN(min) && !math.IsNaN(max) && !math.IsInf(min, 0) && !math.IsInf(max, 0)
+	return
+}
+
+func (base *baseChart) SetAnimationDuration(d time.Duration) {
+	if d < 0 {
+		return
+	}
+	base.animDuration = d
+}
+
+// SetValue sets a new value; the chart animates from the currently displayed value to the new one
+func (base *baseChart) SetValue(val float64) (err error) {
+	if math.IsNaN(val) || math.IsInf(val, 0) {
+		err = errors.New("invalid value")
+		return
+	}
+	if base.anim != nil {
+		base.anim.Stop()
+		base.anim = nil
+	}
+	base.val = val
+	if base.animDuration == 0 || fyne.CurrentApp() == nil {
+		base.displayedVal = val
+		base.Refresh()
+		return
+	}
+	from := base.displayedVal
+	base.anim = fyne.NewAnimation(base.animDuration, func(f float32) {
+		base.displayedVal = from + (float64(f) * (val - from))
+		base.Refresh()
+	})
+	base.anim.Curve = fyne.AnimationEaseInOut
+	base.anim.Start()
+	return
+}
+
+func (base *baseChart) Value() (val float64) {
+	val = base.val
+	return
+}
+
+// clampedValue gives the displayed value limited to the range of the chart
+func (base *baseChart) clampedValue() (val float64) {
+	val = math.Min(math.Max(base.displayedVal, base.min), base.max)
+	return
+}
+
+func (base *baseChart) updateValueText() {
+	base.valText.Text = strconv.FormatFloat(base.displayedVal, 'f', base.precision, 64)
+}
+
+func (base *baseChart) refreshBaseTheme() {
+	base.title.TextSize = theme.Size(base.titleStyle.SizeName)
+	base.title.Color = theme.Color(base.titleStyle.ColorName)
+	base.valText.TextSize = theme.Size(base.valTextStyle.SizeName)
+	base.valText.Color = theme.Color(base.valTextStyle.ColorName)
+}
+
+func (base *baseChart) Tooltip() (tt renderer.Tooltip) {
+	return
+}
+
+func (base *baseChart) Overlay() (io *interact.Overlay) {
+	io = nil
+	return
+}
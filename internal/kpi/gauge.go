@@ -0,0 +1,194 @@
+package kpi
+
+import (
+	"image/color"
+	"math"
+	"strconv"
+
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+)
+
+const (
+	gaugeArcInner   = 0.75
+	gaugeArcOuter   = 0.95
+	gaugeNeedleTail = -0.1
+	gaugeNeedleTip  = 0.9
+)
+
+// Gauge is a radial gauge with a colored arc and a needle; it is drawn by the polar renderer
+type Gauge struct {
+	baseChart
+	startAngle float64
+	sweep      float64
+	rast       *canvas.Raster
+	needle     *canvas.Line
+	hub        *canvas.Circle
+	minText    *canvas.Text
+	maxText    *canvas.Text
+}
+
+func EmptyGauge() (g *Gauge) {
+	g = &Gauge{
+		baseChart:  emptyBaseChart(),
+		startAngle: 5 * math.Pi / 4,
+		sweep:      3 * math.Pi / 2,
+		needle:     canvas.NewLine(theme.Color(theme.ColorNameForeground)),
+		hub:        canvas.NewCircle(theme.Color(theme.ColorNameForeground)),
+		minText:    canvas.NewText("", theme.Color(theme.ColorNameForeground)),
+		maxText:    canvas.NewText("", theme.Color(theme.ColorNameForeground)),
+	}
+	g.rast = canvas.NewRasterWithPixels(g.PixelGenPolar)
+	g.needle.StrokeWidth = 3
+	g.hub.Resize(fyne.NewSize(12, 12))
+	g.ExtendBaseWidget(g)
+	g.initContainer(g)
+	return
+}
+
+func (g *Gauge) CreateRenderer() (r fyne.WidgetRenderer) {
+	g.render = renderer.EmptyPolarRenderer(g)
+	r = g.render
+	return
+}
+
+// SetArcAngles sets the angles (in radians, counterclockwise from the positive x-axis) at which min and max are drawn.
+// The arc runs clockwise from minAngle to maxAngle; equal angles result in a full circle
+func (g *Gauge) SetArcAngles(minAngle float64, maxAngle float64) {
+	g.startAngle = normalizeAngle(minAngle)
+	g.sweep = normalizeAngle(minAngle - maxAngle)
+	if g.sweep == 0 {
+		g.sweep = 2 * math.Pi
+	}
+	g.Refresh()
+}
+
+// normalizeAngle maps an angle to [0, 2pi)
+func normalizeAngle(a float64) (n float64) {
+	n = math.Mod(a, 2*math.Pi)
+	if n < 0 {
+		n += 2 * math.Pi
+	}
+	return
+}
+
+// valueToAngle gives the angle of val on an arc starting at start and running clockwise over sweep
+func valueToAngle(val float64, min float64, max float64, start float64, sweep float64) (phi float64) {
+	phi = normalizeAngle(start - (((val - min) / (max - min)) * sweep))
+	return
+}
+
+// angleToValue gives the value at angle phi and whether phi is part of the arc
+func angleToValue(phi float64, min float64, max float64, start float64, sweep float64) (val float64, onArc bool) {
+	offset := normalizeAngle(start - phi)
+	if offset > sweep {
+		return
+	}
+	onArc = true
+	val = min + ((offset / sweep) * (max - min))
+	return
+}
+
+func (g *Gauge) PixelGenPolar(pX, pY, w, h int) (col color.Color) {
+	col = color.RGBA{0x00, 0x00, 0x00, 0x00}
+	posToCoord := 1.0 / (float64(w) / 2.0)
+	x := (float64(pX) - (float64(w) / 2.0)) * posToCoord
+	y := ((float64(h) / 2.0) - float64(pY)) * posToCoord
+	r := math.Sqrt(math.Pow(x, 2) + math.Pow(y, 2))
+	if r < gaugeArcInner || r > gaugeArcOuter {
+		return
+	}
+	val, onArc := angleToValue(math.Atan2(y, x), g.min, g.max, g.startAngle, g.sweep)
+	if !onArc {
+		return
+	}
+	col = theme.Color(theme.ColorNameInputBorder)
+	for i := range g.ranges {
+		if val >= g.ranges[i].Min && val <= g.ranges[i].Max {
+			col = theme.Color(g.ranges[i].ColName)
+		}
+	}
+	return
+}
+
+func (g *Gauge) PolarObjects() (canObj []fyne.CanvasObject) {
+	canObj = append(canObj, g.rast)
+	es := g.PolarEdges()
+	for i := range es {
+		canObj = append(canObj, es[i].Line)
+	}
+	ns := g.PolarNodes()
+	for i := range ns {
+		canObj = append(canObj, ns[i].Dot)
+	}
+	ts := g.PolarTexts()
+	for i := range ts {
+		canObj = append(canObj, ts[i].Text)
+	}
+	return
+}
+
+func (g *Gauge) PolarOrientation() (rot float64, mathPos bool) {
+	rot = 0
+	mathPos = true
+	return
+}
+
+func (g *Gauge) PolarNodes() (ns []renderer.PolarNode) {
+	ns = append(ns, renderer.PolarNode{Phi: 0, R: 0, Dot: g.hub})
+	return
+}
+
+func (g *Gauge) PolarEdges() (es []renderer.PolarEdge) {
+	phi := valueToAngle(g.clampedValue(), g.min, g.max, g.startAngle, g.sweep)
+	es = append(es, renderer.PolarEdge{
+		Phi1: phi,
+		R1:   gaugeNeedleTail,
+		Phi2: phi,
+		R2:   gaugeNeedleTip,
+		Line: g.needle,
+	})
+	return
+}
+
+func (g *Gauge) PolarTexts() (ts []renderer.PolarText) {
+	g.updateValueText()
+	ts = append(ts, renderer.PolarText{Phi: 3 * math.Pi / 2, R: 0.4, Text: g.valText})
+	g.minText.Text = strconv.FormatFloat(g.min, 'g', 6, 64)
+	g.maxText.Text = strconv.FormatFloat(g.max, 'g', 6, 64)
+	if g.sweep < 2*math.Pi {
+		ts = append(ts, renderer.PolarText{Phi: g.startAngle, R: 0.6, Text: g.minText})
+		ts = append(ts, renderer.PolarText{Phi: g.startAngle - g.sweep, R: 0.6, Text: g.maxText})
+	}
+	return
+}
+
+func (g *Gauge) Raster() (rs *canvas.Raster) {
+	rs = g.rast
+	return
+}
+
+func (g *Gauge) FromAxisElements() (min float64, max float64, origin float64,
+	ticks []renderer.Tick, arrow renderer.Arrow, show bool) {
+	min, max = 0, 2*math.Pi
+	return
+}
+
+func (g *Gauge) ToAxisElements() (min float64, max float64, origin float64,
+	ticks []renderer.Tick, arrow renderer.Arrow, show bool) {
+	min, max = 0, 1
+	return
+}
+
+func (g *Gauge) ChartSizeChange(fromSpace float32, toSpace float32) {}
+
+func (g *Gauge) RefreshTheme() {
+	g.refreshBaseTheme()
+	g.needle.StrokeColor = theme.Color(theme.ColorNameForeground)
+	g.hub.FillColor = theme.Color(theme.ColorNameForeground)
+	g.minText.Color = theme.Color(theme.ColorNameForeground)
+	g.maxText.Color = theme.Color(theme.ColorNameForeground)
+}
@@ -0,0 +1,36 @@
+package kpi
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGaugeAngles(t *testing.T) {
+	var tests = []struct {
+		val    float64
+		min    float64
+		max    float64
+		start  float64
+		sweep  float64
+		expPhi float64
+	}{
+		{0, 0, 100, 5 * math.Pi / 4, 3 * math.Pi / 2, 5 * math.Pi / 4},
+		{100, 0, 100, 5 * math.Pi / 4, 3 * math.Pi / 2, 7 * math.Pi / 4},
+		{50, 0, 100, 5 * math.Pi / 4, 3 * math.Pi / 2, math.Pi / 2},
+		{-5, -10, 0, math.Pi, math.Pi, math.Pi / 2},
+	}
+	for i, tt := range tests {
+		phi := valueToAngle(tt.val, tt.min, tt.max, tt.start, tt.sweep)
+		if math.Abs(phi-tt.expPhi) > 0.000001 {
+			t.Errorf("wrong angle, set %d, exp %f, have %f", i, tt.expPhi, phi)
+		}
+		val, onArc := angleToValue(phi, tt.min, tt.max, tt.start, tt.sweep)
+		if !onArc || math.Abs(val-tt.val) > 0.000001 {
+			t.Errorf("wrong value, set %d, exp %f, have %f", i, tt.val, val)
+		}
+	}
+	// the bottom of the default arc is not part of the gauge
+	if _, onArc := angleToValue(3*math.Pi/2, 0, 100, 5*math.Pi/4, 3*math.Pi/2); onArc {
+		t.Errorf("angle outside arc detected as on arc")
+	}
+}
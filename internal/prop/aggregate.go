@@ -0,0 +1,196 @@
+package prop
+
+import (
+	"errors"
+	"slices"
+	"sort"
+
+	"fyne.io/fyne/v2"
+)
+
+// SetSortByValue defines whether the points are displayed in order of descending value
+// Otherwise they are displayed in the order in which they have been added
+func (ser *Series) SetSortByValue(sortByValue bool) {
+	ser.mu.Lock()
+	ser.sortByValue = sortByValue
+	ser.mu.Unlock()
+	ser.dataChange()
+}
+
+// SetTopN limits the displayed points to the n largest; all other points are aggregated in the other category
+// n = 0 removes the limit
+func (ser *Series) SetTopN(n int) (err error) {
+	if n < 0 {
+		err = errors.New("invalid number of points")
+		return
+	}
+	ser.mu.Lock()
+	ser.topN = n
+	ser.mu.Unlock()
+	ser.dataChange()
+	return
+}
+
+// SetThreshold aggregates all points with a share below threshold in the other category
+// threshold = 0 removes the limit
+func (ser *Series) SetThreshold(threshold float64) (err error) {
+	if threshold < 0 || threshold > 1 {
+		err = errors.New("invalid threshold")
+		return
+	}
+	ser.mu.Lock()
+	ser.threshold = threshold
+	ser.mu.Unlock()
+	ser.dataChange()
+	return
+}
+
+// SetOtherCategory changes name and color of the category that aggregates all collapsed points
+func (ser *Series) SetOtherCategory(name string, colName fyne.ThemeColorName) {
+	ser.mu.Lock()
+	other := ser.other
+	ser.other = nil
+	ser.otherName = name
+	ser.otherCol = colName
+	ser.mu.Unlock()
+	if other != nil && other.inLegend && ser.chart != nil {
+		ser.chart.RemoveLegendEntry(other.c, ser.name)
+	}
+	ser.dataChange()
+}
+
+// dataChange updates the aggregation and the chart in one step, like a change of the data of the series
+func (ser *Series) dataChange() {
+	if ser.chart == nil {
+		ser.updateAggregation()
+		return
+	}
+	ser.chart.change(ser.updateAggregation)
+}
+
+// points gives all points that are displayed: points that are not collapsed followed by the other category
+func (ser *Series) points() (ps []*proportionPoint) {
+	for _, point := range ser.ordered() {
+		if !point.collapsed {
+			ps = append(ps, point)
+		}
+	}
+	if ser.other != nil && len(ser.other.members) > 0 {
+		ps = append(ps, ser.other)
+	}
+	return
+}
+
+// ordered gives the points in the order in which they are displayed
+// ser.data always keeps the order in which the points have been added
+func (ser *Series) ordered() (ps []*proportionPoint) {
+	ps = slices.Clone(ser.data)
+	if ser.sortByValue {
+		sort.SliceStable(ps, func(i, j int) bool { return ps[i].val > ps[j].val })
+	}
+	return
+}
+
+// allPoints gives all points including collapsed points and the other category
+func (ser *Series) allPoints() (ps []*proportionPoint) {
+	ps = append(ps, ser.data...)
+	if ser.other != nil {
+		ps = append(ps, ser.other)
+	}
+	return
+}
+
+// updateAggregation collapses the points that do not meet top N or threshold and updates the total
+func (ser *Series) updateAggregation() {
+	ser.mu.Lock()
+	ordered := ser.ordered()
+	vals := []float64{}
+	for _, point := range ordered {
+		if point.visible {
+			vals = append(vals, point.val)
+		}
+	}
+	collapsed := collapsedPoints(vals, ser.topN, ser.threshold)
+	members := []string{}
+	otherVal := 0.0
+	k := 0
+	for _, point := range ordered {
+		point.collapsed = false
+		if !point.visible {
+			continue
+		}
+		if collapsed[k] {
+			point.collapsed = true
+			members = append(members, point.c)
+			otherVal += point.val
+		}
+		k++
+	}
+	if len(members) > 0 && ser.other == nil {
+		ser.other = emptyProportionPoint(ser.otherName, ser.otherCol, ser)
+		ser.other.setTextStyle(ser.textStyle)
+	}
+	if ser.other != nil {
+		ser.other.members = members
+		ser.other.val = otherVal
+	}
+	ser.tot = 0
+	for _, point := range ser.points() {
+		if point.visible {
+			ser.tot += point.val
+		}
+	}
+	ser.mu.Unlock()
+	ser.syncLegend()
+}
+
+// syncLegend adds the legend entries of all displayed points and removes those of collapsed points
+func (ser *Series) syncLegend() {
+	if ser.chart == nil {
+		return
+	}
+	// charts with shared categories maintain one legend entry per category for all series
+	displayed := map[*proportionPoint]bool{}
+	ser.mu.Lock()
+	for _, point := range ser.points() {
+		displayed[point] = !ser.chart.shareCategories
+	}
+	ser.mu.Unlock()
+	for _, point := range ser.lockedPoints() {
+		if displayed[point] && !point.inLegend {
+			ser.chart.AddLegendEntry(point.legendEntry)
+			point.inLegend = true
+		} else if !displayed[point] && point.inLegend {
+			ser.chart.RemoveLegendEntry(point.c, ser.name)
+			point.inLegend = false
+		}
+	}
+}
+
+// collapsedPoints marks all values that are not among the topN largest values or whose share is below threshold
+// topN = 0 and threshold = 0 disable the respective limit
+func collapsedPoints(vals []float64, topN int, threshold float64) (collapsed []bool) {
+	collapsed = make([]bool, len(vals))
+	tot := 0.0
+	for i := range vals {
+		tot += vals[i]
+	}
+	if topN > 0 && topN < len(vals) {
+		idx := make([]int, len(vals))
+		for i := range idx {
+			idx[i] = i
+		}
+		sort.SliceStable(idx, func(i, j int) bool { return vals[idx[i]] > vals[idx[j]] })
+		for _, i := range idx[topN:] {
+			collapsed[i] = true
+		}
+	}
+	if threshold > 0 && tot > 0 {
+		for i := range vals {
+			if vals[i]/tot < threshold {
+				collapsed[i] = true
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,64 @@
+package prop
+
+import (
+	"slices"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+func TestCollapsedPoints(t *testing.T) {
+	var tests = []struct {
+		vals         []float64
+		topN         int
+		threshold    float64
+		expCollapsed []bool
+	}{
+		{[]float64{5, 1, 3, 2}, 0, 0, []bool{false, false, false, false}},
+		{[]float64{5, 1, 3, 2}, 2, 0, []bool{false, true, false, true}},
+		{[]float64{5, 1, 3, 2}, 4, 0, []bool{false, false, false, false}},
+		{[]float64{5, 1, 3, 1}, 0, 0.15, []bool{false, true, false, true}},
+		{[]float64{50, 1, 30, 19}, 3, 0.2, []bool{false, true, false, true}},
+		{[]float64{0, 0}, 0, 0.5, []bool{false, false}},
+		{[]float64{}, 2, 0.1, []bool{}},
+	}
+	for i, tt := range tests {
+		collapsed := collapsedPoints(tt.vals, tt.topN, tt.threshold)
+		if len(collapsed) != len(tt.expCollapsed) {
+			t.Errorf("wrong number of points, set %d, exp %d, have %d", i, len(tt.expCollapsed), len(collapsed))
+			continue
+		}
+		for j := range collapsed {
+			if collapsed[j] != tt.expCollapsed[j] {
+				t.Errorf("wrong collapse, set %d, point %d, exp %t, have %t", i, j, tt.expCollapsed[j], collapsed[j])
+			}
+		}
+	}
+}
+
+func TestSortByValue(t *testing.T) {
+	test.NewTempApp(t)
+	ser := EmptyProportionalSeries("s")
+	err := ser.AddData([]data.ProportionalPoint{{C: "a", Val: 1}, {C: "b", Val: 3}, {C: "c", Val: 2}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var tests = []struct {
+		sortByValue bool
+		exp         []string
+	}{
+		{true, []string{"b", "c", "a"}},
+		{false, []string{"a", "b", "c"}},
+	}
+	for i, tt := range tests {
+		ser.SetSortByValue(tt.sortByValue)
+		cs := []string{}
+		for _, point := range ser.points() {
+			cs = append(cs, point.c)
+		}
+		if !slices.Equal(cs, tt.exp) {
+			t.Errorf("wrong order, set %d, exp %v, have %v", i, tt.exp, cs)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package prop
+
+import (
+	"math"
+
+	"fyne.io/fyne/v2"
+	"github.com/s-daehling/fyne-charts/internal/interact"
+)
+
+// BarMode defines how the series of a cartesian chart with stacked layout are scaled
+type BarMode string
+
+const (
+	// BarModeNormalized scales every series to 100%
+	BarModeNormalized BarMode = "Normalized"
+	// BarModeAbsolute stacks the values of every series; the value axis shows the totals
+	BarModeAbsolute BarMode = "Absolute"
+	// BarModeGrouped draws one bar per category next to each other
+	BarModeGrouped BarMode = "Grouped"
+)
+
+// SetBarMode defines how the series of a cartesian chart are scaled
+func (base *BaseChart) SetBarMode(m BarMode) {
+	if base.planeType != CartesianPlane || base.barMode == m {
+		return
+	}
+	base.barMode = m
+	base.DataChange()
+}
+
+// SetSharedCategories defines whether categories with the same name share color and legend entry across all series
+func (base *BaseChart) SetSharedCategories(shared bool) {
+	base.shareCategories = shared
+	for i := range base.series {
+		base.series[i].syncLegend()
+	}
+	base.DataChange()
+}
+
+func (base *BaseChart) showValueAxis() (b bool) {
+	b = base.valAx != nil && base.tree == nil && base.layout == StackedLayout && base.barMode != BarModeNormalized
+	return
+}
+
+// updateBars scales all series according to the bar mode and updates the value axis
+func (base *BaseChart) updateBars() {
+	switch base.barMode {
+	case BarModeAbsolute:
+		maxTot := 0.0
+		for i := range base.series {
+			maxTot = math.Max(maxTot, base.series[i].tot)
+		}
+		base.fromMax = maxOrOne(maxTot)
+		for i := range base.series {
+			base.series[i].ConvertValToN()
+		}
+	case BarModeGrouped:
+		maxVal := 0.0
+		for i := range base.series {
+			for _, point := range base.series[i].points() {
+				if point.visible {
+					maxVal = math.Max(maxVal, point.val)
+				}
+			}
+		}
+		base.fromMax = maxOrOne(maxVal)
+		for i := range base.series {
+			base.series[i].ConvertToGroups()
+		}
+	default:
+		base.fromMax = 100
+		for i := range base.series {
+			base.series[i].ConvertPtoN(base.ptoN)
+		}
+	}
+	base.valAx.SetNRange(base.fromMin, base.fromMax)
+	base.valAx.SetNOrigin(base.fromMin)
+	base.valAx.AutoNTicks()
+}
+
+func maxOrOne(v float64) (m float64) {
+	m = v
+	if m <= 0 {
+		m = 1
+	}
+	return
+}
+
+// ConvertValToN sets the length of each point to its value; points are stacked in order
+func (ser *Series) ConvertValToN() {
+	valOffset := 0.0
+	for _, point := range ser.points() {
+		point.valOffset = valOffset
+		point.cells = nil
+		point.n = 0
+		if point.visible {
+			point.n = point.val
+			valOffset += point.n
+		}
+		point.label = ser.labelText(point)
+	}
+}
+
+// ConvertToGroups places one bar per visible point from top to bottom within the height of the series
+func (ser *Series) ConvertToGroups() {
+	pts := ser.points()
+	nBars := 0
+	for i := range pts {
+		if pts[i].visible {
+			nBars++
+		}
+	}
+	if nBars == 0 {
+		return
+	}
+	barHeight := ser.height / float64(nBars)
+	k := 0
+	for _, point := range pts {
+		point.cells = nil
+		point.valOffset = 0
+		point.n = 0
+		point.label = ser.labelText(point)
+		if !point.visible {
+			continue
+		}
+		point.n = point.val
+		point.height = barHeight * 0.9
+		point.hOffset = ser.hOffset + ser.height - (float64(k+1) * barHeight) + (barHeight * 0.05)
+		k++
+	}
+}
+
+// updateCategoryLegend gives all points of a category the color of its first occurrence and maintains one legend entry per category
+func (base *BaseChart) updateCategoryLegend() {
+	cols := map[string]fyne.ThemeColorName{}
+	order := []string{}
+	for i := range base.series {
+		for _, point := range base.series[i].points() {
+			col := point.baseColName
+			if base.shareCategories {
+				if c, ok := cols[point.c]; ok {
+					col = c
+				} else {
+					cols[point.c] = col
+					order = append(order, point.c)
+				}
+			}
+			if point.colName != col {
+				point.colName = col
+				point.refreshTheme()
+			}
+		}
+	}
+	for _, c := range order {
+		if le, ok := base.catEntries[c]; ok {
+			le.SetColor(cols[c])
+			continue
+		}
+		le := interact.NewLegendEntry(c, "", true, cols[c], func() { base.toggleCategory(c) })
+		base.catEntries[c] = le
+		base.AddLegendEntry(le)
+	}
+	for c := range base.catEntries {
+		if _, ok := cols[c]; !ok {
+			base.RemoveLegendEntry(c, "")
+			delete(base.catEntries, c)
+		}
+	}
+}
+
+// toggleCategory hides all points of category c if any is visible, otherwise all are shown
+func (base *BaseChart) toggleCategory(c string) {
+	anyVisible := false
+	pts := []*proportionPoint{}
+	base.Lock()
+	for i := range base.series {
+		for _, point := range base.series[i].points() {
+			if point.c == c {
+				pts = append(pts, point)
+				anyVisible = anyVisible || point.visible
+			}
+		}
+	}
+	base.Unlock()
+	for _, point := range pts {
+		if anyVisible {
+			point.hide()
+		} else {
+			point.show()
+		}
+	}
+	if le, ok := base.catEntries[c]; ok {
+		if anyVisible {
+			le.Hide()
+		} else {
+			le.Show()
+		}
+	}
+	base.RasterVisibilityChange()
+}
@@ -0,0 +1,50 @@
+package prop
+
+import (
+	"math"
+	"testing"
+)
+
+func TestConvertToGroups(t *testing.T) {
+	var tests = []struct {
+		vals       []float64
+		visible    []bool
+		expN       []float64
+		expHOffset []float64
+	}{
+		{[]float64{2, 4}, []bool{true, true}, []float64{2, 4}, []float64{1.05, 0.05}},
+		{[]float64{2, 4, 3}, []bool{true, false, true}, []float64{2, 0, 3}, []float64{1.05, 0, 0.05}},
+		{[]float64{1}, []bool{false}, []float64{0}, []float64{0}},
+	}
+	for i, tt := range tests {
+		ser := &Series{hOffset: 0, height: 2}
+		for j := range tt.vals {
+			ser.data = append(ser.data, &proportionPoint{val: tt.vals[j], visible: tt.visible[j], ser: ser})
+		}
+		ser.ConvertToGroups()
+		for j, point := range ser.data {
+			if math.Abs(point.n-tt.expN[j]) > 0.0001 {
+				t.Errorf("wrong length, set %d, point %d, exp %f, have %f", i, j, tt.expN[j], point.n)
+			}
+			if point.visible && math.Abs(point.hOffset-tt.expHOffset[j]) > 0.0001 {
+				t.Errorf("wrong offset, set %d, point %d, exp %f, have %f", i, j, tt.expHOffset[j], point.hOffset)
+			}
+		}
+	}
+}
+
+func TestConvertValToN(t *testing.T) {
+	ser := &Series{}
+	for _, v := range []float64{3, 5, 2} {
+		ser.data = append(ser.data, &proportionPoint{val: v, visible: true, ser: ser})
+	}
+	ser.data[1].visible = false
+	ser.ConvertValToN()
+	expN := []float64{3, 0, 2}
+	expOffset := []float64{0, 3, 3}
+	for j, point := range ser.data {
+		if point.n != expN[j] || point.valOffset != expOffset[j] {
+			t.Errorf("wrong bar, point %d, exp %f/%f, have %f/%f", j, expN[j], expOffset[j], point.n, point.valOffset)
+		}
+	}
+}
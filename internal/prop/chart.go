@@ -1,9 +1,14 @@
 package prop
 
 import (
+	"errors"
+	"image"
 	"image/color"
 	"math"
+	"sync"
 
+	"github.com/s-daehling/fyne-charts/internal/batch"
+	"github.com/s-daehling/fyne-charts/internal/coord/axis"
 	"github.com/s-daehling/fyne-charts/internal/interact"
 	"github.com/s-daehling/fyne-charts/internal/renderer"
 	"github.com/s-daehling/fyne-charts/pkg/style"
@@ -22,27 +27,56 @@ const (
 	PolarPlane     PlaneType = "Polar"
 )
 
+// Layout defines how the points of proportional series are arranged on a cartesian plane
+type Layout string
+
+const (
+	StackedLayout Layout = "Stacked"
+	FunnelLayout  Layout = "Funnel"
+	WaffleLayout  Layout = "Waffle"
+)
+
 type BaseChart struct {
 	widget.BaseWidget
-	title         *canvas.Text
-	titleStyle    style.ChartTextStyle
-	series        []*Series
-	changed       bool
-	legend        *interact.Legend
-	legendVisible bool
-	planeType     PlaneType
-	transposed    bool
-	rast          *canvas.Raster
-	render        fyne.WidgetRenderer
-	fromMin       float64
-	fromMax       float64
-	toMin         float64
-	toMax         float64
-	mainCont      *fyne.Container
-	rLegendCont   *fyne.Container
-	lLegendCont   *fyne.Container
-	bLegendCont   *fyne.Container
-	tLegendCont   *fyne.Container
+	title           *canvas.Text
+	titleStyle      style.ChartTextStyle
+	series          []*Series
+	changed         bool
+	legend          *interact.Legend
+	legendVisible   bool
+	planeType       PlaneType
+	layout          Layout
+	barMode         BarMode
+	valAx           *axis.Axis
+	shareCategories bool
+	catEntries      map[string]*interact.LegendEntry
+	transposed      bool
+	rot             float64
+	mathPos         bool
+	innerRadius     float64
+	explode         map[string]float64
+	centerText      *canvas.Text
+	centerStyle     style.ChartTextStyle
+	rast            *canvas.Raster
+	render          fyne.WidgetRenderer
+	fromMin         float64
+	fromMax         float64
+	toMin           float64
+	toMax           float64
+	mainCont        *fyne.Container
+	topCont         *fyne.Container
+	rLegendCont     *fyne.Container
+	lLegendCont     *fyne.Container
+	bLegendCont     *fyne.Container
+	tLegendCont     *fyne.Container
+	tree            *hierarchy
+	overlay         *interact.Overlay
+	menu            *interact.ContextMenu
+	tooltip         *interact.Tooltip
+	updater         *batch.Updater
+	expVariant      fyne.ThemeVariant
+	expVariantSet   bool
+	mu              sync.Mutex
 }
 
 func EmptyBaseChart(pType PlaneType) (base *BaseChart) {
@@ -52,7 +86,16 @@ func EmptyBaseChart(pType PlaneType) (base *BaseChart) {
 		legend:        interact.NewLegend(),
 		legendVisible: true,
 		planeType:     pType,
+		layout:        StackedLayout,
+		barMode:       BarModeNormalized,
+		catEntries:    map[string]*interact.LegendEntry{},
 		transposed:    false,
+		rot:           0,
+		mathPos:       true,
+		explode:       map[string]float64{},
+		centerText:    canvas.NewText("", theme.Color(theme.ColorNameForeground)),
+		tooltip:       interact.NewTooltip(),
+		menu:          interact.NewContextMenu(),
 		fromMin:       0,
 		toMin:         0,
 		toMax:         100,
@@ -61,21 +104,26 @@ func EmptyBaseChart(pType PlaneType) (base *BaseChart) {
 		bLegendCont:   container.NewStack(),
 		tLegendCont:   container.NewStack(),
 	}
+	base.updater = batch.NewUpdater(base.dataChange, base.refresh)
+	base.topCont = container.NewVBox(
+		base.title,
+		base.tLegendCont)
 	base.mainCont = container.NewBorder(
-		container.NewVBox(
-			base.title,
-			base.tLegendCont),
+		base.topCont,
 		base.bLegendCont,
 		base.lLegendCont,
 		base.rLegendCont,
 		base)
 	base.SetTitleStyle(style.DefaultTitleStyle())
+	base.SetCenterTextStyle(style.DefaultAxisLabelStyle())
+	base.overlay = interact.NewOverlay(base)
 	base.SetLegendStyle(style.LegendLocationRight, style.DefaultLegendTextStyle(), true)
 	if pType == CartesianPlane {
 		base.rast = nil
 		base.fromMax = 100
+		base.valAx = axis.EmptyAxis("", axis.CartesianHorAxis)
 	} else {
-		base.rast = canvas.NewRasterWithPixels(base.PixelGenPolar)
+		base.rast = canvas.NewRaster(base.rasterGenerator(base.PixelGenPolar))
 		base.fromMax = 2 * math.Pi
 	}
 	base.ExtendBaseWidget(base)
@@ -105,10 +153,22 @@ func (base *BaseChart) IsPolar() (b bool) {
 func (base *BaseChart) SetCartesianOrientantion(transposed bool) {
 	if base.transposed != transposed {
 		base.transposed = transposed
+		if base.valAx != nil {
+			base.valAx.CartesianTranspose()
+		}
 		base.DataChange()
 	}
 }
 
+// SetLayout defines how the points of all series are arranged; only applies to cartesian charts
+func (base *BaseChart) SetLayout(l Layout) {
+	if base.planeType != CartesianPlane || base.layout == l {
+		return
+	}
+	base.layout = l
+	base.DataChange()
+}
+
 func (base *BaseChart) CartesianOrientation() (transposed bool) {
 	transposed = base.transposed
 	return
@@ -117,7 +177,11 @@ func (base *BaseChart) CartesianOrientation() (transposed bool) {
 func (base *BaseChart) CartesianObjects() (canObj []fyne.CanvasObject) {
 	// objects will be drawn in the same order as added here
 
-	// first get all objects from the series
+	if base.showValueAxis() {
+		canObj = append(canObj, base.valAx.Objects()...)
+	}
+
+	// get all objects from the series
 	rects := base.CartesianRects()
 	for i := range rects {
 		canObj = append(canObj, rects[i].Rect)
@@ -127,6 +191,15 @@ func (base *BaseChart) CartesianObjects() (canObj []fyne.CanvasObject) {
 		canObj = append(canObj, texts[i].Text)
 	}
 
+	// add tooltip and overlay
+	tt := base.Tooltip()
+	if tt.Box != nil {
+		canObj = append(canObj, tt.Box)
+	}
+	for i := range tt.Entries {
+		canObj = append(canObj, tt.Entries[i])
+	}
+	canObj = append(canObj, base.overlay)
 	return
 }
 
@@ -139,6 +212,7 @@ func (base *BaseChart) CartesianEdges() (es []renderer.CartesianEdge) {
 }
 
 func (base *BaseChart) CartesianRects() (as []renderer.CartesianRect) {
+	as = base.hierarchyRects()
 	for i := range base.series {
 		as = append(as, base.series[i].CartesianRects(base.fromMin, base.fromMax, base.toMin, base.toMax)...)
 	}
@@ -146,6 +220,7 @@ func (base *BaseChart) CartesianRects() (as []renderer.CartesianRect) {
 }
 
 func (base *BaseChart) CartesianTexts() (ts []renderer.CartesianText) {
+	ts = base.hierarchyCartesianTexts()
 	for i := range base.series {
 		ts = append(ts, base.series[i].CartesianTexts(base.fromMin, base.fromMax, base.toMin, base.toMax)...)
 	}
@@ -157,26 +232,111 @@ func (base *BaseChart) PolarObjects() (canObj []fyne.CanvasObject) {
 
 	// first get all objects from the series
 	canObj = append(canObj, base.rast)
+	edges := base.PolarEdges()
+	for i := range edges {
+		canObj = append(canObj, edges[i].Line)
+	}
 	texts := base.PolarTexts()
 	for i := range texts {
 		canObj = append(canObj, texts[i].Text)
 	}
 
+	// add tooltip and overlay
+	tt := base.Tooltip()
+	if tt.Box != nil {
+		canObj = append(canObj, tt.Box)
+	}
+	for i := range tt.Entries {
+		canObj = append(canObj, tt.Entries[i])
+	}
+	canObj = append(canObj, base.overlay)
+	return
+}
+
+func (base *BaseChart) PolarOrientation() (rot float64, mathPos bool) {
+	rot = base.rot
+	mathPos = base.mathPos
 	return
 }
 
+// SetStartAngle sets the absolute angle (radian, counter-clockwise from the positive x-axis) at which the first slice starts
+func (base *BaseChart) SetStartAngle(rot float64) {
+	base.rot = math.Mod(rot, 2*math.Pi)
+	if base.rot < 0 {
+		base.rot += 2 * math.Pi
+	}
+	base.Refresh()
+	base.RasterVisibilityChange()
+}
+
+// SetClockwise defines whether the slices are arranged clockwise or counter-clockwise
+func (base *BaseChart) SetClockwise(clockwise bool) {
+	base.mathPos = !clockwise
+	base.Refresh()
+	base.RasterVisibilityChange()
+}
+
+// SetInnerRadius sets the radius of the hole in the center as ratio of the outer radius
+func (base *BaseChart) SetInnerRadius(ratio float64) (err error) {
+	if ratio < 0 || ratio >= 1 {
+		err = errors.New("invalid ratio")
+		return
+	}
+	base.innerRadius = ratio
+	base.DataChange()
+	return
+}
+
+// SetExplode moves all slices of category c outwards by offset (ratio of the outer radius); an offset of 0 resets the slices
+func (base *BaseChart) SetExplode(c string, offset float64) (err error) {
+	if offset < 0 || offset > 1 {
+		err = errors.New("invalid offset")
+		return
+	}
+	if offset == 0 {
+		delete(base.explode, c)
+	} else {
+		base.explode[c] = offset
+	}
+	base.DataChange()
+	return
+}
+
+// SetCenterText sets a text that is displayed in the center of the chart
+func (base *BaseChart) SetCenterText(t string) {
+	base.centerText.Text = t
+	base.centerText.Refresh()
+	base.Refresh()
+}
+
+// SetCenterTextStyle changes the style of the text in the center of the chart
+func (base *BaseChart) SetCenterTextStyle(ts style.ChartTextStyle) {
+	base.centerStyle = ts
+	base.centerText.TextSize = theme.Size(ts.SizeName)
+	base.centerText.Color = theme.Color(ts.ColorName)
+	base.centerText.TextStyle = ts.TextStyle
+	base.centerText.Refresh()
+}
+
 func (base *BaseChart) PolarNodes() (ns []renderer.PolarNode) {
 	return
 }
 
 func (base *BaseChart) PolarEdges() (es []renderer.PolarEdge) {
+	_, es = base.outsideLabels()
 	return
 }
 
 func (base *BaseChart) PolarTexts() (ts []renderer.PolarText) {
+	ts = base.hierarchyPolarTexts()
+	if base.centerText.Text != "" {
+		ts = append(ts, renderer.PolarText{Phi: 0, R: 0, Text: base.centerText})
+	}
 	for i := range base.series {
 		ts = append(ts, base.series[i].PolarTexts(base.fromMin, base.fromMax, base.toMin, base.toMax)...)
 	}
+	outside, _ := base.outsideLabels()
+	ts = append(ts, outside...)
 	return
 }
 
@@ -186,7 +346,7 @@ func (base *BaseChart) Raster() (rs *canvas.Raster) {
 }
 
 func (base *BaseChart) Overlay() (io *interact.Overlay) {
-	io = nil
+	io = base.overlay
 	return
 }
 
@@ -217,6 +377,7 @@ func (base *BaseChart) HideLegend() {
 }
 
 func (base *BaseChart) Tooltip() (tt renderer.Tooltip) {
+	tt.X, tt.Y, tt.Entries, tt.Box = base.tooltip.GetEntries()
 	return
 }
 
@@ -246,6 +407,11 @@ func (base *BaseChart) FromAxisElements() (min float64, max float64, origin floa
 	ticks = []renderer.Tick{}
 	arrow = renderer.Arrow{}
 	show = false
+	if base.showValueAxis() {
+		ticks = base.valAx.Ticks()
+		arrow = base.valAx.Arrow()
+		show = base.valAx.Visible()
+	}
 	return
 }
 
@@ -259,14 +425,37 @@ func (base *BaseChart) ToAxisElements() (min float64, max float64, origin float6
 	return
 }
 
+// rasterGenerator creates the raster image pixel by pixel with pixelGen while the chart is locked
+func (base *BaseChart) rasterGenerator(pixelGen func(pX, pY, w, h int) color.Color) (gen func(w, h int) image.Image) {
+	gen = func(w, h int) image.Image {
+		img := image.NewRGBA(image.Rect(0, 0, w, h))
+		base.Lock()
+		defer base.Unlock()
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				img.Set(x, y, pixelGen(x, y, w, h))
+			}
+		}
+		return img
+	}
+	return
+}
+
 func (base *BaseChart) PixelGenPolar(pX, pY, w, h int) (col color.Color) {
 	phi, r, _, _ := base.PositionToPolarCoordinates(pX, pY, w, h)
 	col = color.RGBA{0x00, 0x00, 0x00, 0x00}
 	if r > base.toMax {
 		return
 	}
+	if base.tree != nil {
+		if treeCol, useColor := base.hierarchyRasterColor(phi, r); useColor {
+			col = treeCol
+		}
+		return
+	}
+	x, y := r*math.Cos(phi), r*math.Sin(phi)
 	for i := range base.series {
-		serCol, useColor := base.series[i].RasterColorPolar(phi, r)
+		serCol, useColor := base.series[i].RasterColorPolar(phi, r, x, y)
 		if useColor {
 			col = serCol
 			break
@@ -277,8 +466,6 @@ func (base *BaseChart) PixelGenPolar(pX, pY, w, h int) (col color.Color) {
 
 func (base *BaseChart) PositionToPolarCoordinates(pX int, pY int, w int, h int) (phi float64,
 	r float64, x float64, y float64) {
-	rot := 0.0
-	mathPos := true
 	posToCoord := base.toMax / (float64(w) / 2.0)
 	x = (float64(pX) - (float64(w) / 2.0)) * posToCoord
 	y = ((float64(h) / 2.0) - float64(pY)) * posToCoord
@@ -287,15 +474,14 @@ func (base *BaseChart) PositionToPolarCoordinates(pX int, pY int, w int, h int)
 	if y < 0 {
 		phi = -phi + (2 * math.Pi)
 	}
-	dirCor := 0.0
-	if !mathPos {
-		dirCor = 1.0
+	// convert the absolute angle to the angle of the chart
+	phi -= base.rot
+	if !base.mathPos {
+		phi = -phi
 	}
-	phi -= (rot + (dirCor * 2 * math.Pi))
+	phi = math.Mod(phi, 2*math.Pi)
 	if phi < 0 {
 		phi += 2 * math.Pi
-	} else if phi > 2*math.Pi {
-		phi -= 2 * math.Pi
 	}
 	return
 }
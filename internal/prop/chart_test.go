@@ -0,0 +1,55 @@
+package prop
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPositionToPolarCoordinates(t *testing.T) {
+	var tests = []struct {
+		rot     float64
+		mathPos bool
+		pX      int
+		pY      int
+		expPhi  float64
+		expR    float64
+	}{
+		{0, true, 200, 100, 0, 100},
+		{0, true, 100, 0, math.Pi / 2, 100},
+		{0, true, 100, 150, 3 * math.Pi / 2, 50},
+		{math.Pi / 2, true, 100, 0, 0, 100},
+		{math.Pi / 2, true, 0, 100, math.Pi / 2, 100},
+		{math.Pi / 2, false, 200, 100, math.Pi / 2, 100},
+		{math.Pi / 2, false, 100, 200, math.Pi, 100},
+		{0, false, 100, 0, 3 * math.Pi / 2, 100},
+	}
+	for i, tt := range tests {
+		base := &BaseChart{toMax: 100, rot: tt.rot, mathPos: tt.mathPos}
+		phi, r, _, _ := base.PositionToPolarCoordinates(tt.pX, tt.pY, 200, 200)
+		if math.Abs(phi-tt.expPhi) > 0.000001 || math.Abs(r-tt.expR) > 0.000001 {
+			t.Errorf("wrong coordinates, set %d, exp (%f,%f), have (%f,%f)", i, tt.expPhi, tt.expR, phi, r)
+		}
+	}
+}
+
+func TestExplodedPolarCoordinates(t *testing.T) {
+	var tests = []struct {
+		x      float64
+		y      float64
+		mid    float64
+		offset float64
+		expPhi float64
+		expR   float64
+	}{
+		{10, 0, 0, 5, 0, 5},
+		{0, 10, math.Pi / 2, 10, 0, 0},
+		{0, -20, 3 * math.Pi / 2, 5, 3 * math.Pi / 2, 15},
+		{5, 5, 0, 5, math.Pi / 2, 5},
+	}
+	for i, tt := range tests {
+		phi, r := explodedPolarCoordinates(tt.x, tt.y, tt.mid, tt.offset)
+		if math.Abs(r-tt.expR) > 0.000001 || (r > 0.000001 && math.Abs(phi-tt.expPhi) > 0.000001) {
+			t.Errorf("wrong coordinates, set %d, exp (%f,%f), have (%f,%f)", i, tt.expPhi, tt.expR, phi, r)
+		}
+	}
+}
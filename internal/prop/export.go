@@ -0,0 +1,129 @@
+package prop
+
+import (
+	"image"
+	"io"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"github.com/s-daehling/fyne-charts/internal/export"
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+)
+
+// SetExportThemeVariant defines the theme variant that is used by ExportImage, WriteSVG and WritePDF
+func (base *BaseChart) SetExportThemeVariant(v fyne.ThemeVariant) {
+	base.expVariant = v
+	base.expVariantSet = true
+}
+
+// ExportImage renders a snapshot of the chart offscreen with the given size and scale
+// The theme variant of the app is used unless another one has been defined with SetExportThemeVariant
+// Neither the theme of the app nor the chart shown in the window are changed
+func (base *BaseChart) ExportImage(size fyne.Size, scale float32) (img image.Image, err error) {
+	img, err = export.Image(base.exportChart(), size, scale, base.exportVariant())
+	return
+}
+
+// exportChart gives the content of the chart for exports
+func (base *BaseChart) exportChart() (ch export.Chart) {
+	ch = export.Chart{
+		Content: base.mainCont,
+		Refresh: func() {
+			base.refresh()
+			base.legend.Refresh()
+		},
+		Texts: base.imageTexts,
+		Areas: base.rasterAreas,
+	}
+	return
+}
+
+func (base *BaseChart) exportVariant() (variant fyne.ThemeVariant) {
+	variant = export.CurrentVariant()
+	if base.expVariantSet {
+		variant = base.expVariant
+	}
+	return
+}
+
+// WritePNG renders the chart offscreen and writes it as PNG file to path
+func (base *BaseChart) WritePNG(path string, size fyne.Size, scale float32) (err error) {
+	img, err := base.ExportImage(size, scale)
+	if err != nil {
+		return
+	}
+	err = export.WritePNG(path, img)
+	return
+}
+
+// WriteSVG renders the chart offscreen and writes it as SVG document to w
+func (base *BaseChart) WriteSVG(w io.Writer, size fyne.Size) (err error) {
+	err = export.WriteSVG(w, base.exportChart(), size, base.exportVariant())
+	return
+}
+
+// WritePDF renders the chart offscreen and writes it as single page PDF document to w
+func (base *BaseChart) WritePDF(w io.Writer, size fyne.Size) (err error) {
+	err = export.WritePDF(w, base.exportChart(), size, base.exportVariant())
+	return
+}
+
+// ExportObjects returns the objects of the chart for exports
+func (base *BaseChart) ExportObjects() (canObj []fyne.CanvasObject) {
+	base.Lock()
+	defer base.Unlock()
+	if base.planeType == CartesianPlane {
+		canObj = base.CartesianObjects()
+	} else {
+		canObj = base.PolarObjects()
+	}
+	return
+}
+
+// imageTexts gives the texts of the axis labels, which are drawn as images
+func (base *BaseChart) imageTexts() (its []renderer.ImageText) {
+	base.Lock()
+	defer base.Unlock()
+	if base.valAx != nil {
+		its = base.valAx.ImageTexts()
+	}
+	return
+}
+
+// rasterAreas gives the sectors of polar charts as outlines relative to the raster
+func (base *BaseChart) rasterAreas() (ras []renderer.RasterArea) {
+	// sunburst charts keep their segments on the raster image
+	if base.rast == nil || base.tree != nil {
+		return
+	}
+	base.Lock()
+	defer base.Unlock()
+	size := base.rast.Size()
+	if size.Width <= 0 || size.Height <= 0 || base.toMax <= 0 {
+		return
+	}
+	var ps []renderer.Polygon
+	// the raster shows the first series where series overlap, so it is drawn last
+	for i := len(base.series) - 1; i >= 0; i-- {
+		ps = append(ps, base.series[i].rasterPolygons()...)
+	}
+	w, h := float64(size.Width), float64(size.Height)
+	scale := (w / 2) / base.toMax
+	sin, cos := math.Sincos(base.rot)
+	ra := renderer.RasterArea{Raster: base.rast}
+	for _, p := range ps {
+		o := renderer.Outline{Col: p.Col}
+		for i := range p.X {
+			// convert the angle of the chart to the absolute angle
+			x, y := p.X[i], p.Y[i]
+			if !base.mathPos {
+				y = -y
+			}
+			x, y = x*cos-y*sin, x*sin+y*cos
+			o.Points = append(o.Points, fyne.NewPos(float32(w/2+x*scale), float32(h/2-y*scale)))
+		}
+		ra.Outlines = append(ra.Outlines, o)
+	}
+	ras = append(ras, ra)
+	return
+}
@@ -0,0 +1,158 @@
+package prop
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+func TestExportImage(t *testing.T) {
+	test.NewTempApp(t)
+	var tests = []struct {
+		pType PlaneType
+		scale float32
+		expW  int
+		expH  int
+	}{
+		{PolarPlane, 1, 300, 200},
+		{CartesianPlane, 1.5, 450, 300},
+	}
+	for i, tt := range tests {
+		for _, hierarchy := range []bool{false, true} {
+			var base *BaseChart
+			var err error
+			if hierarchy {
+				base, err = hierarchyExportChart(tt.pType)
+			} else {
+				base, err = exportChart(tt.pType)
+			}
+			if err != nil {
+				t.Errorf("unexpected error, set %d: %s", i, err)
+				continue
+			}
+			img, err := base.ExportImage(fyne.NewSize(300, 200), tt.scale)
+			if err != nil {
+				t.Errorf("unexpected error, set %d: %s", i, err)
+				continue
+			}
+			if img.Bounds().Dx() != tt.expW || img.Bounds().Dy() != tt.expH {
+				t.Errorf("wrong image size, set %d, exp %dx%d, have %v", i, tt.expW, tt.expH, img.Bounds())
+			}
+		}
+	}
+}
+
+// exportChart gives a chart with one series of two points
+func exportChart(pType PlaneType) (base *BaseChart, err error) {
+	base = EmptyBaseChart(pType)
+	ser := EmptyProportionalSeries("shares")
+	err = base.AddSeries(ser)
+	if err == nil {
+		err = ser.AddData([]data.ProportionalPoint{
+			{C: "a", Val: 3, ColName: theme.ColorNamePrimary},
+			{C: "b", Val: 1, ColName: theme.ColorNameError},
+		})
+	}
+	return
+}
+
+// hierarchyExportChart gives a treemap (cartesian) or sunburst (polar) chart with a tree of two levels
+func hierarchyExportChart(pType PlaneType) (base *BaseChart, err error) {
+	base = EmptyBaseChart(pType)
+	base.MakeHierarchy()
+	err = base.SetHierarchy(data.ProportionalNode{C: "total", ColName: theme.ColorNamePrimary,
+		Children: []data.ProportionalNode{
+			{C: "a", Children: []data.ProportionalNode{{C: "a1", Val: 1}, {C: "a2", Val: 2}}},
+			{C: "b", Val: 4, ColName: theme.ColorNameError},
+		}})
+	return
+}
+
+func TestWriteSVG(t *testing.T) {
+	test.NewTempApp(t)
+	base := EmptyBaseChart(PolarPlane)
+	ser := EmptyProportionalSeries("shares")
+	err := base.AddSeries(ser)
+	if err == nil {
+		err = ser.AddData([]data.ProportionalPoint{
+			{C: "a", Val: 3, ColName: theme.ColorNamePrimary},
+			{C: "b", Val: 1, ColName: theme.ColorNameError},
+		})
+	}
+	var buf bytes.Buffer
+	if err == nil {
+		err = base.WriteSVG(&buf, fyne.NewSize(300, 200))
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// the sectors are written as paths instead of an image
+	doc := buf.String()
+	if n := strings.Count(doc, "<path"); n != 2 {
+		t.Errorf("wrong number of paths, exp 2, have %d", n)
+	}
+	if strings.Contains(doc, "<image") {
+		t.Errorf("unexpected image")
+	}
+}
+
+func TestWriteSVGHierarchy(t *testing.T) {
+	test.NewTempApp(t)
+	var tests = []struct {
+		pType PlaneType
+		image bool
+	}{
+		{CartesianPlane, false},
+		{PolarPlane, true},
+	}
+	for i, tt := range tests {
+		base, err := hierarchyExportChart(tt.pType)
+		var buf bytes.Buffer
+		if err == nil {
+			err = base.WriteSVG(&buf, fyne.NewSize(300, 200))
+		}
+		if err != nil {
+			t.Errorf("unexpected error, set %d: %s", i, err)
+			continue
+		}
+		// treemap nodes are rectangles; the segments of sunburst charts are embedded as image
+		doc := buf.String()
+		if strings.Contains(doc, "<image") != tt.image {
+			t.Errorf("wrong image, set %d, exp %t", i, tt.image)
+		}
+		for _, label := range []string{">a1</text>", ">a2</text>", ">b</text>"} {
+			if !strings.Contains(doc, label) {
+				t.Errorf("label %q missing, set %d", label, i)
+			}
+		}
+	}
+}
+
+func TestWritePDFHierarchy(t *testing.T) {
+	test.NewTempApp(t)
+	for i, pType := range []PlaneType{CartesianPlane, PolarPlane} {
+		base, err := hierarchyExportChart(pType)
+		var buf bytes.Buffer
+		if err == nil {
+			err = base.WritePDF(&buf, fyne.NewSize(300, 200))
+		}
+		if err != nil {
+			t.Errorf("unexpected error, set %d: %s", i, err)
+			continue
+		}
+		doc := buf.String()
+		if !strings.HasPrefix(doc, "%PDF-1.4") || !strings.HasSuffix(doc, "%%EOF\n") {
+			t.Errorf("invalid header or trailer, set %d", i)
+		}
+		for _, s := range []string{"/MediaBox [0 0 300 200]", "/FontFile2", "> Tj"} {
+			if !strings.Contains(doc, s) {
+				t.Errorf("%q missing, set %d", s, i)
+			}
+		}
+	}
+}
@@ -0,0 +1,480 @@
+package prop
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"sort"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"github.com/s-daehling/fyne-charts/internal/interact"
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+	"github.com/s-daehling/fyne-charts/pkg/style"
+)
+
+type treeNode struct {
+	c           string
+	val         float64
+	colName     fyne.ThemeColorName
+	alpha       uint16
+	col         color.Color
+	parent      *treeNode
+	children    []*treeNode
+	x1          float64
+	y1          float64
+	x2          float64
+	y2          float64
+	phi1        float64
+	phi2        float64
+	r1          float64
+	r2          float64
+	rect        *canvas.Rectangle
+	text        *canvas.Text
+	legendEntry *interact.LegendEntry
+}
+
+// buildTreeNode converts input into a tree; nodes without color use a lighter variant of the color of their parent
+func buildTreeNode(input data.ProportionalNode, parent *treeNode) (node *treeNode, err error) {
+	node = &treeNode{
+		c:       input.C,
+		colName: input.ColName,
+		alpha:   0xffff,
+		parent:  parent,
+	}
+	if node.colName == "" {
+		if parent != nil {
+			node.colName = parent.colName
+			node.alpha = max(parent.alpha/4*3, 0x4000)
+		} else {
+			node.colName = theme.ColorNamePrimary
+		}
+	}
+	if len(input.Children) == 0 {
+		if input.Val < 0 || math.IsNaN(input.Val) || math.IsInf(input.Val, 0) {
+			err = errors.New("invalid data")
+			return
+		}
+		node.val = input.Val
+	}
+	for i := range input.Children {
+		var child *treeNode
+		child, err = buildTreeNode(input.Children[i], node)
+		if err != nil {
+			return
+		}
+		node.children = append(node.children, child)
+		node.val += child.val
+	}
+	node.rect = canvas.NewRectangle(color.Transparent)
+	node.text = canvas.NewText(node.c, theme.Color(theme.ColorNameBackground))
+	node.refreshTheme()
+	return
+}
+
+func (node *treeNode) refreshTheme() {
+	red, green, blue, _ := theme.Color(node.colName).RGBA()
+	node.col = color.RGBA64{R: uint16(red), G: uint16(green), B: uint16(blue), A: node.alpha}
+	node.rect.FillColor = node.col
+	node.rect.StrokeColor = theme.Color(theme.ColorNameBackground)
+	node.rect.StrokeWidth = 1
+}
+
+func (node *treeNode) setTextStyle(ts style.ChartTextStyle) {
+	node.text.TextSize = theme.Size(ts.SizeName)
+	node.text.Color = theme.Color(ts.ColorName)
+	node.text.TextStyle = ts.TextStyle
+	for i := range node.children {
+		node.children[i].setTextStyle(ts)
+	}
+}
+
+// height gives the number of levels below node
+func (node *treeNode) height() (h int) {
+	for i := range node.children {
+		h = max(h, node.children[i].height()+1)
+	}
+	return
+}
+
+// descendants gives all nodes below node with a positive value, parents before their children
+func (node *treeNode) descendants() (ns []*treeNode) {
+	for i := range node.children {
+		if node.children[i].val <= 0 {
+			continue
+		}
+		ns = append(ns, node.children[i])
+		ns = append(ns, node.children[i].descendants()...)
+	}
+	return
+}
+
+func (node *treeNode) path() (ns []*treeNode) {
+	for n := node; n != nil; n = n.parent {
+		ns = append([]*treeNode{n}, ns...)
+	}
+	return
+}
+
+type hierarchy struct {
+	root      *treeNode
+	current   *treeNode
+	textStyle style.ChartTextStyle
+	crumbs    *fyne.Container
+}
+
+// MakeHierarchy turns the chart into a treemap (cartesian plane) or sunburst (polar plane) chart
+func (base *BaseChart) MakeHierarchy() {
+	base.tree = &hierarchy{
+		textStyle: style.DefaultValueTextStyle(),
+		crumbs:    container.NewHBox(),
+	}
+	base.topCont.Objects = []fyne.CanvasObject{base.title, base.tree.crumbs, base.tLegendCont}
+	base.topCont.Refresh()
+}
+
+// SetHierarchy replaces the data of a hierarchical chart
+func (base *BaseChart) SetHierarchy(input data.ProportionalNode) (err error) {
+	if base.tree == nil {
+		return
+	}
+	root, err := buildTreeNode(input, nil)
+	if err != nil {
+		return
+	}
+	root.setTextStyle(base.tree.textStyle)
+	base.removeHierarchyLegend()
+	base.tree.root = root
+	base.tree.current = root
+	base.hierarchyNavigationChange()
+	return
+}
+
+// SetHierarchyValTextStyle changes the style of the labels of a hierarchical chart
+func (base *BaseChart) SetHierarchyValTextStyle(ts style.ChartTextStyle) {
+	if base.tree == nil {
+		return
+	}
+	base.tree.textStyle = ts
+	if base.tree.root != nil {
+		base.tree.root.setTextStyle(ts)
+	}
+	base.Refresh()
+}
+
+// drillTo shows the subtree below node
+func (base *BaseChart) drillTo(node *treeNode) {
+	if base.tree == nil || node == nil || node == base.tree.current || len(node.children) == 0 {
+		return
+	}
+	base.removeHierarchyLegend()
+	base.tree.current = node
+	base.hierarchyNavigationChange()
+}
+
+// DrillUp shows the subtree of the parent of the currently shown node
+func (base *BaseChart) DrillUp() {
+	if base.tree == nil || base.tree.current == nil {
+		return
+	}
+	base.drillTo(base.tree.current.parent)
+}
+
+// DrillToRoot shows the complete tree
+func (base *BaseChart) DrillToRoot() {
+	if base.tree == nil {
+		return
+	}
+	base.drillTo(base.tree.root)
+}
+
+func (base *BaseChart) hierarchyNavigationChange() {
+	base.updateBreadcrumbs()
+	for _, child := range base.tree.current.children {
+		if child.legendEntry == nil {
+			child.legendEntry = interact.NewLegendEntry(child.c, "", true, child.colName,
+				func() { base.drillTo(child) })
+		}
+		base.AddLegendEntry(child.legendEntry)
+	}
+	base.DataChange()
+}
+
+func (base *BaseChart) removeHierarchyLegend() {
+	if base.tree.current == nil {
+		return
+	}
+	for _, child := range base.tree.current.children {
+		base.RemoveLegendEntry(child.c, "")
+	}
+}
+
+// updateBreadcrumbs creates one button for each node on the path to the currently shown node
+func (base *BaseChart) updateBreadcrumbs() {
+	base.tree.crumbs.RemoveAll()
+	p := base.tree.current.path()
+	for i, node := range p {
+		if i > 0 {
+			base.tree.crumbs.Add(widget.NewLabel("›"))
+		}
+		b := widget.NewButton(node.c, func() { base.drillTo(node) })
+		b.Importance = widget.LowImportance
+		if node == base.tree.current {
+			b.Disable()
+		}
+		base.tree.crumbs.Add(b)
+	}
+	base.tree.crumbs.Refresh()
+}
+
+func (base *BaseChart) updateHierarchy() {
+	if base.tree == nil || base.tree.current == nil {
+		return
+	}
+	if base.planeType == CartesianPlane {
+		layoutTreemap(base.tree.current, base.fromMin, base.toMin, base.fromMax, base.toMax, 0)
+	} else {
+		cur := base.tree.current
+		ringWidth := base.toMax / float64(cur.height()+1)
+		cur.phi1, cur.phi2 = 0, base.fromMax
+		cur.r1, cur.r2 = 0, ringWidth
+		layoutSunburst(cur, ringWidth)
+	}
+}
+
+// layoutTreemap places the children of node in the rectangle (x1,y1)-(x2,y2); nested levels are inset by pad
+func layoutTreemap(node *treeNode, x1 float64, y1 float64, x2 float64, y2 float64, pad float64) {
+	node.x1, node.y1, node.x2, node.y2 = x1, y1, x2, y2
+	if x2-x1 > 2*pad && y2-y1 > 2*pad {
+		x1, y1, x2, y2 = x1+pad, y1+pad, x2-pad, y2-pad
+	}
+	vals := make([]float64, len(node.children))
+	for i := range node.children {
+		vals[i] = node.children[i].val
+	}
+	rects := squarify(vals, x1, y1, x2, y2)
+	for i := range node.children {
+		layoutTreemap(node.children[i], rects[i][0], rects[i][1], rects[i][2], rects[i][3],
+			math.Min(rects[i][2]-rects[i][0], rects[i][3]-rects[i][1])*0.02)
+	}
+}
+
+// layoutSunburst places the children of node in the next ring, each child covering an angle proportional to its value
+func layoutSunburst(node *treeNode, ringWidth float64) {
+	phi := node.phi1
+	for i := range node.children {
+		child := node.children[i]
+		child.phi1 = phi
+		if node.val > 0 {
+			phi += (child.val / node.val) * (node.phi2 - node.phi1)
+		}
+		child.phi2 = phi
+		child.r1 = node.r2
+		child.r2 = node.r2 + ringWidth
+		layoutSunburst(child, ringWidth)
+	}
+}
+
+// squarify divides the rectangle (x1,y1)-(x2,y2) into one rectangle per value with an area proportional to the value
+// the rectangles are returned in the order of vals and have an aspect ratio close to 1 (Bruls et al.)
+func squarify(vals []float64, x1 float64, y1 float64, x2 float64, y2 float64) (rects [][4]float64) {
+	rects = make([][4]float64, len(vals))
+	tot := 0.0
+	idx := []int{}
+	for i := range vals {
+		rects[i] = [4]float64{x1, y1, x1, y1}
+		if vals[i] > 0 {
+			tot += vals[i]
+			idx = append(idx, i)
+		}
+	}
+	w, h := x2-x1, y2-y1
+	if tot <= 0 || w <= 0 || h <= 0 {
+		return
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return vals[idx[i]] > vals[idx[j]] })
+	areas := make([]float64, len(vals))
+	for _, i := range idx {
+		areas[i] = vals[i] / tot * w * h
+	}
+	x, y := x1, y1
+	row := []int{}
+	for k := 0; k < len(idx); {
+		short := math.Min(w, h)
+		next := append(append([]int{}, row...), idx[k])
+		if len(row) == 0 || worstRatio(next, areas, short) <= worstRatio(row, areas, short) {
+			row = next
+			k++
+			continue
+		}
+		x, y, w, h = layoutRow(row, areas, rects, x, y, w, h)
+		row = []int{}
+	}
+	layoutRow(row, areas, rects, x, y, w, h)
+	return
+}
+
+// worstRatio gives the highest aspect ratio of the rectangles in row placed along a side of length short
+func worstRatio(row []int, areas []float64, short float64) (r float64) {
+	sum, rMin, rMax := 0.0, math.Inf(1), 0.0
+	for _, i := range row {
+		sum += areas[i]
+		rMin = math.Min(rMin, areas[i])
+		rMax = math.Max(rMax, areas[i])
+	}
+	s2 := short * short
+	r = math.Max(s2*rMax/(sum*sum), (sum*sum)/(s2*rMin))
+	return
+}
+
+// layoutRow places the rectangles of row along the short side of the remaining area and gives the new remaining area
+func layoutRow(row []int, areas []float64, rects [][4]float64, x float64, y float64, w float64,
+	h float64) (nx float64, ny float64, nw float64, nh float64) {
+	sum := 0.0
+	for _, i := range row {
+		sum += areas[i]
+	}
+	if sum <= 0 {
+		return x, y, w, h
+	}
+	if w >= h {
+		colWidth := sum / h
+		pos := y
+		for _, i := range row {
+			rh := areas[i] / colWidth
+			rects[i] = [4]float64{x, pos, x + colWidth, pos + rh}
+			pos += rh
+		}
+		return x + colWidth, y, w - colWidth, h
+	}
+	rowHeight := sum / w
+	pos := x
+	for _, i := range row {
+		rw := areas[i] / rowHeight
+		rects[i] = [4]float64{pos, y, pos + rw, y + rowHeight}
+		pos += rw
+	}
+	return x, y + rowHeight, w, h - rowHeight
+}
+
+func (base *BaseChart) hierarchyRects() (rs []renderer.CartesianRect) {
+	if base.tree == nil || base.tree.current == nil || base.planeType != CartesianPlane {
+		return
+	}
+	for _, node := range base.tree.current.descendants() {
+		rs = append(rs, renderer.CartesianRect{
+			X1:   node.x1,
+			Y1:   node.y1,
+			X2:   node.x2,
+			Y2:   node.y2,
+			Rect: node.rect,
+		})
+	}
+	return
+}
+
+// hierarchyCartesianTexts labels all leaves that cover at least 2% of the area
+func (base *BaseChart) hierarchyCartesianTexts() (ts []renderer.CartesianText) {
+	if base.tree == nil || base.tree.current == nil || base.planeType != CartesianPlane {
+		return
+	}
+	minArea := 0.02 * (base.fromMax - base.fromMin) * (base.toMax - base.toMin)
+	for _, node := range base.tree.current.descendants() {
+		if len(node.children) > 0 || (node.x2-node.x1)*(node.y2-node.y1) < minArea {
+			continue
+		}
+		ts = append(ts, renderer.CartesianText{
+			X:    (node.x1 + node.x2) / 2,
+			Y:    (node.y1 + node.y2) / 2,
+			Text: node.text,
+		})
+	}
+	return
+}
+
+// hierarchyPolarTexts labels the center and all segments with enough space
+func (base *BaseChart) hierarchyPolarTexts() (ts []renderer.PolarText) {
+	if base.tree == nil || base.tree.current == nil || base.planeType != PolarPlane {
+		return
+	}
+	ts = append(ts, renderer.PolarText{Phi: 0, R: 0, Text: base.tree.current.text})
+	for _, node := range base.tree.current.descendants() {
+		rMid := (node.r1 + node.r2) / 2
+		if (node.phi2-node.phi1)*rMid < 0.15*base.toMax {
+			continue
+		}
+		ts = append(ts, renderer.PolarText{
+			Phi:  (node.phi1 + node.phi2) / 2,
+			R:    rMid,
+			Text: node.text,
+		})
+	}
+	return
+}
+
+// hierarchyNodeAt gives the node of a sunburst chart at (phi,r); nil if there is none
+func (base *BaseChart) hierarchyNodeAt(phi float64, r float64) (node *treeNode) {
+	cur := base.tree.current
+	if cur == nil || r > base.toMax {
+		return
+	}
+	if r <= cur.r2 {
+		node = cur
+		return
+	}
+	for _, child := range cur.descendants() {
+		if phi >= child.phi1 && phi < child.phi2 && r > child.r1 && r <= child.r2 {
+			node = child
+			return
+		}
+	}
+	return
+}
+
+func (base *BaseChart) hierarchyRasterColor(phi float64, r float64) (col color.Color, useColor bool) {
+	node := base.hierarchyNodeAt(phi, r)
+	if node == nil || node.val <= 0 {
+		return
+	}
+	// leave a small gap between segments
+	gap := 0.005 * base.toMax
+	if node != base.tree.current &&
+		(r-node.r1 < gap || (phi-node.phi1)*r < gap || (node.phi2-phi)*r < gap) {
+		return
+	}
+	col = node.col
+	useColor = true
+	return
+}
+
+// Tap drills down into the tapped node; tapping the center of a sunburst chart drills up
+func (base *BaseChart) Tap(pX float32, pY float32, w float32, h float32) {
+	if base.tree == nil || base.tree.current == nil || w <= 0 || h <= 0 {
+		return
+	}
+	if base.planeType == PolarPlane {
+		phi, r, _, _ := base.PositionToPolarCoordinates(int(pX), int(pY), int(w), int(h))
+		node := base.hierarchyNodeAt(phi, r)
+		if node == base.tree.current {
+			base.DrillUp()
+			return
+		}
+		for node != nil && node.parent != base.tree.current && len(node.children) == 0 {
+			node = node.parent
+		}
+		base.drillTo(node)
+		return
+	}
+	x := base.fromMin + (float64(pX)/float64(w))*(base.fromMax-base.fromMin)
+	y := base.toMin + (float64(h-pY)/float64(h))*(base.toMax-base.toMin)
+	for _, child := range base.tree.current.children {
+		if x >= child.x1 && x <= child.x2 && y >= child.y1 && y <= child.y2 {
+			base.drillTo(child)
+			return
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package prop
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSquarify(t *testing.T) {
+	var tests = []struct {
+		vals []float64
+		w    float64
+		h    float64
+	}{
+		{[]float64{6, 6, 4, 3, 2, 2, 1}, 6, 4},
+		{[]float64{1}, 100, 100},
+		{[]float64{1, 0, 3}, 100, 50},
+		{[]float64{5, 1, 1, 1, 1, 1}, 40, 100},
+	}
+	for i, tt := range tests {
+		rects := squarify(tt.vals, 0, 0, tt.w, tt.h)
+		if len(rects) != len(tt.vals) {
+			t.Errorf("wrong number of rects, set %d, exp %d, have %d", i, len(tt.vals), len(rects))
+			continue
+		}
+		tot := 0.0
+		for j := range tt.vals {
+			tot += tt.vals[j]
+		}
+		for j, r := range rects {
+			expArea := tt.vals[j] / tot * tt.w * tt.h
+			area := (r[2] - r[0]) * (r[3] - r[1])
+			if math.Abs(area-expArea) > 0.000001 {
+				t.Errorf("wrong area, set %d, rect %d, exp %f, have %f", i, j, expArea, area)
+			}
+			if r[0] < -0.000001 || r[1] < -0.000001 || r[2] > tt.w+0.000001 || r[3] > tt.h+0.000001 {
+				t.Errorf("rect outside of area, set %d, rect %d, have %v", i, j, r)
+			}
+		}
+	}
+}
+
+func TestSquarifyAspectRatio(t *testing.T) {
+	// example from Bruls et al.; all rectangles are close to a square
+	rects := squarify([]float64{6, 6, 4, 3, 2, 2, 1}, 0, 0, 6, 4)
+	for j, r := range rects {
+		w, h := r[2]-r[0], r[3]-r[1]
+		ratio := math.Max(w/h, h/w)
+		if ratio > 3 {
+			t.Errorf("bad aspect ratio, rect %d, have %f", j, ratio)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package prop
+
+import (
+	"math"
+	"sort"
+	"strconv"
+
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+	"github.com/s-daehling/fyne-charts/pkg/style"
+)
+
+const (
+	outsideRingRatio  = 0.7
+	outsideLabelRatio = 0.85
+)
+
+// SetLabelOptions defines content and placement of the value labels
+func (ser *Series) SetLabelOptions(opts style.LabelOptions) {
+	ser.labelOpts = opts
+	if ser.chart != nil {
+		ser.chart.DataChange()
+	}
+}
+
+func (ser *Series) labelText(point *proportionPoint) (t string) {
+	share := 0.0
+	if ser.tot > 0 {
+		share = point.val / ser.tot
+	}
+	t = formatLabel(ser.labelOpts, point.c, point.val, share)
+	return
+}
+
+// formatLabel gives the label of a point with category c, value val and share of the total in [0,1]
+func formatLabel(opts style.LabelOptions, c string, val float64, share float64) (t string) {
+	if opts.Format != nil {
+		t = opts.Format(c, val, share)
+		return
+	}
+	percentage := strconv.FormatFloat(100*share, 'f', 0, 64) + "%"
+	switch opts.Content {
+	case style.LabelContentValue:
+		t = strconv.FormatFloat(val, 'g', 6, 64)
+	case style.LabelContentCategory:
+		t = c
+	case style.LabelContentCategoryPercentage:
+		t = c + " " + percentage
+	default:
+		t = percentage
+	}
+	return
+}
+
+func (base *BaseChart) hasOutsideLabels() (b bool) {
+	if base.planeType != PolarPlane {
+		return
+	}
+	for i := range base.series {
+		if base.series[i].labelOpts.Outside && base.series[i].showText {
+			b = true
+			return
+		}
+	}
+	return
+}
+
+type outsideLabel struct {
+	point *proportionPoint
+	mid   float64
+	x     float64
+	y     float64
+}
+
+// outsideLabels places the labels of all series with outside labels around the pie
+// labels on the same side are moved vertically until they do not overlap
+func (base *BaseChart) outsideLabels() (ts []renderer.PolarText, es []renderer.PolarEdge) {
+	if !base.hasOutsideLabels() {
+		return
+	}
+	dir := 1.0
+	if !base.mathPos {
+		dir = -1.0
+	}
+	labelR := outsideLabelRatio * base.toMax
+	left, right := []outsideLabel{}, []outsideLabel{}
+	for _, ser := range base.series {
+		if !ser.labelOpts.Outside || !ser.visible {
+			continue
+		}
+		for _, point := range ser.points() {
+			if point.text == nil || !point.visible || point.n <= 0 {
+				continue
+			}
+			mid := point.valOffset + (point.n / 2)
+			abs := (dir * mid) + base.rot
+			l := outsideLabel{point: point, mid: mid, x: labelR * math.Cos(abs), y: labelR * math.Sin(abs)}
+			if l.x >= 0 {
+				right = append(right, l)
+			} else {
+				left = append(left, l)
+			}
+		}
+	}
+
+	// conversion factor from widget size to chart coordinates
+	posToCoord := 0.0
+	size := base.Size()
+	if size.Width > 0 && size.Height > 0 {
+		posToCoord = base.toMax / float64(math.Min(float64(size.Width), float64(size.Height))/2)
+	}
+	spacing := 0.08 * base.toMax
+	for _, side := range [][]outsideLabel{left, right} {
+		if len(side) == 0 {
+			continue
+		}
+		sort.SliceStable(side, func(i, j int) bool { return side[i].y > side[j].y })
+		ys := make([]float64, len(side))
+		for i := range side {
+			ys[i] = side[i].y
+			side[i].point.text.Text = side[i].point.label
+			if posToCoord > 0 {
+				spacing = math.Max(spacing, float64(side[i].point.text.MinSize().Height)*posToCoord)
+			}
+		}
+		ys = spreadLabels(ys, spacing, base.toMax)
+		for i := range side {
+			l := side[i]
+			point := l.point
+			sign := 1.0
+			if l.x < 0 {
+				sign = -1.0
+			}
+			x := sign * math.Sqrt(math.Max((labelR*labelR)-(ys[i]*ys[i]), 0))
+			anchorPhi, anchorR := base.absToChartCoordinates(x, ys[i])
+			es = append(es, renderer.PolarEdge{
+				Phi1: l.mid,
+				R1:   point.hOffset + point.height + point.explode,
+				Phi2: anchorPhi,
+				R2:   anchorR,
+				Line: point.leader,
+			})
+			// move the text outwards so that it starts at the end of the leader line
+			if posToCoord > 0 {
+				x += sign * float64(point.text.MinSize().Width/2) * posToCoord
+			}
+			textPhi, textR := base.absToChartCoordinates(x, ys[i])
+			if point.ser.textStyle.ColorName == theme.ColorNameBackground {
+				// the default value text color is invisible outside of the slices
+				point.text.Color = theme.Color(theme.ColorNameForeground)
+			}
+			ts = append(ts, renderer.PolarText{Phi: textPhi, R: textR, Text: point.text})
+		}
+	}
+	return
+}
+
+// absToChartCoordinates converts an unrotated cartesian position to the polar coordinates of the chart
+func (base *BaseChart) absToChartCoordinates(x float64, y float64) (phi float64, r float64) {
+	r = math.Hypot(x, y)
+	phi = math.Atan2(y, x) - base.rot
+	if !base.mathPos {
+		phi = -phi
+	}
+	phi = math.Mod(phi, 2*math.Pi)
+	if phi < 0 {
+		phi += 2 * math.Pi
+	}
+	return
+}
+
+// spreadLabels moves the vertical positions ys (sorted descending) apart until they are at least spacing apart
+// positions are kept within [-limit, limit] where possible
+func spreadLabels(ys []float64, spacing float64, limit float64) (res []float64) {
+	res = append([]float64{}, ys...)
+	for i := 1; i < len(res); i++ {
+		if res[i] > res[i-1]-spacing {
+			res[i] = res[i-1] - spacing
+		}
+	}
+	if len(res) > 0 && res[len(res)-1] < -limit {
+		res[len(res)-1] = -limit
+		for i := len(res) - 2; i >= 0; i-- {
+			if res[i] < res[i+1]+spacing {
+				res[i] = res[i+1] + spacing
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,77 @@
+package prop
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/s-daehling/fyne-charts/pkg/style"
+)
+
+func TestFormatLabel(t *testing.T) {
+	var tests = []struct {
+		opts    style.LabelOptions
+		c       string
+		val     float64
+		share   float64
+		expText string
+	}{
+		{style.DefaultLabelOptions(), "a", 12, 0.25, "25%"},
+		{style.LabelOptions{Content: style.LabelContentValue}, "a", 12.5, 0.25, "12.5"},
+		{style.LabelOptions{Content: style.LabelContentCategory}, "a", 12, 0.25, "a"},
+		{style.LabelOptions{Content: style.LabelContentCategoryPercentage}, "a", 12, 0.333, "a 33%"},
+		{style.LabelOptions{Content: style.LabelContentCategory, Format: func(c string, val float64, share float64) string {
+			return c + ": " + strconv.FormatFloat(val, 'f', 1, 64)
+		}}, "b", 3, 0.1, "b: 3.0"},
+	}
+	for i, tt := range tests {
+		text := formatLabel(tt.opts, tt.c, tt.val, tt.share)
+		if text != tt.expText {
+			t.Errorf("wrong label, set %d, exp %s, have %s", i, tt.expText, text)
+		}
+	}
+}
+
+func TestSpreadLabels(t *testing.T) {
+	var tests = []struct {
+		ys      []float64
+		spacing float64
+		limit   float64
+		expYs   []float64
+	}{
+		{[]float64{50, 20, -10}, 10, 100, []float64{50, 20, -10}},
+		{[]float64{50, 48, 47}, 10, 100, []float64{50, 40, 30}},
+		{[]float64{-90, -92, -95}, 10, 100, []float64{-80, -90, -100}},
+		{[]float64{}, 10, 100, []float64{}},
+	}
+	for i, tt := range tests {
+		ys := spreadLabels(tt.ys, tt.spacing, tt.limit)
+		for j := range ys {
+			if math.Abs(ys[j]-tt.expYs[j]) > 0.000001 {
+				t.Errorf("wrong position, set %d, label %d, exp %f, have %f", i, j, tt.expYs[j], ys[j])
+			}
+		}
+	}
+}
+
+func TestAbsToChartCoordinates(t *testing.T) {
+	var tests = []struct {
+		rot     float64
+		mathPos bool
+		x       float64
+		y       float64
+		expPhi  float64
+	}{
+		{0, true, 0, 10, math.Pi / 2},
+		{math.Pi / 2, true, 0, 10, 0},
+		{math.Pi / 2, false, 10, 0, math.Pi / 2},
+		{0, false, 0, 10, 3 * math.Pi / 2},
+	}
+	for i, tt := range tests {
+		base := &BaseChart{rot: tt.rot, mathPos: tt.mathPos}
+		phi, r := base.absToChartCoordinates(tt.x, tt.y)
+		if math.Abs(phi-tt.expPhi) > 0.000001 || math.Abs(r-10) > 0.000001 {
+			t.Errorf("wrong coordinates, set %d, exp (%f,10), have (%f,%f)", i, tt.expPhi, phi, r)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package prop
+
+import (
+	"math"
+	"sort"
+	"strconv"
+
+	"fyne.io/fyne/v2/canvas"
+	"github.com/s-daehling/fyne-charts/internal/renderer"
+)
+
+const (
+	waffleSize   = 10
+	waffleCells  = waffleSize * waffleSize
+	waffleGap    = 0.05
+	funnelHeight = 0.9
+)
+
+// cell is one square of a waffle chart
+type cell struct {
+	x1   float64
+	y1   float64
+	x2   float64
+	y2   float64
+	rect *canvas.Rectangle
+}
+
+func (c cell) cartesianRect() (r renderer.CartesianRect) {
+	r = renderer.CartesianRect{
+		X1:   c.x1,
+		Y1:   c.y1,
+		X2:   c.x2,
+		Y2:   c.y2,
+		Rect: c.rect,
+	}
+	return
+}
+
+func cellsCenter(cells []cell) (x float64, y float64) {
+	for i := range cells {
+		x += (cells[i].x1 + cells[i].x2) / 2
+		y += (cells[i].y1 + cells[i].y2) / 2
+	}
+	x /= float64(len(cells))
+	y /= float64(len(cells))
+	return
+}
+
+// ConvertToFunnel arranges the visible points as centered bars from top to bottom
+// the width of each bar is proportional to its value relative to the largest value
+func (ser *Series) ConvertToFunnel(width float64) {
+	nStages := 0
+	maxVal := 0.0
+	vals := []float64{}
+	pts := ser.points()
+	for i := range pts {
+		if pts[i].visible {
+			nStages++
+			maxVal = math.Max(maxVal, pts[i].val)
+			vals = append(vals, pts[i].val)
+		}
+	}
+	if nStages == 0 {
+		return
+	}
+	ofFirst, ofPrev := funnelPercentages(vals)
+	stageHeight := ser.height / float64(nStages)
+	k := 0
+	for i := range pts {
+		point := pts[i]
+		point.cells = nil
+		if !point.visible {
+			point.n = 0
+			continue
+		}
+		point.n = 0
+		if maxVal > 0 {
+			point.n = (point.val / maxVal) * width
+		}
+		point.valOffset = (width - point.n) / 2
+		point.height = stageHeight * funnelHeight
+		point.hOffset = ser.hOffset + ser.height - (float64(k+1) * stageHeight) +
+			(stageHeight * (1 - funnelHeight) / 2)
+		point.label = strconv.FormatFloat(ofFirst[k], 'f', 0, 64) + "%"
+		if k > 0 {
+			point.label += " (" + strconv.FormatFloat(ofPrev[k], 'f', 0, 64) + "% of previous)"
+		}
+		k++
+	}
+}
+
+// funnelPercentages gives the percentage of each stage with respect to the first and to the previous stage
+func funnelPercentages(vals []float64) (ofFirst []float64, ofPrev []float64) {
+	ofFirst = make([]float64, len(vals))
+	ofPrev = make([]float64, len(vals))
+	for i := range vals {
+		if vals[0] > 0 {
+			ofFirst[i] = 100 * vals[i] / vals[0]
+		}
+		if i == 0 {
+			ofPrev[i] = 100
+		} else if vals[i-1] > 0 {
+			ofPrev[i] = 100 * vals[i] / vals[i-1]
+		}
+	}
+	return
+}
+
+// ConvertToWaffle assigns the cells of a 10x10 grid to the visible points according to their share
+// cells are filled row by row starting at the top left
+func (ser *Series) ConvertToWaffle(width float64) {
+	pts := ser.points()
+	vals := []float64{}
+	for i := range pts {
+		if pts[i].visible {
+			vals = append(vals, pts[i].val)
+		}
+	}
+	counts := waffleCellCounts(vals, waffleCells)
+	cellW := width / waffleSize
+	cellH := ser.height / waffleSize
+	k := 0
+	pos := 0
+	for i := range pts {
+		point := pts[i]
+		point.n = 0
+		if !point.visible {
+			continue
+		}
+		if point.cells == nil {
+			point.cells = []cell{}
+		}
+		for len(point.cells) < counts[k] {
+			point.cells = append(point.cells, cell{rect: canvas.NewRectangle(point.col)})
+		}
+		point.cells = point.cells[:counts[k]]
+		for j := range point.cells {
+			row := pos / waffleSize
+			col := pos % waffleSize
+			point.cells[j].x1 = (float64(col) + waffleGap) * cellW
+			point.cells[j].x2 = (float64(col+1) - waffleGap) * cellW
+			point.cells[j].y2 = ser.hOffset + ser.height - ((float64(row) + waffleGap) * cellH)
+			point.cells[j].y1 = ser.hOffset + ser.height - ((float64(row+1) - waffleGap) * cellH)
+			pos++
+		}
+		point.label = ser.labelText(point)
+		k++
+	}
+}
+
+// waffleCellCounts distributes n cells proportionally to vals using the largest remainder method
+func waffleCellCounts(vals []float64, n int) (counts []int) {
+	counts = make([]int, len(vals))
+	tot := 0.0
+	for i := range vals {
+		tot += vals[i]
+	}
+	if tot <= 0 {
+		return
+	}
+	rest := make([]float64, len(vals))
+	idx := make([]int, len(vals))
+	assigned := 0
+	for i := range vals {
+		exact := vals[i] / tot * float64(n)
+		counts[i] = int(math.Floor(exact))
+		rest[i] = exact - float64(counts[i])
+		idx[i] = i
+		assigned += counts[i]
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return rest[idx[i]] > rest[idx[j]] })
+	for i := 0; assigned < n && i < len(idx); i++ {
+		counts[idx[i]]++
+		assigned++
+	}
+	return
+}
@@ -0,0 +1,55 @@
+package prop
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFunnelPercentages(t *testing.T) {
+	var tests = []struct {
+		vals       []float64
+		expOfFirst []float64
+		expOfPrev  []float64
+	}{
+		{[]float64{200, 100, 50}, []float64{100, 50, 25}, []float64{100, 50, 50}},
+		{[]float64{10}, []float64{100}, []float64{100}},
+		{[]float64{0, 5}, []float64{0, 0}, []float64{100, 0}},
+		{[]float64{4, 0, 2}, []float64{100, 0, 50}, []float64{100, 0, 0}},
+	}
+	for i, tt := range tests {
+		ofFirst, ofPrev := funnelPercentages(tt.vals)
+		for j := range tt.vals {
+			if math.Abs(ofFirst[j]-tt.expOfFirst[j]) > 0.000001 {
+				t.Errorf("wrong percentage of first, set %d, stage %d, exp %f, have %f", i, j, tt.expOfFirst[j], ofFirst[j])
+			}
+			if math.Abs(ofPrev[j]-tt.expOfPrev[j]) > 0.000001 {
+				t.Errorf("wrong percentage of previous, set %d, stage %d, exp %f, have %f", i, j, tt.expOfPrev[j], ofPrev[j])
+			}
+		}
+	}
+}
+
+func TestWaffleCellCounts(t *testing.T) {
+	var tests = []struct {
+		vals      []float64
+		expCounts []int
+	}{
+		{[]float64{1, 1, 2}, []int{25, 25, 50}},
+		{[]float64{1, 1, 1}, []int{34, 33, 33}},
+		{[]float64{0.4, 99.6}, []int{0, 100}},
+		{[]float64{0, 0}, []int{0, 0}},
+		{[]float64{}, []int{}},
+	}
+	for i, tt := range tests {
+		counts := waffleCellCounts(tt.vals, waffleCells)
+		if len(counts) != len(tt.expCounts) {
+			t.Errorf("wrong number of counts, set %d, exp %d, have %d", i, len(tt.expCounts), len(counts))
+			continue
+		}
+		for j := range counts {
+			if counts[j] != tt.expCounts[j] {
+				t.Errorf("wrong count, set %d, point %d, exp %d, have %d", i, j, tt.expCounts[j], counts[j])
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package prop
+
+import (
+	"image"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+	dataio "github.com/s-daehling/fyne-charts/pkg/data/io"
+)
+
+// SetContextMenu enables or disables the context menu on right-click or long-press
+func (base *BaseChart) SetContextMenu(enabled bool) {
+	base.menu.SetEnabled(enabled)
+}
+
+// AddContextMenuItem appends an item after the standard actions of the context menu
+func (base *BaseChart) AddContextMenuItem(item *fyne.MenuItem) {
+	base.menu.AddItem(item)
+}
+
+// SetContextMenuImageCopier defines how copy image puts the chart image on the clipboard
+func (base *BaseChart) SetContextMenuImageCopier(f func(img image.Image) (err error)) {
+	base.menu.SetImageCopier(f)
+}
+
+// ShowContextMenu shows the context menu at the absolute position pos on the canvas of obj
+func (base *BaseChart) ShowContextMenu(obj fyne.CanvasObject, pos fyne.Position) {
+	base.menu.Show(base, base.mainCont, obj, pos)
+}
+
+// DataCSV gives the data of all series as CSV with one block per series, separated by an empty line
+// Each block starts with a comment line with the name of the series, followed by the data as written by WriteCSV of
+// package pkg/data/io with default options, so that each block can be read again by ReadCSV with comment '#'
+// Categories that are collapsed into the other category are written with their own value
+// Nodes of treemap and sunburst charts are written in a block named like the root with their path as C
+func (base *BaseChart) DataCSV() (s string) {
+	var b strings.Builder
+	base.Lock()
+	for _, ser := range base.series {
+		writeCSVBlock(&b, ser.name, ser.Data())
+	}
+	if base.tree != nil && base.tree.root != nil {
+		root := base.tree.root
+		ps := []data.ProportionalPoint{}
+		for _, node := range root.descendants() {
+			var cs []string
+			for _, n := range node.path()[1:] {
+				cs = append(cs, n.c)
+			}
+			ps = append(ps, data.ProportionalPoint{C: strings.Join(cs, "/"), Val: node.val, ColName: node.colName})
+		}
+		writeCSVBlock(&b, root.c, ps)
+	}
+	base.Unlock()
+	s = b.String()
+	return
+}
+
+// writeCSVBlock writes the points of one series as comment line with its name and a CSV document of the io package
+func writeCSVBlock(b *strings.Builder, name string, ps []data.ProportionalPoint) {
+	if b.Len() > 0 {
+		b.WriteString("\n")
+	}
+	b.WriteString("# " + strings.ReplaceAll(name, "\n", " ") + "\n")
+	dataio.WriteCSV(b, ps, dataio.DefaultOptions())
+}
+
+// CSVSupported reports whether DataCSV writes the data of all series of the chart, which is always the case
+func (base *BaseChart) CSVSupported() (b bool) {
+	b = true
+	return
+}
+
+// Zoomed reports whether a treemap or sunburst chart shows a subtree
+func (base *BaseChart) Zoomed() (b bool) {
+	b = base.tree != nil && base.tree.current != base.tree.root
+	return
+}
+
+// ResetZoom shows the complete tree of a treemap or sunburst chart
+func (base *BaseChart) ResetZoom() {
+	base.DrillToRoot()
+}
+
+func (base *BaseChart) LegendVisible() (b bool) {
+	b = base.legend.Visible()
+	return
+}
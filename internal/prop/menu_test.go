@@ -0,0 +1,91 @@
+package prop
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+func TestDataCSV(t *testing.T) {
+	test.NewTempApp(t)
+	base := EmptyBaseChart(CartesianPlane)
+	ser := EmptyProportionalSeries("shares")
+	err := base.AddSeries(ser)
+	if err == nil {
+		err = ser.AddData([]data.ProportionalPoint{
+			{C: "a", Val: 3, ColName: theme.ColorNamePrimary},
+			{C: "b, c", Val: 0.5, ColName: theme.ColorNameError},
+		})
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	exp := "# shares\nC,Val,ColName\na,3,primary\n\"b, c\",0.5,error\n"
+	if csv := base.DataCSV(); csv != exp {
+		t.Errorf("wrong csv, exp %q, have %q", exp, csv)
+	}
+}
+
+func TestHierarchyDataCSVAndZoom(t *testing.T) {
+	test.NewTempApp(t)
+	base := EmptyBaseChart(PolarPlane)
+	base.MakeHierarchy()
+	err := base.SetHierarchy(data.ProportionalNode{C: "total", Children: []data.ProportionalNode{
+		{C: "a", Children: []data.ProportionalNode{{C: "a1", Val: 1}, {C: "a2", Val: 2}}},
+		{C: "b", Val: 4},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	exp := "# total\nC,Val,ColName\na,3,primary\na/a1,1,primary\na/a2,2,primary\nb,4,primary\n"
+	if csv := base.DataCSV(); csv != exp {
+		t.Errorf("wrong csv, exp %q, have %q", exp, csv)
+	}
+	if base.Zoomed() {
+		t.Errorf("zoomed without drill down")
+	}
+	base.drillTo(base.tree.root.children[0])
+	if !base.Zoomed() {
+		t.Errorf("not zoomed after drill down")
+	}
+	base.ResetZoom()
+	if base.Zoomed() {
+		t.Errorf("zoom not reset")
+	}
+}
+
+func TestHierarchyContextMenu(t *testing.T) {
+	test.NewTempApp(t)
+	for i, pType := range []PlaneType{CartesianPlane, PolarPlane} {
+		base, err := hierarchyExportChart(pType)
+		if err != nil {
+			t.Fatalf("unexpected error, set %d: %s", i, err)
+		}
+		w := test.NewWindow(base.MainContainer())
+		w.Resize(fyne.NewSize(400, 300))
+		base.SetContextMenu(true)
+		m := base.menu.Menu(base, base.mainCont)
+		if m == nil || menuItem(m, "Copy data as CSV") == nil || menuItem(m, "Reset zoom") == nil {
+			t.Errorf("standard actions missing, set %d", i)
+		}
+		// the overlay of treemap and sunburst charts shows the menu on secondary taps
+		test.TapSecondaryAt(base.overlay, fyne.NewPos(10, 10))
+		if w.Canvas().Overlays().Top() == nil {
+			t.Errorf("menu not shown on secondary tap, set %d", i)
+		}
+		w.Close()
+	}
+}
+
+func menuItem(m *fyne.Menu, label string) (item *fyne.MenuItem) {
+	for _, it := range m.Items {
+		if it.Label == label {
+			item = it
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,109 @@
+package prop
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+// TestConcurrentAddDeleteRender is meant to be run with the race detector
+func TestConcurrentAddDeleteRender(t *testing.T) {
+	test.NewTempApp(t)
+	var tests = []struct {
+		pType PlaneType
+		topN  int
+	}{
+		{CartesianPlane, 0},
+		{PolarPlane, 0},
+		{PolarPlane, 5},
+	}
+	for i, tt := range tests {
+		base := EmptyBaseChart(tt.pType)
+		w := test.NewWindow(base.MainContainer())
+		w.Resize(fyne.NewSize(400, 300))
+		ser := EmptyProportionalSeries("shares")
+		err := ser.SetTopN(tt.topN)
+		if err == nil {
+			err = base.AddSeries(ser)
+		}
+		if err != nil {
+			t.Errorf("unexpected error, set %d: %s", i, err)
+			w.Close()
+			continue
+		}
+		var wg sync.WaitGroup
+		for g := range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := range 30 {
+					c := strconv.Itoa(g*100 + j)
+					ser.AddData([]data.ProportionalPoint{{C: c, Val: float64(j + 1), ColName: theme.ColorNamePrimary}})
+					if j%10 == 9 {
+						ser.DeleteDataInRange([]string{c, strconv.Itoa(g*100 + j - 1)})
+					}
+				}
+			}()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 30 {
+				base.Refresh()
+				if base.Raster() != nil {
+					base.Raster().Generator(40, 30)
+				}
+			}
+		}()
+		wg.Wait()
+		ser.Clear()
+		w.Close()
+	}
+}
+
+// TestConcurrentAggregationRender is meant to be run with the race detector
+func TestConcurrentAggregationRender(t *testing.T) {
+	test.NewTempApp(t)
+	base := EmptyBaseChart(PolarPlane)
+	w := test.NewWindow(base.MainContainer())
+	defer w.Close()
+	w.Resize(fyne.NewSize(400, 300))
+	ser := EmptyProportionalSeries("shares")
+	err := base.AddSeries(ser)
+	if err == nil {
+		err = ser.AddData([]data.ProportionalPoint{
+			{C: "a", Val: 3, ColName: theme.ColorNamePrimary},
+			{C: "b", Val: 1, ColName: theme.ColorNameError},
+			{C: "c", Val: 2, ColName: theme.ColorNameSuccess},
+		})
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for j := range 30 {
+			ser.SetSortByValue(j%2 == 0)
+			ser.SetTopN(j % 3)
+			ser.SetThreshold(float64(j%4) / 10)
+		}
+	}()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for j := range 30 {
+			ser.AddData([]data.ProportionalPoint{{C: strconv.Itoa(j), Val: float64(j + 1), ColName: theme.ColorNamePrimary}})
+			base.Lock()
+			base.PolarObjects()
+			base.Unlock()
+		}
+	}()
+	wg.Wait()
+}
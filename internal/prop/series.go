@@ -4,11 +4,12 @@ import (
 	"errors"
 	"image/color"
 	"math"
-	"strconv"
+	"sync"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/internal/batch"
 	"github.com/s-daehling/fyne-charts/internal/interact"
 	"github.com/s-daehling/fyne-charts/internal/renderer"
 
@@ -17,17 +18,22 @@ import (
 )
 
 func (base *BaseChart) addSeriesIfNotExist(ser *Series) (err error) {
+	base.mu.Lock()
 	for i := range base.series {
 		if base.series[i].Name() == ser.Name() {
+			base.mu.Unlock()
 			err = errors.New("series already exists")
 			return
 		}
 	}
+	base.mu.Unlock()
 	err = ser.BindToChart(base)
 	if err != nil {
 		return
 	}
+	base.mu.Lock()
 	base.series = append(base.series, ser)
+	base.mu.Unlock()
 	base.DataChange()
 	return
 }
@@ -47,14 +53,20 @@ func (base *BaseChart) RemoveLegendEntry(name string, super string) {
 
 func (base *BaseChart) RemoveSeries(name string) {
 	newSeries := make([]*Series, 0)
+	released := make([]*Series, 0)
+	base.mu.Lock()
 	for i := range base.series {
 		if base.series[i].Name() != name {
 			newSeries = append(newSeries, base.series[i])
 		} else {
-			base.series[i].Release()
+			released = append(released, base.series[i])
 		}
 	}
 	base.series = newSeries
+	base.mu.Unlock()
+	for i := range released {
+		released[i].Release()
+	}
 	base.DataChange()
 }
 
@@ -65,11 +77,19 @@ type proportionPoint struct {
 	height      float64
 	hOffset     float64
 	valOffset   float64
+	explode     float64
+	collapsed   bool
+	inLegend    bool
+	members     []string
+	label       string
+	cells       []cell
 	rect        *canvas.Rectangle
 	text        *canvas.Text
+	leader      *canvas.Line
 	textStyle   style.ChartTextStyle
 	visible     bool
 	colName     fyne.ThemeColorName
+	baseColName fyne.ThemeColorName
 	col         color.Color
 	legendEntry *interact.LegendEntry
 	ser         *Series
@@ -77,16 +97,18 @@ type proportionPoint struct {
 
 func emptyProportionPoint(c string, colName fyne.ThemeColorName, ser *Series) (point *proportionPoint) {
 	point = &proportionPoint{
-		c:       c,
-		rect:    canvas.NewRectangle(theme.Color(colName)),
-		visible: true,
-		ser:     ser,
-		colName: colName,
-		col:     theme.Color(colName),
+		c:           c,
+		rect:        canvas.NewRectangle(theme.Color(colName)),
+		visible:     true,
+		ser:         ser,
+		colName:     colName,
+		baseColName: colName,
+		col:         theme.Color(colName),
 	}
 	point.legendEntry = interact.NewLegendEntry(c, ser.name, true, colName, point.toggleView)
 	if ser.showText {
 		point.text = canvas.NewText("", theme.Color(theme.ColorNameForeground))
+		point.leader = canvas.NewLine(theme.Color(theme.ColorNameForeground))
 	}
 	return
 }
@@ -110,7 +132,7 @@ func (point *proportionPoint) hide() {
 	point.visible = false
 	point.legendEntry.Hide()
 	if point.ser != nil {
-		point.ser.pointVisibilityUpdate(-point.val)
+		point.ser.pointVisibilityUpdate()
 	}
 }
 
@@ -126,7 +148,7 @@ func (point *proportionPoint) show() {
 	point.ser.visible = true
 	point.legendEntry.Show()
 	if point.ser != nil {
-		point.ser.pointVisibilityUpdate(point.val)
+		point.ser.pointVisibilityUpdate()
 	}
 }
 
@@ -143,10 +165,25 @@ func (point *proportionPoint) refreshTheme() {
 	point.text.Color = theme.Color(point.textStyle.ColorName)
 	point.text.TextSize = theme.Size(point.textStyle.SizeName)
 	point.rect.FillColor = point.col
+	if point.leader != nil {
+		point.leader.StrokeColor = theme.Color(theme.ColorNameForeground)
+	}
+	for i := range point.cells {
+		point.cells[i].rect.FillColor = point.col
+	}
 }
 
 func (point *proportionPoint) cartesianRects(xMin float64, xMax float64, yMin float64,
 	yMax float64) (rs []renderer.CartesianRect) {
+	if point.cells != nil {
+		if !point.visible {
+			return
+		}
+		for i := range point.cells {
+			rs = append(rs, point.cells[i].cartesianRect())
+		}
+		return
+	}
 	if point.valOffset+point.n < xMin || point.valOffset > xMax {
 		return
 	}
@@ -176,22 +213,32 @@ func (point *proportionPoint) cartesianTexts(xMin float64, xMax float64, yMin fl
 	if point.hOffset+point.height < yMin || point.hOffset > yMax {
 		return
 	}
-	point.text.Text = strconv.FormatFloat(point.n, 'f', 0, 64) + "%"
+	point.text.Text = point.label
 	t := renderer.CartesianText{
 		X:    point.valOffset + (point.n / 2),
 		Y:    point.hOffset + (point.height / 2),
 		Text: point.text,
 	}
+	if point.cells != nil {
+		if !point.visible || len(point.cells) == 0 {
+			return
+		}
+		t.X, t.Y = cellsCenter(point.cells)
+	}
 	ts = append(ts, t)
 	return
 }
 
-func (point *proportionPoint) RasterColorPolar(phi float64, r float64) (col color.Color, useColor bool) {
+func (point *proportionPoint) RasterColorPolar(phi float64, r float64, x float64,
+	y float64) (col color.Color, useColor bool) {
 	col = color.RGBA{0x00, 0x00, 0x00, 0x00}
 	useColor = false
 	if !point.visible {
 		return
 	}
+	if point.explode > 0 {
+		phi, r = explodedPolarCoordinates(x, y, point.valOffset+(point.n/2), point.explode)
+	}
 	if phi < point.valOffset ||
 		phi > point.valOffset+point.n ||
 		r < point.hOffset || r > point.hOffset+point.height {
@@ -202,9 +249,42 @@ func (point *proportionPoint) RasterColorPolar(phi float64, r float64) (col colo
 	return
 }
 
+// rasterPolygon gives the sector of the point in the plane of the chart angle, moved by the explode offset
+func (point *proportionPoint) rasterPolygon() (p renderer.Polygon, ok bool) {
+	if !point.visible || point.n <= 0 || point.height <= 0 {
+		return
+	}
+	phi1, phi2 := point.valOffset, point.valOffset+point.n
+	r1, r2 := point.hOffset, point.hOffset+point.height
+	p.X, p.Y = renderer.PolarOutline([]float64{phi1, phi2, phi2, phi1}, []float64{r1, r1, r2, r2})
+	if point.explode > 0 {
+		mid := point.valOffset + (point.n / 2)
+		for i := range p.X {
+			p.X[i] += point.explode * math.Cos(mid)
+			p.Y[i] += point.explode * math.Sin(mid)
+		}
+	}
+	p.Plane = true
+	p.Col = point.col
+	ok = true
+	return
+}
+
+// explodedPolarCoordinates gives the polar coordinates of (x,y) relative to a slice that is moved by offset in direction mid
+func explodedPolarCoordinates(x float64, y float64, mid float64, offset float64) (phi float64, r float64) {
+	x -= offset * math.Cos(mid)
+	y -= offset * math.Sin(mid)
+	r = math.Hypot(x, y)
+	phi = math.Atan2(y, x)
+	if phi < 0 {
+		phi += 2 * math.Pi
+	}
+	return
+}
+
 func (point *proportionPoint) polarTexts(phiMin float64, phiMax float64, rMin float64,
 	rMax float64) (ts []renderer.PolarText) {
-	if point.text == nil {
+	if point.text == nil || point.ser.labelOpts.Outside {
 		return
 	}
 	if point.valOffset+point.n < phiMin || point.valOffset > phiMax {
@@ -213,10 +293,10 @@ func (point *proportionPoint) polarTexts(phiMin float64, phiMax float64, rMin fl
 	if point.hOffset+point.height < rMin || point.hOffset > rMax {
 		return
 	}
-	point.text.Text = strconv.FormatFloat(100*(point.n/(2*math.Pi)), 'f', 0, 64) + "%"
+	point.text.Text = point.label
 	t := renderer.PolarText{
 		Phi:  point.valOffset + (point.n / 2),
-		R:    point.hOffset + (point.height / 2),
+		R:    point.hOffset + (point.height / 2) + point.explode,
 		Text: point.text,
 	}
 	ts = append(ts, t)
@@ -231,16 +311,28 @@ type Series struct {
 	visible     bool
 	legendEntry *interact.LegendEntry
 	textStyle   style.ChartTextStyle
+	labelOpts   style.LabelOptions
 	chart       *BaseChart
 	height      float64
 	hOffset     float64
+	sortByValue bool
+	topN        int
+	threshold   float64
+	other       *proportionPoint
+	otherName   string
+	otherCol    fyne.ThemeColorName
+	onRelease   func()
+	mu          sync.Mutex
 }
 
 func EmptyProportionalSeries(name string) (ser *Series) {
 	ser = &Series{
-		name:     name,
-		visible:  true,
-		showText: true,
+		name:      name,
+		visible:   true,
+		showText:  true,
+		labelOpts: style.DefaultLabelOptions(),
+		otherName: "Other",
+		otherCol:  theme.ColorNameDisabled,
 	}
 	ser.SetValTextStyle(style.DefaultValueTextStyle())
 	ser.legendEntry = interact.NewLegendEntry(name, "", false, theme.ColorNameForeground, ser.toggleView)
@@ -253,6 +345,52 @@ func (ser *Series) Name() (n string) {
 	return
 }
 
+// Lock blocks concurrent data updates of the series
+func (ser *Series) Lock() {
+	ser.mu.Lock()
+}
+
+// Unlock releases the lock acquired by Lock
+func (ser *Series) Unlock() {
+	ser.mu.Unlock()
+}
+
+// Data gives the data points of the series with the color they were added with; the series must be locked
+func (ser *Series) Data() (ps []data.ProportionalPoint) {
+	for _, point := range ser.data {
+		ps = append(ps, data.ProportionalPoint{C: point.c, Val: point.val, ColName: point.baseColName})
+	}
+	return
+}
+
+// lockedPoints gives all points like allPoints while the series is locked
+func (ser *Series) lockedPoints() (ps []*proportionPoint) {
+	ser.mu.Lock()
+	ps = ser.allPoints()
+	ser.mu.Unlock()
+	return
+}
+
+// dataChanged updates aggregation and chart on the main goroutine after the data was modified
+func (ser *Series) dataChanged(removed []*proportionPoint) {
+	batch.RunOnMain(func() {
+		ch := ser.chart
+		if ch == nil {
+			ser.updateAggregation()
+			return
+		}
+		ch.change(func() {
+			for _, point := range removed {
+				if point.inLegend {
+					ch.RemoveLegendEntry(point.c, ser.name)
+					point.inLegend = false
+				}
+			}
+			ser.updateAggregation()
+		})
+	})
+}
+
 func (ser *Series) BindToChart(ch *BaseChart) (err error) {
 	if ser.chart != nil {
 		err = errors.New("series is already part of a chart")
@@ -260,60 +398,73 @@ func (ser *Series) BindToChart(ch *BaseChart) (err error) {
 	}
 	ser.chart = ch
 	ch.AddLegendEntry(ser.legendEntry)
-	for i := range ser.data {
-		ch.AddLegendEntry(ser.data[i].legendEntry)
-	}
+	ser.syncLegend()
 	return
 }
 
 func (ser *Series) Release() {
 	if ser.chart != nil {
-		for i := range ser.data {
-			ser.chart.RemoveLegendEntry(ser.data[i].c, ser.name)
+		for _, point := range ser.lockedPoints() {
+			if point.inLegend {
+				ser.chart.RemoveLegendEntry(point.c, ser.name)
+				point.inLegend = false
+			}
 		}
 		ser.chart.RemoveLegendEntry(ser.name, "")
 	}
 	ser.chart = nil
+	if ser.onRelease != nil {
+		ser.onRelease()
+		ser.onRelease = nil
+	}
+}
+
+// SetReleaseFunc registers a function that is called once when the series is released from its chart
+func (ser *Series) SetReleaseFunc(f func()) {
+	ser.onRelease = f
 }
 
 func (ser *Series) ConvertPtoN(pToN func(p float64) (n float64)) {
 	valOffset := 0.0
-	for i := range ser.data {
-		ser.data[i].valOffset = valOffset
-		if ser.data[i].visible {
-			ser.data[i].n = pToN(ser.data[i].val / ser.tot)
-			valOffset += ser.data[i].n
+	for _, point := range ser.points() {
+		point.valOffset = valOffset
+		point.cells = nil
+		if point.visible {
+			point.n = pToN(point.val / ser.tot)
+			valOffset += point.n
 		} else {
-			ser.data[i].n = 0
+			point.n = 0
 		}
+		point.label = ser.labelText(point)
 	}
 }
 
 func (ser *Series) CartesianRects(xMin float64, xMax float64, yMin float64,
 	yMax float64) (fs []renderer.CartesianRect) {
-	for i := range ser.data {
-		fs = append(fs, ser.data[i].cartesianRects(xMin, xMax, yMin, yMax)...)
+	for _, point := range ser.points() {
+		fs = append(fs, point.cartesianRects(xMin, xMax, yMin, yMax)...)
 	}
 	return
 }
 
 func (ser *Series) CartesianTexts(xMin float64, xMax float64, yMin float64,
 	yMax float64) (ts []renderer.CartesianText) {
-	for i := range ser.data {
-		ts = append(ts, ser.data[i].cartesianTexts(xMin, xMax, yMin, yMax)...)
+	for _, point := range ser.points() {
+		ts = append(ts, point.cartesianTexts(xMin, xMax, yMin, yMax)...)
 	}
 	return
 }
 
-func (ser *Series) RasterColorPolar(phi float64, r float64) (col color.Color, useColor bool) {
+func (ser *Series) RasterColorPolar(phi float64, r float64, x float64,
+	y float64) (col color.Color, useColor bool) {
 	col = color.RGBA{0x00, 0x00, 0x00, 0x00}
 	useColor = false
-	if !ser.visible || r < ser.hOffset || r > ser.hOffset+ser.height {
+	if !ser.visible || (!ser.exploded() && (r < ser.hOffset || r > ser.hOffset+ser.height)) {
 		return
 	}
 	pCol := col
-	for i := range ser.data {
-		pCol, useColor = ser.data[i].RasterColorPolar(phi, r)
+	for _, point := range ser.points() {
+		pCol, useColor = point.RasterColorPolar(phi, r, x, y)
 		if useColor {
 			col = pCol
 			break
@@ -322,32 +473,45 @@ func (ser *Series) RasterColorPolar(phi float64, r float64) (col color.Color, us
 	return
 }
 
+// rasterPolygons gives the sectors of all displayed points
+func (ser *Series) rasterPolygons() (ps []renderer.Polygon) {
+	if !ser.visible {
+		return
+	}
+	for _, point := range ser.points() {
+		if p, ok := point.rasterPolygon(); ok {
+			ps = append(ps, p)
+		}
+	}
+	return
+}
+
 func (ser *Series) PolarTexts(phiMin float64, phiMax float64, rMin float64,
 	rMax float64) (ts []renderer.PolarText) {
-	for i := range ser.data {
-		ts = append(ts, ser.data[i].polarTexts(phiMin, phiMax, rMin, rMax)...)
+	for _, point := range ser.points() {
+		ts = append(ts, point.polarTexts(phiMin, phiMax, rMin, rMax)...)
 	}
 	return
 }
 
 func (ser *Series) RefreshTheme() {
-	for i := range ser.data {
-		ser.data[i].refreshTheme()
+	for _, point := range ser.allPoints() {
+		point.refreshTheme()
 	}
 }
 
 func (ser *Series) SetValTextStyle(ts style.ChartTextStyle) {
 	ser.textStyle = ts
-	for i := range ser.data {
-		ser.data[i].setTextStyle(ts)
+	for _, point := range ser.lockedPoints() {
+		point.setTextStyle(ts)
 	}
 }
 
 // Show makes the Bars of the series visible
 func (ser *Series) Show() {
 	ser.visible = true
-	for i := range ser.data {
-		ser.data[i].show()
+	for _, point := range ser.lockedPoints() {
+		point.show()
 	}
 	ser.legendEntry.Show()
 }
@@ -355,8 +519,8 @@ func (ser *Series) Show() {
 // Hide hides the Barss of the series
 func (ser *Series) Hide() {
 	ser.visible = false
-	for i := range ser.data {
-		ser.data[i].hide()
+	for _, point := range ser.lockedPoints() {
+		point.hide()
 	}
 	ser.legendEntry.Hide()
 }
@@ -374,32 +538,42 @@ func (ser *Series) toggleView() {
 	}
 }
 
-func (ser *Series) pointVisibilityUpdate(totChange float64) {
-	ser.tot += totChange
-	if ser.chart != nil {
-		ser.chart.DataChange()
-	}
+func (ser *Series) pointVisibilityUpdate() {
+	ser.dataChange()
 }
 
 func (ser *Series) SetHeightAndOffset(h float64, hOffset float64) {
 	ser.height = h
 	ser.hOffset = hOffset
-	for i := range ser.data {
-		ser.data[i].height = h
-		ser.data[i].hOffset = hOffset
+	for _, point := range ser.points() {
+		point.height = h
+		point.hOffset = hOffset
 	}
 }
 
-func (ser *Series) Clear() {
-	if ser.chart != nil {
-		for i := range ser.data {
-			ser.chart.RemoveLegendEntry(ser.data[i].c, ser.name)
+// SetExplode moves the slices of the given categories outwards by offset; all other slices are not moved
+func (ser *Series) SetExplode(explode map[string]float64, scale float64) {
+	for _, point := range ser.points() {
+		point.explode = explode[point.c] * scale
+	}
+}
+
+func (ser *Series) exploded() (b bool) {
+	for _, point := range ser.points() {
+		if point.explode > 0 {
+			b = true
+			return
 		}
 	}
+	return
+}
+
+func (ser *Series) Clear() {
+	ser.mu.Lock()
+	removed := ser.data
 	ser.data = []*proportionPoint{}
-	if ser.chart != nil {
-		ser.chart.DataChange()
-	}
+	ser.mu.Unlock()
+	ser.dataChanged(removed)
 }
 
 func (ser *Series) DeleteDataInRange(cat []string) (c int) {
@@ -408,34 +582,31 @@ func (ser *Series) DeleteDataInRange(cat []string) (c int) {
 		return
 	}
 	finalData := []*proportionPoint{}
-	tot := 0.0
+	removed := []*proportionPoint{}
+	ser.mu.Lock()
 	for i := range ser.data {
 		del := false
 		for j := range cat {
 			if ser.data[i].c == cat[j] {
 				del = true
-				if ser.chart != nil {
-					ser.chart.RemoveLegendEntry(ser.data[i].c, ser.name)
-				}
 				break
 			}
 		}
 		if del {
 			c++
+			removed = append(removed, ser.data[i])
 		} else {
 			finalData = append(finalData, ser.data[i])
-			tot += ser.data[i].val
 		}
 	}
 	if c == 0 {
+		ser.mu.Unlock()
 		return
 	}
 	ser.data = nil
 	ser.data = finalData
-	ser.tot = tot
-	if ser.chart != nil {
-		ser.chart.DataChange()
-	}
+	ser.mu.Unlock()
+	ser.dataChanged(removed)
 	return
 }
 
@@ -443,13 +614,46 @@ func (ser *Series) AddData(input []data.ProportionalPoint) (err error) {
 	if len(input) == 0 {
 		return
 	}
+	err = validData(input)
+	if err != nil {
+		return
+	}
+	ser.mu.Lock()
+	ser.addData(input)
+	ser.mu.Unlock()
+	ser.dataChanged(nil)
+	return
+}
+
+// ReplaceData replaces all data of the series with input
+// If input is invalid, an error is returned and the series keeps its data
+func (ser *Series) ReplaceData(input []data.ProportionalPoint) (err error) {
+	err = validData(input)
+	if err != nil {
+		return
+	}
+	ser.mu.Lock()
+	removed := ser.data
+	ser.data = []*proportionPoint{}
+	ser.addData(input)
+	ser.mu.Unlock()
+	ser.dataChanged(removed)
+	return
+}
+
+// validData checks that no point of input has a negative value
+func validData(input []data.ProportionalPoint) (err error) {
 	for i := range input {
 		if input[i].Val < 0 {
 			err = errors.New("invalid data")
 			return
 		}
 	}
+	return
+}
 
+// addData adds the points of input with a new category to the data; the series must be locked
+func (ser *Series) addData(input []data.ProportionalPoint) {
 	for i := range input {
 		catExist := false
 		for j := range ser.data {
@@ -465,13 +669,5 @@ func (ser *Series) AddData(input []data.ProportionalPoint) (err error) {
 		pPoint.setTextStyle(ser.textStyle)
 		pPoint.val = input[i].Val
 		ser.data = append(ser.data, pPoint)
-		ser.tot += pPoint.val
-		if ser.chart != nil {
-			ser.chart.AddLegendEntry(pPoint.legendEntry)
-		}
 	}
-	if ser.chart != nil {
-		ser.chart.DataChange()
-	}
-	return
 }
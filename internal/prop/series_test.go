@@ -0,0 +1,41 @@
+package prop
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+func TestReplaceData(t *testing.T) {
+	test.NewTempApp(t)
+	ser := EmptyProportionalSeries("s")
+	err := ser.AddData([]data.ProportionalPoint{{C: "a", Val: 1}, {C: "b", Val: 2}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var tests = []struct {
+		input  []data.ProportionalPoint
+		expErr bool
+		exp    []string
+	}{
+		{[]data.ProportionalPoint{{C: "c", Val: 1}, {C: "d", Val: -1}}, true, []string{"a", "b"}},
+		{[]data.ProportionalPoint{{C: "c", Val: 1}, {C: "a", Val: 4}}, false, []string{"c", "a"}},
+		{[]data.ProportionalPoint{}, false, []string{}},
+	}
+	for i, tt := range tests {
+		err = ser.ReplaceData(tt.input)
+		if (err != nil) != tt.expErr {
+			t.Errorf("wrong error, set %d, exp error %t, have %v", i, tt.expErr, err)
+		}
+		if len(ser.data) != len(tt.exp) {
+			t.Errorf("wrong number of points, set %d, exp %d, have %d", i, len(tt.exp), len(ser.data))
+			continue
+		}
+		for j := range tt.exp {
+			if ser.data[j].c != tt.exp[j] {
+				t.Errorf("wrong point, set %d, point %d, exp %s, have %s", i, j, tt.exp[j], ser.data[j].c)
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package prop
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/s-daehling/fyne-charts/internal/spec"
+	"github.com/s-daehling/fyne-charts/pkg/style"
+)
+
+// Spec describes the configuration and the data of the chart as chart specification
+// An error is returned for treemap and sunburst charts
+func (base *BaseChart) Spec() (s spec.Chart, err error) {
+	if base.tree != nil {
+		err = errors.New("treemap and sunburst charts are not supported by specifications")
+		return
+	}
+	base.Lock()
+	defer base.Unlock()
+	switch {
+	case base.planeType == PolarPlane:
+		s.Type = spec.Pie
+	case base.layout == FunnelLayout:
+		s.Type = spec.Funnel
+	case base.layout == WaffleLayout:
+		s.Type = spec.Waffle
+	default:
+		s.Type = spec.Bar
+		s.Transposed = base.transposed
+	}
+	s.Title = base.title.Text
+	var l spec.Legend
+	if !base.legend.Visible() {
+		l.Visible = new(bool)
+	}
+	if loc := base.legend.Location(); loc != style.LegendLocationRight {
+		l.Location = string(loc)
+	}
+	if !base.legend.Interactive() {
+		l.Interactive = new(bool)
+	}
+	if l != (spec.Legend{}) {
+		s.Legend = &l
+	}
+	for _, ser := range base.series {
+		ss := spec.Series{Name: ser.name}
+		ss.Data, err = spec.Records(ser.Data())
+		if err != nil {
+			err = fmt.Errorf("series %s: %w", ser.name, err)
+			return
+		}
+		s.Series = append(s.Series, ss)
+	}
+	return
+}
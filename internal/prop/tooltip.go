@@ -0,0 +1,104 @@
+package prop
+
+import (
+	"math"
+	"strconv"
+)
+
+func (base *BaseChart) MouseIn(pX, pY, w, h, absX, absY float32) {
+	base.updateTooltip(pX, pY, w, h)
+}
+
+func (base *BaseChart) MouseMove(pX, pY, w, h, absX, absY float32) {
+	base.updateTooltip(pX, pY, w, h)
+}
+
+func (base *BaseChart) MouseOut() {
+	base.tooltip.MouseOut()
+	base.Refresh()
+}
+
+// updateTooltip shows the collapsed categories while the mouse is over an other category
+func (base *BaseChart) updateTooltip(pX, pY, w, h float32) {
+	_, _, entries, _ := base.tooltip.GetEntries()
+	wasShown := len(entries) > 0
+	base.Lock()
+	point := base.otherPointAt(pX, pY, w, h)
+	var ent []string
+	if point != nil {
+		ent = otherTooltipEntries(point)
+	}
+	base.Unlock()
+	if point == nil {
+		if wasShown {
+			base.tooltip.MouseOut()
+			base.Refresh()
+		}
+		return
+	}
+	if !wasShown {
+		base.tooltip.MouseIn(pX, pY)
+		base.tooltip.SetEntries(ent)
+		base.Refresh()
+		return
+	}
+	if c := base.tooltip.MouseMove(pX, pY); c > 3 {
+		base.tooltip.SetEntries(ent)
+		base.Refresh()
+	}
+}
+
+// otherTooltipEntries lists all categories collapsed into an other category with their values
+func otherTooltipEntries(point *proportionPoint) (ent []string) {
+	ent = append(ent, point.c+": "+strconv.FormatFloat(point.val, 'g', 6, 64))
+	for _, c := range point.members {
+		for _, member := range point.ser.data {
+			if member.c == c {
+				ent = append(ent, "  "+c+": "+strconv.FormatFloat(member.val, 'g', 6, 64))
+				break
+			}
+		}
+	}
+	return
+}
+
+// otherPointAt gives the other category at the position; nil if there is none
+func (base *BaseChart) otherPointAt(pX, pY, w, h float32) (point *proportionPoint) {
+	if base.tree != nil || w <= 0 || h <= 0 {
+		return
+	}
+	if base.planeType == PolarPlane {
+		phi, r, _, _ := base.PositionToPolarCoordinates(int(pX), int(pY), int(w), int(h))
+		x, y := r*math.Cos(phi), r*math.Sin(phi)
+		for _, ser := range base.series {
+			if ser.other == nil || len(ser.other.members) == 0 {
+				continue
+			}
+			if _, hit := ser.other.RasterColorPolar(phi, r, x, y); hit {
+				point = ser.other
+				return
+			}
+		}
+		return
+	}
+	hor := float64(pX / w)
+	vert := float64((h - pY) / h)
+	from := base.fromMin + vert*(base.fromMax-base.fromMin)
+	to := base.toMin + hor*(base.toMax-base.toMin)
+	if !base.transposed {
+		from = base.fromMin + hor*(base.fromMax-base.fromMin)
+		to = base.toMin + vert*(base.toMax-base.toMin)
+	}
+	for _, ser := range base.series {
+		if ser.other == nil || len(ser.other.members) == 0 {
+			continue
+		}
+		for _, rect := range ser.other.cartesianRects(base.fromMin, base.fromMax, base.toMin, base.toMax) {
+			if from >= rect.X1 && from <= rect.X2 && to >= rect.Y1 && to <= rect.Y2 {
+				point = ser.other
+				return
+			}
+		}
+	}
+	return
+}
@@ -1,18 +1,70 @@
 package prop
 
 import (
+	"math"
+
 	"fyne.io/fyne/v2/theme"
 )
 
 func (base *BaseChart) Refresh() {
+	base.updater.Refresh()
+}
+
+func (base *BaseChart) refresh() {
 	if base.render != nil {
 		base.render.Refresh()
 	}
 }
 
 func (base *BaseChart) DataChange() {
+	base.updater.DataChange()
+}
+
+func (base *BaseChart) dataChange() {
+	base.Lock()
 	base.updateSeriesVariables()
-	base.Refresh()
+	base.updateCategoryLegend()
+	base.updateHierarchy()
+	base.Unlock()
+	base.refresh()
+}
+
+// Lock blocks concurrent data updates of the chart and all of its series
+// Rendering holds the lock while it reads the series data
+func (base *BaseChart) Lock() {
+	base.mu.Lock()
+	for i := range base.series {
+		base.series[i].Lock()
+	}
+}
+
+// Unlock releases the lock acquired by Lock
+func (base *BaseChart) Unlock() {
+	for i := len(base.series) - 1; i >= 0; i-- {
+		base.series[i].Unlock()
+	}
+	base.mu.Unlock()
+}
+
+// change runs f, which prepares a data change of a series, and the data change of the chart in one step
+func (base *BaseChart) change(f func()) {
+	base.updater.Change(f)
+}
+
+// BeginUpdate defers all data changes and refreshes until the matching EndUpdate
+func (base *BaseChart) BeginUpdate() {
+	base.updater.BeginUpdate()
+}
+
+// EndUpdate applies the changes since BeginUpdate
+func (base *BaseChart) EndUpdate() {
+	base.updater.EndUpdate()
+}
+
+// SetMaxRefreshRate merges updates that follow each other within 1/fps seconds into one update
+func (base *BaseChart) SetMaxRefreshRate(fps float64) (err error) {
+	err = base.updater.SetMaxRate(fps)
+	return
 }
 
 func (base *BaseChart) RasterVisibilityChange() {
@@ -22,15 +74,36 @@ func (base *BaseChart) RasterVisibilityChange() {
 }
 
 func (base *BaseChart) ChartSizeChange(fromSpace float32, toSpace float32) {
-
+	if base.valAx != nil {
+		base.valAx.SetSpace(fromSpace)
+		base.valAx.AutoNTicks()
+	}
 }
 
 func (base *BaseChart) updateSeriesVariables() {
 	nPropSeries := len(base.series)
-	propHeight := base.toMax / float64(nPropSeries)
-	propOffset := 0.0
+	outer := base.toMax
+	inner := 0.0
+	if base.planeType == PolarPlane {
+		// leave space for exploded slices
+		maxExplode := 0.0
+		for _, offset := range base.explode {
+			maxExplode = math.Max(maxExplode, offset)
+		}
+		outer = base.toMax / (1 + maxExplode)
+		if base.hasOutsideLabels() {
+			// leave space for labels around the pie
+			outer *= outsideRingRatio
+		}
+		inner = base.innerRadius * outer
+	}
+	propHeight := (outer - inner) / float64(nPropSeries)
+	propOffset := inner
 	for i := range base.series {
-		if i == 0 {
+		if base.planeType == PolarPlane {
+			base.series[i].SetExplode(base.explode, outer)
+		}
+		if i == 0 && inner == 0 {
 			base.series[i].SetHeightAndOffset(propHeight, propOffset)
 		} else {
 			base.series[i].SetHeightAndOffset(propHeight*0.9, propOffset+0.1*propHeight)
@@ -39,16 +112,38 @@ func (base *BaseChart) updateSeriesVariables() {
 	}
 
 	for i := range base.series {
-		base.series[i].ConvertPtoN(base.ptoN)
+		switch {
+		case base.planeType == CartesianPlane && base.layout == FunnelLayout:
+			base.series[i].ConvertToFunnel(base.fromMax)
+		case base.planeType == CartesianPlane && base.layout == WaffleLayout:
+			base.series[i].ConvertToWaffle(base.fromMax)
+		case base.planeType == CartesianPlane:
+			// bars are scaled for all series at once in updateBars
+		default:
+			base.series[i].ConvertPtoN(base.ptoN)
+		}
+	}
+	if base.planeType == CartesianPlane && base.layout == StackedLayout {
+		base.updateBars()
 	}
 }
 
 func (base *BaseChart) RefreshTheme() {
 	base.title.TextSize = theme.Size(base.titleStyle.SizeName)
 	base.title.Color = theme.Color(base.titleStyle.ColorName)
+	base.centerText.TextSize = theme.Size(base.centerStyle.SizeName)
+	base.centerText.Color = theme.Color(base.centerStyle.ColorName)
+	base.tooltip.RefreshTheme()
+	if base.valAx != nil {
+		base.valAx.RefreshTheme()
+	}
 	for i := range base.series {
 		base.series[i].RefreshTheme()
 	}
+	if base.tree != nil && base.tree.root != nil {
+		base.tree.root.refreshTheme()
+		base.tree.root.setTextStyle(base.tree.textStyle)
+	}
 }
 
 func (base *BaseChart) ptoN(p float64) (n float64) {
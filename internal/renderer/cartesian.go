@@ -42,6 +42,8 @@ func EmptyCartesianRenderer(chart CartesianChart) (r *Cartesian) {
 
 // Layout is responsible for redrawing the chart widget; here the horizontal and vertical numerical coordinates are converted to fyne positions and objects are placed accordingly
 func (r *Cartesian) Layout(size fyne.Size) {
+	r.chart.Lock()
+	defer r.chart.Unlock()
 	r.transposed = r.chart.CartesianOrientation()
 
 	vAxisTickLabelWidth := float32(0.0)
@@ -239,15 +241,19 @@ func (r *Cartesian) MinSize() fyne.Size {
 
 // Objects returns a list of all objects to be drawn
 func (r *Cartesian) Objects() []fyne.CanvasObject {
+	r.chart.Lock()
+	defer r.chart.Unlock()
 	return r.chart.CartesianObjects()
 }
 
 // Refresh calls Layout if data of the chart has changes
 func (r *Cartesian) Refresh() {
 	// if r.chart.hasChanged() {
+	r.chart.Lock()
 	r.chart.RefreshTheme()
+	obj := r.chart.CartesianObjects()
+	r.chart.Unlock()
 
-	obj := r.Objects()
 	for i := range obj {
 		obj[i].Refresh()
 	}
@@ -66,6 +66,14 @@ type Tick struct {
 	SupCircle *canvas.Circle
 }
 
+// ImageText is a text that is drawn as image, e.g. to rotate it
+// Rot is the counter-clockwise rotation of the image in degrees
+type ImageText struct {
+	Image *canvas.Image
+	Text  *canvas.Text
+	Rot   float64
+}
+
 type Arrow struct {
 	Line    *canvas.Line
 	Circle  *canvas.Circle
@@ -12,6 +12,7 @@ type PolarChart interface {
 	PolarEdges() (es []PolarEdge)
 	PolarTexts() (ts []PolarText)
 	PolarObjects() (obj []fyne.CanvasObject)
+	PolarOrientation() (rot float64, mathPos bool)
 }
 
 // polDrawingArea represents the area of the widget that can be used for the chart
@@ -44,6 +45,8 @@ func EmptyPolarRenderer(chart PolarChart) (r *Polar) {
 
 // Layout is responsible for redrawing the chart widget
 func (r *Polar) Layout(size fyne.Size) {
+	r.chart.Lock()
+	defer r.chart.Unlock()
 	phiAxisTickLabelWidth := float32(0.0)
 	phiAxisTickLabelHeight := float32(0.0)
 
@@ -53,6 +56,7 @@ func (r *Polar) Layout(size fyne.Size) {
 	var phiShow, rShow bool
 	_, _, phiOrigin, phiTicks, phiArrow, phiShow = r.chart.FromAxisElements()
 	_, rMax, rOrigin, _, rArrow, rShow = r.chart.ToAxisElements()
+	r.rot, r.mathPos = r.chart.PolarOrientation()
 
 	phiOriginAbs := absAngle(phiOrigin, r.mathPos, r.rot)
 	phiAxisTickLabelWidth, phiAxisTickLabelHeight = maxTickSize(phiTicks)
@@ -255,15 +259,19 @@ func (r *Polar) MinSize() fyne.Size {
 
 // Objects returns a list of all objects to be drawn
 func (r *Polar) Objects() []fyne.CanvasObject {
+	r.chart.Lock()
+	defer r.chart.Unlock()
 	return r.chart.PolarObjects()
 }
 
 // Refresh calls Layout if data of the chart has changes
 func (r *Polar) Refresh() {
 	// if r.chart.hasChanged() {
+	r.chart.Lock()
 	r.chart.RefreshTheme()
+	obj := r.chart.PolarObjects()
+	r.chart.Unlock()
 
-	obj := r.Objects()
 	for i := range obj {
 		obj[i].Refresh()
 	}
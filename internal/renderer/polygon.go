@@ -0,0 +1,98 @@
+package renderer
+
+import (
+	"image/color"
+	"math"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+)
+
+// Polygon is an area of a series that is drawn on the raster of the chart
+// Cartesian charts give the corners in x and y; polar charts give them in phi (X) and r (Y) and the edges follow
+// r changing linearly with phi like on the raster. Polar corners with Plane set are x = r cos(phi) and
+// y = r sin(phi) and the edges are straight
+type Polygon struct {
+	X     []float64
+	Y     []float64
+	Plane bool
+	Col   color.Color
+}
+
+// RasterArea gives the areas that are drawn on a raster, so that vector exports can write them as paths
+// The points of the outlines are relative to the top left corner of the raster; later outlines are drawn on top
+type RasterArea struct {
+	Raster   *canvas.Raster
+	Outlines []Outline
+}
+
+// Outline is a filled polygon of a raster area; it is filled with the even-odd rule
+type Outline struct {
+	Points []fyne.Position
+	Col    color.Color
+}
+
+// ClipPolygon cuts the polygon with the corners xs, ys to the rectangle given by the ranges
+// Infinite limits do not clip; the result is empty if nothing of the polygon is left
+func ClipPolygon(xs []float64, ys []float64, xMin float64, xMax float64, yMin float64,
+	yMax float64) (cx []float64, cy []float64) {
+	cx, cy = xs, ys
+	cx, cy = clipEdge(cx, cy, func(x, _ float64) float64 { return x - xMin }, !math.IsInf(xMin, -1))
+	cx, cy = clipEdge(cx, cy, func(x, _ float64) float64 { return xMax - x }, !math.IsInf(xMax, 1))
+	cx, cy = clipEdge(cx, cy, func(_, y float64) float64 { return y - yMin }, !math.IsInf(yMin, -1))
+	cx, cy = clipEdge(cx, cy, func(_, y float64) float64 { return yMax - y }, !math.IsInf(yMax, 1))
+	if len(cx) < 3 {
+		cx, cy = nil, nil
+	}
+	return
+}
+
+// clipEdge keeps the part of the polygon where dist is not negative
+func clipEdge(xs []float64, ys []float64, dist func(x, y float64) float64, clip bool) (cx []float64,
+	cy []float64) {
+	if !clip {
+		cx, cy = xs, ys
+		return
+	}
+	for i := range xs {
+		j := (i + 1) % len(xs)
+		di, dj := dist(xs[i], ys[i]), dist(xs[j], ys[j])
+		if di >= 0 {
+			cx = append(cx, xs[i])
+			cy = append(cy, ys[i])
+		}
+		if (di >= 0) != (dj >= 0) {
+			f := di / (di - dj)
+			cx = append(cx, xs[i]+f*(xs[j]-xs[i]))
+			cy = append(cy, ys[i]+f*(ys[j]-ys[i]))
+		}
+	}
+	return
+}
+
+// PolarOutline gives the corners in the plane of the polygon with the corners phi, r
+// The edges are divided, so that r changes linearly with phi in steps of at most one degree
+func PolarOutline(phi []float64, r []float64) (x []float64, y []float64) {
+	for i := range phi {
+		j := (i + 1) % len(phi)
+		steps := int(math.Ceil(math.Abs(phi[j]-phi[i]) / (math.Pi / 180)))
+		if steps < 1 {
+			steps = 1
+		}
+		for k := 0; k < steps; k++ {
+			f := float64(k) / float64(steps)
+			p := phi[i] + f*(phi[j]-phi[i])
+			rad := r[i] + f*(r[j]-r[i])
+			x = append(x, rad*math.Cos(p))
+			y = append(y, rad*math.Sin(p))
+		}
+	}
+	return
+}
+
+// FillColor gives col with the opacity a
+func FillColor(col color.Color, a uint8) (fill color.NRGBA) {
+	fill = color.NRGBAModel.Convert(col).(color.NRGBA)
+	fill.A = a
+	return
+}
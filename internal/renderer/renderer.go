@@ -15,6 +15,8 @@ type baseChart interface {
 	ChartSizeChange(fromSpace float32, toSpace float32)
 	RefreshTheme()
 	Size() (size fyne.Size)
+	Lock()
+	Unlock()
 }
 
 type baseRenderer struct {
@@ -0,0 +1,130 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	dataio "github.com/s-daehling/fyne-charts/pkg/data/io"
+)
+
+// ChartType names the kind of chart that is described by a specification
+type ChartType string
+
+const (
+	CartesianNumerical   ChartType = "cartesian-numerical"
+	CartesianTemporal    ChartType = "cartesian-temporal"
+	CartesianCategorical ChartType = "cartesian-categorical"
+	PolarNumerical       ChartType = "polar-numerical"
+	PolarTemporal        ChartType = "polar-temporal"
+	PolarCategorical     ChartType = "polar-categorical"
+	Pie                  ChartType = "pie"
+	Bar                  ChartType = "bar"
+	Funnel               ChartType = "funnel"
+	Waffle               ChartType = "waffle"
+)
+
+// SeriesType names the kind of series of coordinate charts
+type SeriesType string
+
+const (
+	Line        SeriesType = "line"
+	Area        SeriesType = "area"
+	Scatter     SeriesType = "scatter"
+	Lollipop    SeriesType = "lollipop"
+	BarSeries   SeriesType = "bar"
+	CandleStick SeriesType = "candlestick"
+	Box         SeriesType = "box"
+)
+
+// Chart describes a chart with its axes, legend and series
+type Chart struct {
+	Type       ChartType `json:"type" yaml:"type"`
+	Title      string    `json:"title,omitempty" yaml:"title,omitempty"`
+	Transposed bool      `json:"transposed,omitempty" yaml:"transposed,omitempty"`
+	X          *Axis     `json:"x,omitempty" yaml:"x,omitempty"`
+	Y          *Axis     `json:"y,omitempty" yaml:"y,omitempty"`
+	Legend     *Legend   `json:"legend,omitempty" yaml:"legend,omitempty"`
+	Series     []Series  `json:"series,omitempty" yaml:"series,omitempty"`
+}
+
+// Axis describes the label, range and ticks of an axis
+// Ranges and ticks are calculated from the data if they are not given
+type Axis struct {
+	Label        string   `json:"label,omitempty" yaml:"label,omitempty"`
+	Min          *float64 `json:"min,omitempty" yaml:"min,omitempty"`
+	Max          *float64 `json:"max,omitempty" yaml:"max,omitempty"`
+	TMin         string   `json:"tMin,omitempty" yaml:"tMin,omitempty"`
+	TMax         string   `json:"tMax,omitempty" yaml:"tMax,omitempty"`
+	Categories   []string `json:"categories,omitempty" yaml:"categories,omitempty"`
+	Ticks        []Tick   `json:"ticks,omitempty" yaml:"ticks,omitempty"`
+	TickFormat   string   `json:"tickFormat,omitempty" yaml:"tickFormat,omitempty"`
+	SupportLines *bool    `json:"supportLines,omitempty" yaml:"supportLines,omitempty"`
+}
+
+// Tick describes a manually placed tick of a numerical (N) or temporal (T) axis
+type Tick struct {
+	N           *float64 `json:"n,omitempty" yaml:"n,omitempty"`
+	T           string   `json:"t,omitempty" yaml:"t,omitempty"`
+	SupportLine bool     `json:"supportLine,omitempty" yaml:"supportLine,omitempty"`
+}
+
+// Legend describes visibility, location and interactiveness of the legend
+type Legend struct {
+	Visible     *bool  `json:"visible,omitempty" yaml:"visible,omitempty"`
+	Location    string `json:"location,omitempty" yaml:"location,omitempty"`
+	Interactive *bool  `json:"interactive,omitempty" yaml:"interactive,omitempty"`
+}
+
+// Series describes a series with its style and either inline data or a data source
+// The elements of Data have the format of the JSON documents of the data/io package
+type Series struct {
+	Name      string           `json:"name" yaml:"name"`
+	Type      SeriesType       `json:"type,omitempty" yaml:"type,omitempty"`
+	Color     string           `json:"color,omitempty" yaml:"color,omitempty"`
+	ShowDots  bool             `json:"showDots,omitempty" yaml:"showDots,omitempty"`
+	LineWidth *float64         `json:"lineWidth,omitempty" yaml:"lineWidth,omitempty"`
+	DotSize   *float64         `json:"dotSize,omitempty" yaml:"dotSize,omitempty"`
+	BarWidth  *float64         `json:"barWidth,omitempty" yaml:"barWidth,omitempty"`
+	Data      []map[string]any `json:"data,omitempty" yaml:"data,omitempty"`
+	Source    *Source          `json:"source,omitempty" yaml:"source,omitempty"`
+}
+
+// Source describes a CSV or JSON file the data of a series is read from
+type Source struct {
+	Path       string            `json:"path" yaml:"path"`
+	Format     string            `json:"format,omitempty" yaml:"format,omitempty"`
+	Delimiter  string            `json:"delimiter,omitempty" yaml:"delimiter,omitempty"`
+	Header     *bool             `json:"header,omitempty" yaml:"header,omitempty"`
+	Columns    map[string]string `json:"columns,omitempty" yaml:"columns,omitempty"`
+	TimeLayout string            `json:"timeLayout,omitempty" yaml:"timeLayout,omitempty"`
+}
+
+// Error reports an invalid element of a specification
+// Path locates the element, e.g. series[2].data[0].Val
+type Error struct {
+	Path string
+	Err  error
+}
+
+func (e *Error) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Records converts data points to elements of Series.Data
+func Records[T dataio.Record](rs []T) (d []map[string]any, err error) {
+	var b bytes.Buffer
+	err = dataio.WriteJSON(&b, rs, dataio.DefaultOptions())
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(b.Bytes(), &d)
+	return
+}
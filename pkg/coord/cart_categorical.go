@@ -98,6 +98,21 @@ func (catChart *CartesianCategoricalChart) AddBoxSeries(cbs *CategoricalBoxSerie
 	return
 }
 
+// AddViolinSeries adds a series of data which is visualized as violin chart.
+// The series must have a unique name throughout the chart.
+// An error is returned,if another series with the same name exists or if the series is already added to another chart
+func (catChart *CartesianCategoricalChart) AddViolinSeries(cvs *CategoricalViolinSeries) (err error) {
+	if catChart.base == nil || cvs == nil {
+		return
+	}
+	if cvs.ser == nil {
+		err = errors.New("series not initialized")
+		return
+	}
+	err = catChart.base.AddViolinSeries(cvs.ser)
+	return
+}
+
 // SetOrientation defines the orientation of axes
 // if transposed is false, the C-axis is the horizontal axis and the Y-axis is the vertical axis
 // if transposed is true, the C-axis is the vertical axis and the Y-axis is the horizontal axis
@@ -70,6 +70,21 @@ func (numChart *CartesianNumericalChart) AddLollipopSeries(nps *NumericalPointSe
 	return
 }
 
+// AddRegressionSeries adds a curve fitted to the data of a point series.
+// The series must have a unique name throughout the chart.
+// An error is returned,if another series with the same name exists or if the series is already added to another chart
+func (numChart *CartesianNumericalChart) AddRegressionSeries(nrs *NumericalRegressionSeries) (err error) {
+	if numChart.base == nil || nrs == nil {
+		return
+	}
+	if nrs.ser == nil {
+		err = errors.New("series not initialized")
+		return
+	}
+	err = numChart.base.AddRegressionSeries(nrs.ser)
+	return
+}
+
 // AddCandleStickSeries adds a series of data which is visualized as canlde stick chart.
 // The series must have a unique name throughout the chart.
 // An error is returned,if another series with the same name exists or if the series is already added to another chart
@@ -100,6 +115,36 @@ func (numChart *CartesianNumericalChart) AddBoxSeries(nbs *NumericalBoxSeries) (
 	return
 }
 
+// AddViolinSeries adds a series of data which is visualized as violin chart.
+// The series must have a unique name throughout the chart.
+// An error is returned,if another series with the same name exists or if the series is already added to another chart
+func (numChart *CartesianNumericalChart) AddViolinSeries(nvs *NumericalViolinSeries) (err error) {
+	if numChart.base == nil || nvs == nil {
+		return
+	}
+	if nvs.ser == nil {
+		err = errors.New("series not initialized")
+		return
+	}
+	err = numChart.base.AddViolinSeries(nvs.ser)
+	return
+}
+
+// AddKDESeries adds a series of data which is visualized as kernel density estimate line.
+// The series must have a unique name throughout the chart.
+// An error is returned,if another series with the same name exists or if the series is already added to another chart
+func (numChart *CartesianNumericalChart) AddKDESeries(nks *NumericalKDESeries) (err error) {
+	if numChart.base == nil || nks == nil {
+		return
+	}
+	if nks.ser == nil {
+		err = errors.New("series not initialized")
+		return
+	}
+	err = numChart.base.AddKDESeries(nks.ser)
+	return
+}
+
 // AddAreaSeries adds a series of data which is visualized as area chart.
 // If showDots is true, dots are displayed at the osition of the series points.
 // The series must have a unique name throughout the chart.
@@ -25,6 +25,26 @@ func NewCartesianTemporalChart(title string) (tempChart *CartesianTemporalChart)
 	return
 }
 
+// NewLinkedCartesianTemporalChart returns an initialized CartesianTemporalChart whose t-range follows the t-range of main.
+// This is intended for charts displayed below main, e.g. for indicators like RSI or MACD.
+// A t-range set on the linked chart is overwritten with the next data change of main.
+func NewLinkedCartesianTemporalChart(title string, main *CartesianTemporalChart) (tempChart *CartesianTemporalChart) {
+	tempChart = NewCartesianTemporalChart(title)
+	if main != nil && main.base != nil {
+		main.base.AddFollower(tempChart.base)
+	}
+	return
+}
+
+// Unlink stops following the t-range of the chart this chart has been linked to with NewLinkedCartesianTemporalChart.
+// The t-range is calculated from the data of the chart again.
+func (tempChart *CartesianTemporalChart) Unlink() {
+	if tempChart.base == nil {
+		return
+	}
+	tempChart.base.Unlink()
+}
+
 // AddLineSeries adds a series of data which is visualized as line chart.
 // If showDots is true, dots are displayed at the osition of the series points.
 // The series must have a unique name throughout the chart.
@@ -71,6 +91,21 @@ func (tempChart *CartesianTemporalChart) AddLollipopSeries(tps *TemporalPointSer
 	return
 }
 
+// AddRegressionSeries adds a curve fitted to the data of a point series.
+// The series must have a unique name throughout the chart.
+// An error is returned,if another series with the same name exists or if the series is already added to another chart
+func (tempChart *CartesianTemporalChart) AddRegressionSeries(trs *TemporalRegressionSeries) (err error) {
+	if tempChart.base == nil || trs == nil {
+		return
+	}
+	if trs.ser == nil {
+		err = errors.New("series not initialized")
+		return
+	}
+	err = tempChart.base.AddRegressionSeries(trs.ser)
+	return
+}
+
 // AddCandleStickSeries adds a series of data which is visualized as canlde stick chart.
 // The series must have a unique name throughout the chart.
 // An error is returned,if another series with the same name exists or if the series is already added to another chart
@@ -86,6 +121,38 @@ func (tempChart *CartesianTemporalChart) AddCandleStickSeries(tcs *TemporalCandl
 	return
 }
 
+// AddGanttSeries adds a series of tasks which are visualized as horizontal bars from start to end.
+// As soon as a gantt series is part of the chart, the y-axis shows one row per task row instead of numerical values.
+// The series must have a unique name throughout the chart.
+// An error is returned,if another series with the same name exists or if the series is already added to another chart
+func (tempChart *CartesianTemporalChart) AddGanttSeries(tgs *TemporalGanttSeries) (err error) {
+	if tempChart.base == nil || tgs == nil {
+		return
+	}
+	if tgs.ser == nil {
+		err = errors.New("series not initialized")
+		return
+	}
+	err = tempChart.base.AddGanttSeries(tgs.ser)
+	return
+}
+
+// AddIndicatorSeries adds a technical indicator which is visualized as one or more lines.
+// The indicator can be added to the chart of its source series or to another chart.
+// The series must have a unique name throughout the chart.
+// An error is returned,if another series with the same name exists or if the series is already added to another chart
+func (tempChart *CartesianTemporalChart) AddIndicatorSeries(tis *TemporalIndicatorSeries) (err error) {
+	if tempChart.base == nil || tis == nil {
+		return
+	}
+	if tis.ser == nil {
+		err = errors.New("series not initialized")
+		return
+	}
+	err = tempChart.base.AddIndicatorSeries(tis.ser)
+	return
+}
+
 // AddBoxSeries adds a series of data which is visualized as box chart.
 // The series must have a unique name throughout the chart.
 // An error is returned,if another series with the same name exists or if the series is already added to another chart
@@ -0,0 +1,174 @@
+package coord
+
+import (
+	"errors"
+
+	"github.com/s-daehling/fyne-charts/internal/coord"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+	"github.com/s-daehling/fyne-charts/pkg/style"
+)
+
+// RadarChart implements a radar (spider) chart with one spoke per category and a common numerical r-axis.
+// Spokes can be given an individual range.
+type RadarChart struct {
+	coordChart
+}
+
+// NewRadarChart returns an initialized RadarChart
+func NewRadarChart(title string) (radChart *RadarChart) {
+	radChart = &RadarChart{
+		coordChart: emptyCoordChart(coord.PolarPlane, coord.Categorical),
+	}
+	radChart.base.MakeRadar()
+	radChart.ExtendBaseWidget(radChart)
+	radChart.SetTitle(title)
+	return
+}
+
+// AddLineSeries adds a series of data which is visualized as closed line from category to category.
+// The series must have a unique name throughout the chart.
+// An error is returned, if another series with the same name exists or if the series is already added to another chart
+func (radChart *RadarChart) AddLineSeries(rs *RadarSeries, showDots bool) (err error) {
+	if radChart.base == nil || rs == nil {
+		return
+	}
+	if rs.ser == nil {
+		err = errors.New("series not initialized")
+		return
+	}
+	err = radChart.base.AddRadarLineSeries(rs.ser, showDots)
+	return
+}
+
+// AddAreaSeries adds a series of data which is visualized as filled polygon.
+// The series must have a unique name throughout the chart.
+// An error is returned, if another series with the same name exists or if the series is already added to another chart
+func (radChart *RadarChart) AddAreaSeries(rs *RadarSeries, showDots bool) (err error) {
+	if radChart.base == nil || rs == nil {
+		return
+	}
+	if rs.ser == nil {
+		err = errors.New("series not initialized")
+		return
+	}
+	err = radChart.base.AddRadarAreaSeries(rs.ser, showDots)
+	return
+}
+
+// SetPolygonGrid defines whether the grid is drawn as polygons (true) or circles (false, default)
+func (radChart *RadarChart) SetPolygonGrid(polygon bool) {
+	if radChart.base == nil {
+		return
+	}
+	radChart.base.SetRadarPolygonGrid(polygon)
+}
+
+// SetAxisRange sets an individual range for the spoke of category c.
+// Values of the category are scaled so that min is drawn at the center and max at the outer grid line;
+// values outside the range are clamped. Scale labels are shown along the spoke.
+// An error is returned if min >= max
+func (radChart *RadarChart) SetAxisRange(c string, min float64, max float64) (err error) {
+	if radChart.base == nil {
+		return
+	}
+	err = radChart.base.SetRadarAxisRange(c, min, max)
+	return
+}
+
+// SetAutoAxisRange removes the individual range of the spoke of category c; the spoke uses the r-axis again
+func (radChart *RadarChart) SetAutoAxisRange(c string) {
+	if radChart.base == nil {
+		return
+	}
+	radChart.base.SetRadarAutoAxisRange(c)
+}
+
+// SetRAxisLabel sets the label of the r-axis, which will be displayed at the bottom
+func (radChart *RadarChart) SetRAxisLabel(l string) {
+	if radChart.base == nil {
+		return
+	}
+	radChart.base.SetToAxisLabel(l)
+}
+
+// SetRRange sets a user defined range for the r-axis;
+// an error is returned if max<0 or if the origin has been defined by the user before and is outside the given range
+func (radChart *RadarChart) SetRRange(max float64) (err error) {
+	if radChart.base == nil {
+		return
+	}
+	err = radChart.base.SetToRange(0.0, max)
+	return
+}
+
+// SetAutoRRange overrides a previously user defined range and lets the range be calculated automatically
+func (radChart *RadarChart) SetAutoRRange() {
+	if radChart.base == nil {
+		return
+	}
+	radChart.base.SetAutoToRange()
+}
+
+// SetRTicks sets the list of user defined ticks to be shown on the r-axis.
+// Ticks with a support line define the grid lines of the chart.
+func (radChart *RadarChart) SetRTicks(ts []data.NumericalTick) {
+	if radChart.base == nil {
+		return
+	}
+	radChart.base.SetToTicks(ts)
+}
+
+// SetAutoRTicks overrides a previously user defined set of r-axis ticks and lets the ticks be calculated automatically
+func (radChart *RadarChart) SetAutoRTicks(autoSupportLine bool) {
+	if radChart.base == nil {
+		return
+	}
+	radChart.base.SetAutoToTicks(autoSupportLine)
+}
+
+// SetRAxisStyle changes the style of the R-axis
+func (radChart *RadarChart) SetRAxisStyle(labelStyle style.ChartTextStyle,
+	axisStyle style.AxisStyle) {
+	if radChart.base == nil {
+		return
+	}
+	radChart.base.SetToAxisLabelStyle(labelStyle)
+	radChart.base.SetToAxisStyle(axisStyle)
+}
+
+// SetCAxisLabel sets the label of the c-axis, which will be displayed at the left side
+func (radChart *RadarChart) SetCAxisLabel(l string) {
+	if radChart.base == nil {
+		return
+	}
+	radChart.base.SetFromAxisLabel(l)
+}
+
+// SetCRange sets a user defined range for the c-axis.
+// This will also determine the spokes and their order.
+// An error is returned if cs is empty.
+func (radChart *RadarChart) SetCRange(cs []string) (err error) {
+	if radChart.base == nil {
+		return
+	}
+	err = radChart.base.SetFromCRange(cs)
+	return
+}
+
+// SetAutoCRange overrides a previously user defined range and lets the range be calculated automatically
+func (radChart *RadarChart) SetAutoCRange() {
+	if radChart.base == nil {
+		return
+	}
+	radChart.base.SetAutoFromRange()
+}
+
+// SetCAxisStyle changes the style of the C-axis
+func (radChart *RadarChart) SetCAxisStyle(labelStyle style.ChartTextStyle,
+	axisStyle style.AxisStyle) {
+	if radChart.base == nil {
+		return
+	}
+	radChart.base.SetFromAxisLabelStyle(labelStyle)
+	radChart.base.SetFromAxisStyle(axisStyle)
+}
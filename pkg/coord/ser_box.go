@@ -71,6 +71,7 @@ func (bs *boxSeries) Clear() {
 }
 
 // NumericalBoxSeries represents a box series over a numerical x-axis
+// The canvas objects of all boxes are created when they are added, also for boxes outside the visible range
 type NumericalBoxSeries struct {
 	boxSeries
 }
@@ -100,6 +101,25 @@ func (nbs *NumericalBoxSeries) DeleteDataInRange(min float64, max float64) (c in
 	return
 }
 
+// Data returns a copy of the boxes of the series
+func (nbs *NumericalBoxSeries) Data() (d []data.NumericalBox) {
+	if nbs.ser == nil {
+		return
+	}
+	d = seriesData(nbs.ser, false, nbs.ser.NumericalData)
+	return
+}
+
+// VisibleData returns a copy of the boxes within the visible range of the x-axis of the chart
+// Nothing is returned if the series has not been added to a chart
+func (nbs *NumericalBoxSeries) VisibleData() (d []data.NumericalBox) {
+	if nbs.ser == nil {
+		return
+	}
+	d = seriesData(nbs.ser, true, nbs.ser.NumericalData)
+	return
+}
+
 // AddData adds data points to the series.
 // An error is returned if the input data is invalid
 func (nbs *NumericalBoxSeries) AddData(input []data.NumericalBox) (err error) {
@@ -111,6 +131,7 @@ func (nbs *NumericalBoxSeries) AddData(input []data.NumericalBox) (err error) {
 }
 
 // TemporalBoxSeries represents a box series over a temporal t-axis
+// The canvas objects of all boxes are created when they are added, also for boxes outside the visible range
 type TemporalBoxSeries struct {
 	boxSeries
 }
@@ -140,6 +161,25 @@ func (tbs *TemporalBoxSeries) DeleteDataInRange(min time.Time, max time.Time) (c
 	return
 }
 
+// Data returns a copy of the boxes of the series
+func (tbs *TemporalBoxSeries) Data() (d []data.TemporalBox) {
+	if tbs.ser == nil {
+		return
+	}
+	d = seriesData(tbs.ser, false, tbs.ser.TemporalData)
+	return
+}
+
+// VisibleData returns a copy of the boxes within the visible range of the t-axis of the chart
+// Nothing is returned if the series has not been added to a chart
+func (tbs *TemporalBoxSeries) VisibleData() (d []data.TemporalBox) {
+	if tbs.ser == nil {
+		return
+	}
+	d = seriesData(tbs.ser, true, tbs.ser.TemporalData)
+	return
+}
+
 // AddData adds data points to the series.
 // An error is returned if the input data is invalid
 func (tbs *TemporalBoxSeries) AddData(input []data.TemporalBox) (err error) {
@@ -151,6 +191,7 @@ func (tbs *TemporalBoxSeries) AddData(input []data.TemporalBox) (err error) {
 }
 
 // CategoricalBoxSeries represents a box series over a categorical c-axis
+// The canvas objects of all boxes are created when they are added, also for boxes outside the visible range
 type CategoricalBoxSeries struct {
 	boxSeries
 }
@@ -182,6 +223,25 @@ func (cbs *CategoricalBoxSeries) DeleteDataInRange(cat []string) (c int) {
 	return
 }
 
+// Data returns a copy of the boxes of the series
+func (cbs *CategoricalBoxSeries) Data() (d []data.CategoricalBox) {
+	if cbs.ser == nil {
+		return
+	}
+	d = seriesData(cbs.ser, false, cbs.ser.CategoricalData)
+	return
+}
+
+// VisibleData returns a copy of the boxes within the visible range of the c-axis of the chart
+// Nothing is returned if the series has not been added to a chart
+func (cbs *CategoricalBoxSeries) VisibleData() (d []data.CategoricalBox) {
+	if cbs.ser == nil {
+		return
+	}
+	d = seriesData(cbs.ser, true, cbs.ser.CategoricalData)
+	return
+}
+
 // AddData adds data points to the series.
 // The method checks for duplicates (i.e. data points with same C).
 // If multiple entries with the same C exist only the first is added to the series
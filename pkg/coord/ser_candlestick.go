@@ -54,6 +54,7 @@ func (cs *candleStickSeries) Clear() {
 }
 
 // NumericalCandleStickSeries represents a candle stick series over a numerical x-axis
+// Only the candles within the visible range of the x-axis own canvas objects, which are taken from a shared pool
 type NumericalCandleStickSeries struct {
 	candleStickSeries
 }
@@ -83,6 +84,25 @@ func (ncs *NumericalCandleStickSeries) DeleteDataInRange(min float64, max float6
 	return
 }
 
+// Data returns a copy of the candles of the series
+func (ncs *NumericalCandleStickSeries) Data() (d []data.NumericalCandleStick) {
+	if ncs.ser == nil {
+		return
+	}
+	d = seriesData(ncs.ser, false, ncs.ser.NumericalData)
+	return
+}
+
+// VisibleData returns a copy of the candles within the visible range of the x-axis of the chart
+// Nothing is returned if the series has not been added to a chart
+func (ncs *NumericalCandleStickSeries) VisibleData() (d []data.NumericalCandleStick) {
+	if ncs.ser == nil {
+		return
+	}
+	d = seriesData(ncs.ser, true, ncs.ser.NumericalData)
+	return
+}
+
 // AddData adds candles to the series.
 // An error is returned if the input data is invalid
 func (ncs *NumericalCandleStickSeries) AddData(input []data.NumericalCandleStick) (err error) {
@@ -94,6 +114,7 @@ func (ncs *NumericalCandleStickSeries) AddData(input []data.NumericalCandleStick
 }
 
 // TemporalCandleStickSeries represents a candle stick series over a temporal t-axis
+// Only the candles within the visible range of the t-axis own canvas objects, which are taken from a shared pool
 type TemporalCandleStickSeries struct {
 	candleStickSeries
 }
@@ -123,6 +144,25 @@ func (tcs *TemporalCandleStickSeries) DeleteDataInRange(min time.Time, max time.
 	return
 }
 
+// Data returns a copy of the candles of the series
+func (tcs *TemporalCandleStickSeries) Data() (d []data.TemporalCandleStick) {
+	if tcs.ser == nil {
+		return
+	}
+	d = seriesData(tcs.ser, false, tcs.ser.TemporalData)
+	return
+}
+
+// VisibleData returns a copy of the candles within the visible range of the t-axis of the chart
+// Nothing is returned if the series has not been added to a chart
+func (tcs *TemporalCandleStickSeries) VisibleData() (d []data.TemporalCandleStick) {
+	if tcs.ser == nil {
+		return
+	}
+	d = seriesData(tcs.ser, true, tcs.ser.TemporalData)
+	return
+}
+
 // AddData adds candles to the series.
 // An error is returned if the input data is invalid
 func (tcs *TemporalCandleStickSeries) AddData(input []data.TemporalCandleStick) (err error) {
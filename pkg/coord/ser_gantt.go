@@ -0,0 +1,155 @@
+package coord
+
+import (
+	"fyne.io/fyne/v2"
+	"github.com/s-daehling/fyne-charts/internal/coord/series"
+
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+// TemporalGanttSeries represents tasks, milestones and dependencies over a temporal x-axis; each task is placed in a row
+type TemporalGanttSeries struct {
+	ser *series.GanttSeries
+}
+
+// NewTemporalGanttSeries creates a new TemporalGanttSeries and populates it with tasks
+// An error is returned if the tasks are invalid
+func NewTemporalGanttSeries(name string, colName fyne.ThemeColorName, tasks []data.GanttTask) (tgs *TemporalGanttSeries, err error) {
+	tgs = &TemporalGanttSeries{
+		ser: series.EmptyGanttSeries(name, colName),
+	}
+	err = tgs.AddTasks(tasks)
+	if err != nil {
+		tgs = nil
+	}
+	return
+}
+
+// Name returns the name of the series
+func (tgs *TemporalGanttSeries) Name() (n string) {
+	if tgs.ser == nil {
+		return
+	}
+	n = tgs.ser.Name()
+	return
+}
+
+// Show makes the elements of the series visible
+func (tgs *TemporalGanttSeries) Show() {
+	if tgs.ser == nil {
+		return
+	}
+	tgs.ser.Show()
+}
+
+// Hide makes the elements of the series invisible
+func (tgs *TemporalGanttSeries) Hide() {
+	if tgs.ser == nil {
+		return
+	}
+	tgs.ser.Hide()
+}
+
+// SetColor changes the color of bars and milestones
+func (tgs *TemporalGanttSeries) SetColor(colName fyne.ThemeColorName) {
+	if tgs.ser == nil {
+		return
+	}
+	tgs.ser.SetColor(colName)
+}
+
+// SetLineWidth sets the width of the dependency arrows
+func (tgs *TemporalGanttSeries) SetLineWidth(lw float32) {
+	if tgs.ser == nil {
+		return
+	}
+	tgs.ser.SetLineWidth(lw)
+}
+
+// Clear deletes all tasks, milestones and dependencies
+func (tgs *TemporalGanttSeries) Clear() {
+	if tgs.ser == nil {
+		return
+	}
+	tgs.ser.Clear()
+}
+
+// DeleteTasks deletes all tasks and milestones with one of the given names
+// The return value gives the number of elements that have been removed
+func (tgs *TemporalGanttSeries) DeleteTasks(names []string) (c int) {
+	if tgs.ser == nil {
+		return
+	}
+	c = tgs.ser.DeleteTasks(names)
+	return
+}
+
+// Data returns a copy of the tasks of the series
+func (tgs *TemporalGanttSeries) Data() (d []data.GanttTask) {
+	if tgs.ser == nil {
+		return
+	}
+	d = seriesData(tgs.ser, false, tgs.ser.Tasks)
+	return
+}
+
+// VisibleData returns a copy of the tasks that overlap the visible range of the t-axis of the chart
+// Nothing is returned if the series has not been added to a chart
+func (tgs *TemporalGanttSeries) VisibleData() (d []data.GanttTask) {
+	if tgs.ser == nil {
+		return
+	}
+	d = seriesData(tgs.ser, true, tgs.ser.Tasks)
+	return
+}
+
+// AddTasks adds tasks to the series.
+// The name of a task is displayed inside its bar. Tasks with a name that already exists are ignored.
+// An error is returned if TEnd is before TStart for one or more tasks
+func (tgs *TemporalGanttSeries) AddTasks(tasks []data.GanttTask) (err error) {
+	if tgs.ser == nil {
+		return
+	}
+	err = tgs.ser.AddTasks(tasks)
+	return
+}
+
+// AddMilestones adds milestones to the series; they are displayed as dots with their name above
+func (tgs *TemporalGanttSeries) AddMilestones(milestones []data.GanttMilestone) (err error) {
+	if tgs.ser == nil {
+		return
+	}
+	err = tgs.ser.AddMilestones(milestones)
+	return
+}
+
+// Milestones returns a copy of the milestones of the series
+func (tgs *TemporalGanttSeries) Milestones() (ms []data.GanttMilestone) {
+	if tgs.ser == nil {
+		return
+	}
+	ms = seriesData(tgs.ser, false, tgs.ser.Milestones)
+	return
+}
+
+// AddDependencies adds arrows from the end of one task to the start of another task.
+// Dependencies that reference unknown tasks are not drawn.
+// An error is returned if From equals To for one or more dependencies
+func (tgs *TemporalGanttSeries) AddDependencies(deps []data.GanttDependency) (err error) {
+	if tgs.ser == nil {
+		return
+	}
+	err = tgs.ser.AddDependencies(deps)
+	return
+}
+
+// Dependencies returns a copy of the dependencies between the tasks of the series
+func (tgs *TemporalGanttSeries) Dependencies() (deps []data.GanttDependency) {
+	if tgs.ser == nil {
+		return
+	}
+	tgs.ser.Lock()
+	deps = tgs.ser.Dependencies()
+	tgs.ser.Unlock()
+	return
+}
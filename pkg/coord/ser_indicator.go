@@ -0,0 +1,217 @@
+package coord
+
+import (
+	"errors"
+
+	"fyne.io/fyne/v2"
+	"github.com/s-daehling/fyne-charts/internal/coord/series"
+
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+// TemporalIndicatorSource is a series whose data can be used to calculate a technical indicator.
+// It is implemented by TemporalPointSeries and TemporalCandleStickSeries.
+// Point series use the value of each point as close, high and low price with a volume of 1.
+// Candle stick series use the center of each candle as t.
+type TemporalIndicatorSource interface {
+	indicatorSource() (src series.IndicatorSource)
+}
+
+func (tps *TemporalPointSeries) indicatorSource() (src series.IndicatorSource) {
+	if tps == nil || tps.ser == nil {
+		return
+	}
+	src = tps.ser
+	return
+}
+
+func (tcs *TemporalCandleStickSeries) indicatorSource() (src series.IndicatorSource) {
+	if tcs == nil || tcs.ser == nil {
+		return
+	}
+	src = tcs.ser
+	return
+}
+
+func sourceOf(tis TemporalIndicatorSource) (src series.IndicatorSource, err error) {
+	if tis == nil {
+		err = errors.New("no source series")
+		return
+	}
+	src = tis.indicatorSource()
+	if src == nil {
+		err = errors.New("source series not initialized")
+	}
+	return
+}
+
+// TemporalIndicatorSeries represents a technical indicator that is calculated from the data of a source series.
+// The indicator is recalculated automatically every time the data of the source series changes.
+type TemporalIndicatorSeries struct {
+	ser *series.IndicatorSeries
+}
+
+// NewTemporalSMASeries creates a simple moving average over the last period values of src
+// An error is returned if src is not initialized or if period is smaller than 1
+func NewTemporalSMASeries(name string, colName fyne.ThemeColorName, src TemporalIndicatorSource,
+	period int) (tis *TemporalIndicatorSeries, err error) {
+	s, err := sourceOf(src)
+	if err != nil {
+		return
+	}
+	ser, err := series.EmptySMASeries(name, colName, s, period)
+	if err != nil {
+		return
+	}
+	tis = &TemporalIndicatorSeries{ser: ser}
+	return
+}
+
+// NewTemporalEMASeries creates an exponential moving average over the last period values of src
+// An error is returned if src is not initialized or if period is smaller than 1
+func NewTemporalEMASeries(name string, colName fyne.ThemeColorName, src TemporalIndicatorSource,
+	period int) (tis *TemporalIndicatorSeries, err error) {
+	s, err := sourceOf(src)
+	if err != nil {
+		return
+	}
+	ser, err := series.EmptyEMASeries(name, colName, s, period)
+	if err != nil {
+		return
+	}
+	tis = &TemporalIndicatorSeries{ser: ser}
+	return
+}
+
+// NewTemporalBollingerBandSeries creates Bollinger bands around the simple moving average over the last period values of src.
+// The upper and lower band are k standard deviations away from the average; the area between them is filled.
+// An error is returned if src is not initialized, if period is smaller than 1 or if k is negative
+func NewTemporalBollingerBandSeries(name string, colName fyne.ThemeColorName, src TemporalIndicatorSource,
+	period int, k float64) (tis *TemporalIndicatorSeries, err error) {
+	s, err := sourceOf(src)
+	if err != nil {
+		return
+	}
+	ser, err := series.EmptyBollingerSeries(name, colName, s, period, k)
+	if err != nil {
+		return
+	}
+	tis = &TemporalIndicatorSeries{ser: ser}
+	return
+}
+
+// NewTemporalVWAPSeries creates the cumulative volume weighted average price of src.
+// The typical price (high+low+close)/3 of each candle is weighted by its volume.
+// An error is returned if src is not initialized
+func NewTemporalVWAPSeries(name string, colName fyne.ThemeColorName,
+	src TemporalIndicatorSource) (tis *TemporalIndicatorSeries, err error) {
+	s, err := sourceOf(src)
+	if err != nil {
+		return
+	}
+	ser, err := series.EmptyVWAPSeries(name, colName, s)
+	if err != nil {
+		return
+	}
+	tis = &TemporalIndicatorSeries{ser: ser}
+	return
+}
+
+// NewTemporalRSISeries creates the relative strength index (0-100) over period values of src using Wilder's smoothing.
+// It is usually displayed in a separate chart created with NewLinkedCartesianTemporalChart.
+// An error is returned if src is not initialized or if period is smaller than 1
+func NewTemporalRSISeries(name string, colName fyne.ThemeColorName, src TemporalIndicatorSource,
+	period int) (tis *TemporalIndicatorSeries, err error) {
+	s, err := sourceOf(src)
+	if err != nil {
+		return
+	}
+	ser, err := series.EmptyRSISeries(name, colName, s, period)
+	if err != nil {
+		return
+	}
+	tis = &TemporalIndicatorSeries{ser: ser}
+	return
+}
+
+// NewTemporalMACDSeries creates the moving average convergence/divergence of src.
+// The MACD line and the histogram are displayed in macdColName, the signal line in signalColName.
+// It is usually displayed in a separate chart created with NewLinkedCartesianTemporalChart.
+// An error is returned if src is not initialized, if a period is smaller than 1 or if fast is not smaller than slow
+func NewTemporalMACDSeries(name string, macdColName fyne.ThemeColorName, signalColName fyne.ThemeColorName,
+	src TemporalIndicatorSource, fast int, slow int, signal int) (tis *TemporalIndicatorSeries, err error) {
+	s, err := sourceOf(src)
+	if err != nil {
+		return
+	}
+	ser, err := series.EmptyMACDSeries(name, macdColName, signalColName, s, fast, slow, signal)
+	if err != nil {
+		return
+	}
+	tis = &TemporalIndicatorSeries{ser: ser}
+	return
+}
+
+// Name returns the name of the series
+func (tis *TemporalIndicatorSeries) Name() (n string) {
+	if tis.ser == nil {
+		return
+	}
+	n = tis.ser.Name()
+	return
+}
+
+// Show makes the elements of the series visible
+func (tis *TemporalIndicatorSeries) Show() {
+	if tis.ser == nil {
+		return
+	}
+	tis.ser.Show()
+}
+
+// Hide makes the elements of the series invisible
+func (tis *TemporalIndicatorSeries) Hide() {
+	if tis.ser == nil {
+		return
+	}
+	tis.ser.Hide()
+}
+
+// SetColor changes the color of the series.
+// The signal line of a MACD series keeps its color.
+func (tis *TemporalIndicatorSeries) SetColor(colName fyne.ThemeColorName) {
+	if tis.ser == nil {
+		return
+	}
+	tis.ser.SetColor(colName)
+}
+
+// SetLineWidth changes the width of the lines
+func (tis *TemporalIndicatorSeries) SetLineWidth(lw float32) {
+	if tis.ser == nil {
+		return
+	}
+	tis.ser.SetLineWidth(lw)
+}
+
+// Data returns a copy of the calculated values of each line of the indicator
+// Bollinger bands give the moving average, the upper and the lower band;
+// MACD gives the MACD line, the signal line and the histogram; all other indicators give one line
+func (tis *TemporalIndicatorSeries) Data() (lines [][]data.TemporalPoint) {
+	if tis.ser == nil {
+		return
+	}
+	lines = seriesData(tis.ser, false, tis.ser.Lines)
+	return
+}
+
+// VisibleData returns a copy of the calculated values of each line like Data within the visible range of the
+// t-axis of the chart
+// Nothing is returned if the series has not been added to a chart
+func (tis *TemporalIndicatorSeries) VisibleData() (lines [][]data.TemporalPoint) {
+	if tis.ser == nil {
+		return
+	}
+	lines = seriesData(tis.ser, true, tis.ser.Lines)
+	return
+}
@@ -1,16 +1,53 @@
 package coord
 
 import (
+	"errors"
+	"math"
 	"time"
 
 	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"github.com/s-daehling/fyne-charts/internal/bind"
 	"github.com/s-daehling/fyne-charts/internal/coord/series"
 
 	"github.com/s-daehling/fyne-charts/pkg/data"
 )
 
+// DownsampleMethod defines how a point series is reduced before drawing if it has more points in the visible range than pixels
+type DownsampleMethod string
+
+const (
+	// DownsampleNone draws all points
+	DownsampleNone DownsampleMethod = DownsampleMethod(series.DownsampleNone)
+	// DownsampleLTTB selects two points per pixel with the largest triangle three buckets algorithm (default)
+	DownsampleLTTB DownsampleMethod = DownsampleMethod(series.DownsampleLTTB)
+	// DownsampleMinMax selects the first, last, minimum and maximum point of each pixel column
+	DownsampleMinMax DownsampleMethod = DownsampleMethod(series.DownsampleMinMax)
+)
+
 type pointSeries struct {
 	ser *series.PointSeries
+	bnd *bind.ListBinding
+}
+
+// lockableSeries is an internal series whose data can be read while it is locked
+type lockableSeries interface {
+	Lock()
+	Unlock()
+	VisibleNRange() (min float64, max float64)
+}
+
+// seriesData reads the data of ser with get while ser is locked
+// If visible is true, only data within the current range of the from-axis of the chart is read
+func seriesData[T any](ser lockableSeries, visible bool, get func(nMin float64, nMax float64) T) (d T) {
+	nMin, nMax := math.Inf(-1), math.Inf(1)
+	if visible {
+		nMin, nMax = ser.VisibleNRange()
+	}
+	ser.Lock()
+	d = get(nMin, nMax)
+	ser.Unlock()
+	return
 }
 
 // Name returns the name of the series
@@ -70,6 +107,51 @@ func (ps *pointSeries) Clear() {
 	ps.ser.Clear()
 }
 
+// SetScroll defines whether the from-axis scrolls along with the newest data point
+// If enabled, the series requests the window of its stream limits ending at the newest point as range.
+// Only effective for stream series with a window and a chart with automatic range
+func (ps *pointSeries) SetScroll(scroll bool) {
+	if ps.ser == nil {
+		return
+	}
+	ps.ser.SetStreamScroll(scroll)
+}
+
+// SetDownsampling defines how the series is reduced if it has more points in the visible range than pixels
+// The points are selected again when the chart is resized or its range changes; tooltips show the raw data.
+// Bar series are never downsampled. Neither are series whose points are not in the order of the from-axis, i.e.
+// categorical series with another order of categories than the axis; they are drawn completely
+func (ps *pointSeries) SetDownsampling(method DownsampleMethod) (err error) {
+	if ps.ser == nil {
+		err = errors.New("series not initialized")
+		return
+	}
+	err = ps.ser.SetDownsampling(series.DownsampleMethod(method))
+	return
+}
+
+// Release stops listening to the bound data list
+// The series is released automatically if it is removed from its chart with RemoveSeries
+func (ps *pointSeries) Release() {
+	if ps.bnd == nil {
+		return
+	}
+	ps.bnd.Unbind()
+	ps.bnd = nil
+}
+
+// bindList populates the series with the items of list and keeps it in sync with the list
+// apply appends vals to the series or, if reset is true, replaces its data; an invalid change must leave the data unchanged
+func (ps *pointSeries) bindList(list binding.UntypedList, convert func(item any) (v any, err error),
+	apply func(vals []any, reset bool) (err error)) (err error) {
+	ps.bnd, err = bind.NewListBinding(list, convert, apply)
+	if err != nil {
+		return
+	}
+	ps.ser.SetReleaseFunc(ps.Release)
+	return
+}
+
 // NumericalPointSeries represents an area series over a numerical x-axis
 type NumericalPointSeries struct {
 	pointSeries
@@ -92,6 +174,93 @@ func NewNumericalPointSeries(name string, colName fyne.ThemeColorName, input []d
 	return
 }
 
+// NewNumericalPointSeriesWithData creates a new NumericalPointSeries that is populated with the items of a data binding list
+// The items must be of type data.NumericalPoint or *data.NumericalPoint.
+// The series is updated every time the list changes. Appended items are added to the series, all other changes replace the data.
+// A change that contains invalid items is ignored.
+// The series stops listening to the list if it is removed from its chart or Release is called
+// An error is returned if the current items of the list are invalid
+func NewNumericalPointSeriesWithData(name string, colName fyne.ThemeColorName, list binding.UntypedList) (nps *NumericalPointSeries, err error) {
+	nps = &NumericalPointSeries{
+		pointSeries: pointSeries{
+			ser: series.EmptyPointSeries(name, colName),
+		},
+	}
+	err = nps.bindList(list, numericalPointItem, func(vals []any, reset bool) (err error) {
+		input := make([]data.NumericalPoint, len(vals))
+		for i := range vals {
+			input[i] = vals[i].(data.NumericalPoint)
+		}
+		if reset {
+			err = nps.ser.ReplaceNumericalData(input)
+			return
+		}
+		err = nps.AddData(input)
+		return
+	})
+	if err != nil {
+		nps = nil
+	}
+	return
+}
+
+func numericalPointItem(item any) (v any, err error) {
+	switch p := item.(type) {
+	case data.NumericalPoint:
+		v = p
+	case *data.NumericalPoint:
+		if p == nil {
+			err = errors.New("invalid list item")
+			return
+		}
+		v = *p
+	default:
+		err = errors.New("invalid list item")
+	}
+	return
+}
+
+// NewNumericalStreamSeries creates a new empty NumericalPointSeries for streaming data
+// The series keeps at most capacity points, and only points whose N is within window of the newest point.
+// Older points are evicted automatically when data is added with StreamData.
+// capacity = 0 and window = 0 disable the respective limit
+// An error is returned if capacity or window is negative
+func NewNumericalStreamSeries(name string, colName fyne.ThemeColorName, capacity int, window float64) (nps *NumericalPointSeries, err error) {
+	nps = &NumericalPointSeries{
+		pointSeries: pointSeries{
+			ser: series.EmptyPointSeries(name, colName),
+		},
+	}
+	err = nps.ser.SetNumericalStream(capacity, window)
+	if err != nil {
+		nps = nil
+	}
+	return
+}
+
+// SetStreamLimits changes the capacity and the window of the series. Points outside of the limits are evicted.
+// capacity = 0 and window = 0 disable the respective limit
+// An error is returned if capacity or window is negative
+func (nps *NumericalPointSeries) SetStreamLimits(capacity int, window float64) (err error) {
+	if nps.ser == nil {
+		return
+	}
+	err = nps.ser.SetNumericalStream(capacity, window)
+	return
+}
+
+// StreamData appends data points to the series and evicts the points outside of the stream limits
+// The points must be in ascending order of N and must not be older than the newest point of the series.
+// Evicted points are reused, so appending does not allocate new chart elements once the series is full.
+// An error is returned if the input data is invalid
+func (nps *NumericalPointSeries) StreamData(input []data.NumericalPoint) (err error) {
+	if nps.ser == nil {
+		return
+	}
+	err = nps.ser.StreamNumericalData(input)
+	return
+}
+
 // DeleteDataInRange deletes all data points with a x-coordinate greater than min and smaller than max
 // The return value gives the number of data points that have been removed
 func (nps *NumericalPointSeries) DeleteDataInRange(min float64, max float64) (c int) {
@@ -102,6 +271,25 @@ func (nps *NumericalPointSeries) DeleteDataInRange(min float64, max float64) (c
 	return
 }
 
+// Data returns a copy of the data points of the series
+func (nps *NumericalPointSeries) Data() (d []data.NumericalPoint) {
+	if nps.ser == nil {
+		return
+	}
+	d = seriesData(nps.ser, false, nps.ser.NumericalData)
+	return
+}
+
+// VisibleData returns a copy of the data points within the visible range of the x-axis of the chart
+// Nothing is returned if the series has not been added to a chart
+func (nps *NumericalPointSeries) VisibleData() (d []data.NumericalPoint) {
+	if nps.ser == nil {
+		return
+	}
+	d = seriesData(nps.ser, true, nps.ser.NumericalData)
+	return
+}
+
 // AddData adds data points to the series.
 // If the series has been added to a polar chart only points with Val >= 0 are allowed
 // In a polar chart only points with 0 <= N <= 2pi are displayed
@@ -149,6 +337,93 @@ func NewTemporalPointSeries(name string, colName fyne.ThemeColorName, input []da
 	return
 }
 
+// NewTemporalPointSeriesWithData creates a new TemporalPointSeries that is populated with the items of a data binding list
+// The items must be of type data.TemporalPoint or *data.TemporalPoint.
+// The series is updated every time the list changes. Appended items are added to the series, all other changes replace the data.
+// A change that contains invalid items is ignored.
+// The series stops listening to the list if it is removed from its chart or Release is called
+// An error is returned if the current items of the list are invalid
+func NewTemporalPointSeriesWithData(name string, colName fyne.ThemeColorName, list binding.UntypedList) (tps *TemporalPointSeries, err error) {
+	tps = &TemporalPointSeries{
+		pointSeries: pointSeries{
+			ser: series.EmptyPointSeries(name, colName),
+		},
+	}
+	err = tps.bindList(list, temporalPointItem, func(vals []any, reset bool) (err error) {
+		input := make([]data.TemporalPoint, len(vals))
+		for i := range vals {
+			input[i] = vals[i].(data.TemporalPoint)
+		}
+		if reset {
+			err = tps.ser.ReplaceTemporalData(input)
+			return
+		}
+		err = tps.AddData(input)
+		return
+	})
+	if err != nil {
+		tps = nil
+	}
+	return
+}
+
+func temporalPointItem(item any) (v any, err error) {
+	switch p := item.(type) {
+	case data.TemporalPoint:
+		v = p
+	case *data.TemporalPoint:
+		if p == nil {
+			err = errors.New("invalid list item")
+			return
+		}
+		v = *p
+	default:
+		err = errors.New("invalid list item")
+	}
+	return
+}
+
+// NewTemporalStreamSeries creates a new empty TemporalPointSeries for streaming data
+// The series keeps at most capacity points, and only points whose T is within window of the newest point.
+// Older points are evicted automatically when data is added with StreamData.
+// capacity = 0 and window = 0 disable the respective limit
+// An error is returned if capacity or window is negative
+func NewTemporalStreamSeries(name string, colName fyne.ThemeColorName, capacity int, window time.Duration) (tps *TemporalPointSeries, err error) {
+	tps = &TemporalPointSeries{
+		pointSeries: pointSeries{
+			ser: series.EmptyPointSeries(name, colName),
+		},
+	}
+	err = tps.ser.SetTemporalStream(capacity, window)
+	if err != nil {
+		tps = nil
+	}
+	return
+}
+
+// SetStreamLimits changes the capacity and the window of the series. Points outside of the limits are evicted.
+// capacity = 0 and window = 0 disable the respective limit
+// An error is returned if capacity or window is negative
+func (tps *TemporalPointSeries) SetStreamLimits(capacity int, window time.Duration) (err error) {
+	if tps.ser == nil {
+		return
+	}
+	err = tps.ser.SetTemporalStream(capacity, window)
+	return
+}
+
+// StreamData appends data points to the series and evicts the points outside of the stream limits
+// The points must be in chronological order and must not be older than the newest point of the series.
+// Evicted points are reused, so appending does not allocate new chart elements once the series is full.
+// An error is returned if the input data is invalid
+func (tps *TemporalPointSeries) StreamData(input []data.TemporalPoint) (err error) {
+	if tps.ser == nil {
+		return
+	}
+	err = tps.ser.StreamTemporalData(input)
+	return
+}
+
 // DeleteDataInRange deletes all data points with a t-coordinate after min and before max.
 // The return value gives the number of data points that have been removed
 func (tps *TemporalPointSeries) DeleteDataInRange(min time.Time, max time.Time) (c int) {
@@ -159,6 +434,25 @@ func (tps *TemporalPointSeries) DeleteDataInRange(min time.Time, max time.Time)
 	return
 }
 
+// Data returns a copy of the data points of the series
+func (tps *TemporalPointSeries) Data() (d []data.TemporalPoint) {
+	if tps.ser == nil {
+		return
+	}
+	d = seriesData(tps.ser, false, tps.ser.TemporalData)
+	return
+}
+
+// VisibleData returns a copy of the data points within the visible range of the t-axis of the chart
+// Nothing is returned if the series has not been added to a chart
+func (tps *TemporalPointSeries) VisibleData() (d []data.TemporalPoint) {
+	if tps.ser == nil {
+		return
+	}
+	d = seriesData(tps.ser, true, tps.ser.TemporalData)
+	return
+}
+
 // AddData adds data points to the series.
 // If the series has been added to a polar chart only points with Val >= 0 are allowed
 // An error is returned if the input data is invalid
@@ -206,6 +500,52 @@ func NewCategoricalPointSeries(name string, colName fyne.ThemeColorName, input [
 	return
 }
 
+// NewCategoricalPointSeriesWithData creates a new CategoricalPointSeries that is populated with the items of a data binding list
+// The items must be of type data.CategoricalPoint or *data.CategoricalPoint. If multiple items with the same C exist only the first is added to the series
+// The series is updated every time the list changes. Appended items are added to the series, all other changes replace the data.
+// A change that contains invalid items is ignored.
+// The series stops listening to the list if it is removed from its chart or Release is called
+// An error is returned if the current items of the list are invalid
+func NewCategoricalPointSeriesWithData(name string, colName fyne.ThemeColorName, list binding.UntypedList) (cps *CategoricalPointSeries, err error) {
+	cps = &CategoricalPointSeries{
+		pointSeries: pointSeries{
+			ser: series.EmptyPointSeries(name, colName),
+		},
+	}
+	err = cps.bindList(list, categoricalPointItem, func(vals []any, reset bool) (err error) {
+		input := make([]data.CategoricalPoint, len(vals))
+		for i := range vals {
+			input[i] = vals[i].(data.CategoricalPoint)
+		}
+		if reset {
+			err = cps.ser.ReplaceCategoricalData(input)
+			return
+		}
+		err = cps.AddData(input)
+		return
+	})
+	if err != nil {
+		cps = nil
+	}
+	return
+}
+
+func categoricalPointItem(item any) (v any, err error) {
+	switch p := item.(type) {
+	case data.CategoricalPoint:
+		v = p
+	case *data.CategoricalPoint:
+		if p == nil {
+			err = errors.New("invalid list item")
+			return
+		}
+		v = *p
+	default:
+		err = errors.New("invalid list item")
+	}
+	return
+}
+
 // DeleteDataInRange deletes all data points with one of the given category
 // The return value gives the number of data points that have been removed
 func (cps *CategoricalPointSeries) DeleteDataInRange(cat []string) (c int) {
@@ -216,6 +556,25 @@ func (cps *CategoricalPointSeries) DeleteDataInRange(cat []string) (c int) {
 	return
 }
 
+// Data returns a copy of the data points of the series
+func (cps *CategoricalPointSeries) Data() (d []data.CategoricalPoint) {
+	if cps.ser == nil {
+		return
+	}
+	d = seriesData(cps.ser, false, cps.ser.CategoricalData)
+	return
+}
+
+// VisibleData returns a copy of the data points within the visible range of the c-axis of the chart
+// Nothing is returned if the series has not been added to a chart
+func (cps *CategoricalPointSeries) VisibleData() (d []data.CategoricalPoint) {
+	if cps.ser == nil {
+		return
+	}
+	d = seriesData(cps.ser, true, cps.ser.CategoricalData)
+	return
+}
+
 // AddData adds data points to the series.
 // The method checks for duplicates (i.e. data points with same C).
 // If multiple entries with the same C exist only the first is added to the series
@@ -0,0 +1,123 @@
+package coord
+
+import (
+	"fyne.io/fyne/v2"
+	"github.com/s-daehling/fyne-charts/internal/coord/series"
+
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+// RadarSeries represents a closed line or area over the categories of a radar chart
+type RadarSeries struct {
+	ser *series.RadarSeries
+}
+
+// NewRadarSeries creates a new RadarSeries and populates it with input data
+// An error is returned if the input data is invalid
+func NewRadarSeries(name string, colName fyne.ThemeColorName, input []data.CategoricalPoint) (rs *RadarSeries, err error) {
+	rs = &RadarSeries{
+		ser: series.EmptyRadarSeries(name, colName),
+	}
+	err = rs.AddData(input)
+	if err != nil {
+		rs = nil
+	}
+	return
+}
+
+// Name returns the name of the series
+func (rs *RadarSeries) Name() (n string) {
+	if rs.ser == nil {
+		return
+	}
+	n = rs.ser.Name()
+	return
+}
+
+// Show makes the elements of the series visible
+func (rs *RadarSeries) Show() {
+	if rs.ser == nil {
+		return
+	}
+	rs.ser.Show()
+}
+
+// Hide makes the elements of the series invisible
+func (rs *RadarSeries) Hide() {
+	if rs.ser == nil {
+		return
+	}
+	rs.ser.Hide()
+}
+
+// SetColor changes the color of series elements
+func (rs *RadarSeries) SetColor(colName fyne.ThemeColorName) {
+	if rs.ser == nil {
+		return
+	}
+	rs.ser.SetColor(colName)
+}
+
+// SetLineWidth sets the width of the line
+func (rs *RadarSeries) SetLineWidth(lw float32) {
+	if rs.ser == nil {
+		return
+	}
+	rs.ser.SetLineWidth(lw)
+}
+
+// SetDotSize sets the size of the dots at series data points
+func (rs *RadarSeries) SetDotSize(ds float32) {
+	if rs.ser == nil {
+		return
+	}
+	rs.ser.SetDotSize(ds)
+}
+
+// Clear deletes all data
+func (rs *RadarSeries) Clear() {
+	if rs.ser == nil {
+		return
+	}
+	rs.ser.Clear()
+}
+
+// DeleteDataInRange deletes all data points with one of the given category
+// The return value gives the number of data points that have been removed
+func (rs *RadarSeries) DeleteDataInRange(cat []string) (c int) {
+	if rs.ser == nil {
+		return
+	}
+	c = rs.ser.DeleteDataInRange(cat)
+	return
+}
+
+// Data returns a copy of the data points of the series
+func (rs *RadarSeries) Data() (d []data.CategoricalPoint) {
+	if rs.ser == nil {
+		return
+	}
+	d = seriesData(rs.ser, false, rs.ser.Data)
+	return
+}
+
+// VisibleData returns a copy of the data points within the visible range of the c-axis of the chart
+// Nothing is returned if the series has not been added to a chart
+func (rs *RadarSeries) VisibleData() (d []data.CategoricalPoint) {
+	if rs.ser == nil {
+		return
+	}
+	d = seriesData(rs.ser, true, rs.ser.Data)
+	return
+}
+
+// AddData adds data points to the series.
+// Data points with a category that already exists are ignored.
+// An error is returned if Val is NaN or infinite for one or more points
+func (rs *RadarSeries) AddData(input []data.CategoricalPoint) (err error) {
+	if rs.ser == nil {
+		return
+	}
+	err = rs.ser.AddData(input)
+	return
+}
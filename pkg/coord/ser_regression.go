@@ -0,0 +1,207 @@
+package coord
+
+import (
+	"errors"
+
+	"fyne.io/fyne/v2"
+	"github.com/s-daehling/fyne-charts/internal/coord/series"
+
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+// RegressionType defines the kind of curve that is fitted to the data of a point series
+type RegressionType string
+
+const (
+	// RegressionLinear fits y = a + b*x
+	RegressionLinear RegressionType = RegressionType(series.RegressionLinear)
+	// RegressionPolynomial fits a polynomial of the given degree
+	RegressionPolynomial RegressionType = RegressionType(series.RegressionPolynomial)
+	// RegressionExponential fits y = a*e^(b*x); points with y <= 0 are ignored
+	RegressionExponential RegressionType = RegressionType(series.RegressionExponential)
+	// RegressionLogarithmic fits y = a + b*ln(x); points with x <= 0 are ignored
+	RegressionLogarithmic RegressionType = RegressionType(series.RegressionLogarithmic)
+	// RegressionPower fits y = a*x^b; points with x <= 0 or y <= 0 are ignored
+	RegressionPower RegressionType = RegressionType(series.RegressionPower)
+	// RegressionLOESS fits a locally weighted linear regression
+	RegressionLOESS RegressionType = RegressionType(series.RegressionLOESS)
+)
+
+// RegressionModel defines the curve that is fitted to the data of a point series
+type RegressionModel struct {
+	Type RegressionType
+	// Degree of a polynomial regression (>= 1)
+	Degree int
+	// Span is the fraction of points used for each local fit of a LOESS regression (0 < Span <= 1)
+	Span float64
+}
+
+type regressionSeries struct {
+	ser *series.RegressionSeries
+}
+
+// Name returns the name of the series
+func (rs *regressionSeries) Name() (n string) {
+	if rs.ser == nil {
+		return
+	}
+	n = rs.ser.Name()
+	return
+}
+
+// Show makes the elements of the series visible
+func (rs *regressionSeries) Show() {
+	if rs.ser == nil {
+		return
+	}
+	rs.ser.Show()
+}
+
+// Hide makes the elements of the series invisible
+func (rs *regressionSeries) Hide() {
+	if rs.ser == nil {
+		return
+	}
+	rs.ser.Hide()
+}
+
+// SetColor changes the color of the curve and the annotation
+func (rs *regressionSeries) SetColor(colName fyne.ThemeColorName) {
+	if rs.ser == nil {
+		return
+	}
+	rs.ser.SetColor(colName)
+}
+
+// SetLineWidth changes the width of the curve
+func (rs *regressionSeries) SetLineWidth(lw float32) {
+	if rs.ser == nil {
+		return
+	}
+	rs.ser.SetLineWidth(lw)
+}
+
+// ShowAnnotation displays the equation and R² next to the curve
+func (rs *regressionSeries) ShowAnnotation() {
+	if rs.ser == nil {
+		return
+	}
+	rs.ser.SetAnnotationVisibility(true)
+}
+
+// HideAnnotation hides the equation and R²
+func (rs *regressionSeries) HideAnnotation() {
+	if rs.ser == nil {
+		return
+	}
+	rs.ser.SetAnnotationVisibility(false)
+}
+
+// Equation returns the fitted equation.
+// It is empty if the source series does not contain enough points for the fit.
+func (rs *regressionSeries) Equation() (eq string) {
+	if rs.ser == nil {
+		return
+	}
+	eq = rs.ser.Equation()
+	return
+}
+
+// RSquared returns the coefficient of determination of the fitted curve
+func (rs *regressionSeries) RSquared() (r2 float64) {
+	if rs.ser == nil {
+		return
+	}
+	r2 = rs.ser.RSquared()
+	return
+}
+
+// NumericalRegressionSeries represents a curve fitted to the data of a NumericalPointSeries.
+// The curve is refitted automatically every time the data of the source series changes.
+type NumericalRegressionSeries struct {
+	regressionSeries
+}
+
+// NewNumericalRegressionSeries creates a new NumericalRegressionSeries fitted to the data of src
+// An error is returned if src is not initialized or if the model is invalid
+func NewNumericalRegressionSeries(name string, colName fyne.ThemeColorName, src *NumericalPointSeries,
+	model RegressionModel) (nrs *NumericalRegressionSeries, err error) {
+	if src == nil || src.ser == nil {
+		err = errors.New("source series not initialized")
+		return
+	}
+	ser, err := series.EmptyRegressionSeries(name, colName, src.ser, false,
+		series.RegressionType(model.Type), model.Degree, model.Span)
+	if err != nil {
+		return
+	}
+	nrs = &NumericalRegressionSeries{
+		regressionSeries: regressionSeries{ser: ser},
+	}
+	return
+}
+
+// Data returns a copy of the points at which the fitted curve is evaluated
+func (nrs *NumericalRegressionSeries) Data() (d []data.NumericalPoint) {
+	if nrs.ser == nil {
+		return
+	}
+	d = seriesData(nrs.ser, false, nrs.ser.NumericalCurve)
+	return
+}
+
+// VisibleData returns a copy of the points of the fitted curve within the visible range of the x-axis of the chart
+// Nothing is returned if the series has not been added to a chart
+func (nrs *NumericalRegressionSeries) VisibleData() (d []data.NumericalPoint) {
+	if nrs.ser == nil {
+		return
+	}
+	d = seriesData(nrs.ser, true, nrs.ser.NumericalCurve)
+	return
+}
+
+// TemporalRegressionSeries represents a curve fitted to the data of a TemporalPointSeries.
+// For the fit x is measured in seconds since the first point of the source series.
+// Logarithmic and power regressions are not supported.
+// The curve is refitted automatically every time the data of the source series changes.
+type TemporalRegressionSeries struct {
+	regressionSeries
+}
+
+// NewTemporalRegressionSeries creates a new TemporalRegressionSeries fitted to the data of src
+// An error is returned if src is not initialized or if the model is invalid
+func NewTemporalRegressionSeries(name string, colName fyne.ThemeColorName, src *TemporalPointSeries,
+	model RegressionModel) (trs *TemporalRegressionSeries, err error) {
+	if src == nil || src.ser == nil {
+		err = errors.New("source series not initialized")
+		return
+	}
+	ser, err := series.EmptyRegressionSeries(name, colName, src.ser, true,
+		series.RegressionType(model.Type), model.Degree, model.Span)
+	if err != nil {
+		return
+	}
+	trs = &TemporalRegressionSeries{
+		regressionSeries: regressionSeries{ser: ser},
+	}
+	return
+}
+
+// Data returns a copy of the points at which the fitted curve is evaluated
+func (trs *TemporalRegressionSeries) Data() (d []data.TemporalPoint) {
+	if trs.ser == nil {
+		return
+	}
+	d = seriesData(trs.ser, false, trs.ser.TemporalCurve)
+	return
+}
+
+// VisibleData returns a copy of the points of the fitted curve within the visible range of the t-axis of the chart
+// Nothing is returned if the series has not been added to a chart
+func (trs *TemporalRegressionSeries) VisibleData() (d []data.TemporalPoint) {
+	if trs.ser == nil {
+		return
+	}
+	d = seriesData(trs.ser, true, trs.ser.TemporalCurve)
+	return
+}
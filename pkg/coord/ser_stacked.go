@@ -2,6 +2,8 @@ package coord
 
 import (
 	"github.com/s-daehling/fyne-charts/internal/coord/series"
+
+	"github.com/s-daehling/fyne-charts/pkg/data"
 )
 
 type stackedSeries struct {
@@ -42,6 +44,7 @@ func (ss *stackedSeries) Clear() {
 }
 
 // CategoricalStackedSeries represents a stacked bar series over a categorical c-axis
+// Each series in the stack is a bar series whose bars own canvas objects only within the visible range
 type CategoricalStackedSeries struct {
 	stackedSeries
 }
@@ -75,6 +78,26 @@ func (css *CategoricalStackedSeries) DeleteDataInRange(cat []string) (c int) {
 	return
 }
 
+// Data returns a copy of the data points of each series in the stack by the name of the series
+func (css *CategoricalStackedSeries) Data() (d map[string][]data.CategoricalPoint) {
+	if css.ser == nil {
+		return
+	}
+	d = seriesData(css.ser, false, css.ser.CategoricalData)
+	return
+}
+
+// VisibleData returns a copy of the data points of each series in the stack within the visible range of the
+// c-axis of the chart
+// No data points are returned if the series has not been added to a chart
+func (css *CategoricalStackedSeries) VisibleData() (d map[string][]data.CategoricalPoint) {
+	if css.ser == nil {
+		return
+	}
+	d = seriesData(css.ser, true, css.ser.CategoricalData)
+	return
+}
+
 // RemoveSeries removes the series with the given name from the stacked series
 func (css *CategoricalStackedSeries) RemoveSeries(name string) {
 	if css.ser == nil {
@@ -0,0 +1,316 @@
+package coord
+
+import (
+	"fyne.io/fyne/v2"
+	"github.com/s-daehling/fyne-charts/internal/coord/series"
+
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+type violinSeries struct {
+	ser *series.ViolinSeries
+}
+
+// Name returns the name of the series
+func (vs *violinSeries) Name() (n string) {
+	if vs.ser == nil {
+		return
+	}
+	n = vs.ser.Name()
+	return
+}
+
+// Show makes the elements of the series visible
+func (vs *violinSeries) Show() {
+	if vs.ser == nil {
+		return
+	}
+	vs.ser.Show()
+}
+
+// Hide makes the elements of the series invisible
+func (vs *violinSeries) Hide() {
+	if vs.ser == nil {
+		return
+	}
+	vs.ser.Hide()
+}
+
+// SetColor changes the color of series elements
+func (vs *violinSeries) SetColor(colName fyne.ThemeColorName) {
+	if vs.ser == nil {
+		return
+	}
+	vs.ser.SetColor(colName)
+}
+
+// SetLineWidth sets the width of the outline
+func (vs *violinSeries) SetLineWidth(lw float32) {
+	if vs.ser == nil {
+		return
+	}
+	vs.ser.SetLineWidth(lw)
+}
+
+// SetBandwidth sets the bandwidth of the gaussian kernel used for the density estimate.
+// A bandwidth of 0 (default) selects the bandwidth automatically using Silverman's rule of thumb.
+// An error is returned if the bandwidth is negative
+func (vs *violinSeries) SetBandwidth(bw float64) (err error) {
+	if vs.ser == nil {
+		return
+	}
+	err = vs.ser.SetBandwidth(bw)
+	return
+}
+
+// ShowBox displays a mini box with quartiles and median inside each violin (default)
+func (vs *violinSeries) ShowBox() {
+	if vs.ser == nil {
+		return
+	}
+	vs.ser.SetBoxVisibility(true)
+}
+
+// HideBox hides the mini box inside each violin
+func (vs *violinSeries) HideBox() {
+	if vs.ser == nil {
+		return
+	}
+	vs.ser.SetBoxVisibility(false)
+}
+
+// Clear deletes all data
+func (vs *violinSeries) Clear() {
+	if vs.ser == nil {
+		return
+	}
+	vs.ser.Clear()
+}
+
+// NumericalViolinSeries represents a violin series over a numerical x-axis
+// The canvas objects of all violins are created when they are added, also for violins outside the visible range
+type NumericalViolinSeries struct {
+	violinSeries
+}
+
+// NewNumericalViolinSeries creates a new NumericalViolinSeries and populates it with input data
+// An error is returned if the input data is invalid
+func NewNumericalViolinSeries(name string, colName fyne.ThemeColorName, input []data.NumericalSamples) (nvs *NumericalViolinSeries, err error) {
+	nvs = &NumericalViolinSeries{
+		violinSeries: violinSeries{
+			ser: series.EmptyViolinSeries(name, colName),
+		},
+	}
+	err = nvs.AddData(input)
+	if err != nil {
+		nvs = nil
+	}
+	return
+}
+
+// DeleteDataInRange deletes all violins with a x-coordinate greater than min and smaller than max
+// The return value gives the number of violins that have been removed
+func (nvs *NumericalViolinSeries) DeleteDataInRange(min float64, max float64) (c int) {
+	if nvs.ser == nil {
+		return
+	}
+	c = nvs.ser.DeleteNumericalDataInRange(min, max)
+	return
+}
+
+// Data returns a copy of the samples of the violins of the series
+func (nvs *NumericalViolinSeries) Data() (d []data.NumericalSamples) {
+	if nvs.ser == nil {
+		return
+	}
+	d = seriesData(nvs.ser, false, nvs.ser.NumericalData)
+	return
+}
+
+// VisibleData returns a copy of the samples of the violins within the visible range of the x-axis of the chart
+// Nothing is returned if the series has not been added to a chart
+func (nvs *NumericalViolinSeries) VisibleData() (d []data.NumericalSamples) {
+	if nvs.ser == nil {
+		return
+	}
+	d = seriesData(nvs.ser, true, nvs.ser.NumericalData)
+	return
+}
+
+// AddData adds violins to the series.
+// An error is returned if the input data is invalid (no samples or samples that are NaN or infinite)
+func (nvs *NumericalViolinSeries) AddData(input []data.NumericalSamples) (err error) {
+	if nvs.ser == nil {
+		return
+	}
+	err = nvs.ser.AddNumericalData(input)
+	return
+}
+
+// CategoricalViolinSeries represents a violin series over a categorical c-axis
+// The canvas objects of all violins are created when they are added, also for violins outside the visible range
+type CategoricalViolinSeries struct {
+	violinSeries
+}
+
+// NewCategoricalViolinSeries creates a new CategoricalViolinSeries and populates it with input data
+// An error is returned if the input data is invalid
+func NewCategoricalViolinSeries(name string, colName fyne.ThemeColorName, input []data.CategoricalSamples) (cvs *CategoricalViolinSeries, err error) {
+	cvs = &CategoricalViolinSeries{
+		violinSeries: violinSeries{
+			ser: series.EmptyViolinSeries(name, colName),
+		},
+	}
+	err = cvs.AddData(input)
+	if err != nil {
+		cvs = nil
+	}
+	return
+}
+
+// DeleteDataInRange deletes all violins with one of the given category
+// The return value gives the number of violins that have been removed
+func (cvs *CategoricalViolinSeries) DeleteDataInRange(cat []string) (c int) {
+	if cvs.ser == nil {
+		return
+	}
+	c = cvs.ser.DeleteCategoricalDataInRange(cat)
+	return
+}
+
+// Data returns a copy of the samples of the violins of the series
+func (cvs *CategoricalViolinSeries) Data() (d []data.CategoricalSamples) {
+	if cvs.ser == nil {
+		return
+	}
+	d = seriesData(cvs.ser, false, cvs.ser.CategoricalData)
+	return
+}
+
+// VisibleData returns a copy of the samples of the violins within the visible range of the c-axis of the chart
+// Nothing is returned if the series has not been added to a chart
+func (cvs *CategoricalViolinSeries) VisibleData() (d []data.CategoricalSamples) {
+	if cvs.ser == nil {
+		return
+	}
+	d = seriesData(cvs.ser, true, cvs.ser.CategoricalData)
+	return
+}
+
+// AddData adds violins to the series.
+// Violins with a category that already exists are ignored.
+// An error is returned if the input data is invalid (no samples or samples that are NaN or infinite)
+func (cvs *CategoricalViolinSeries) AddData(input []data.CategoricalSamples) (err error) {
+	if cvs.ser == nil {
+		return
+	}
+	err = cvs.ser.AddCategoricalData(input)
+	return
+}
+
+// NumericalKDESeries represents the kernel density estimate of a set of samples as line over a numerical x-axis
+type NumericalKDESeries struct {
+	ser *series.KDESeries
+}
+
+// NewNumericalKDESeries creates a new NumericalKDESeries and populates it with samples
+// An error is returned if the samples are invalid
+func NewNumericalKDESeries(name string, colName fyne.ThemeColorName, samples []float64) (nks *NumericalKDESeries, err error) {
+	nks = &NumericalKDESeries{
+		ser: series.EmptyKDESeries(name, colName),
+	}
+	err = nks.AddData(samples)
+	if err != nil {
+		nks = nil
+	}
+	return
+}
+
+// Name returns the name of the series
+func (nks *NumericalKDESeries) Name() (n string) {
+	if nks.ser == nil {
+		return
+	}
+	n = nks.ser.Name()
+	return
+}
+
+// Show makes the elements of the series visible
+func (nks *NumericalKDESeries) Show() {
+	if nks.ser == nil {
+		return
+	}
+	nks.ser.Show()
+}
+
+// Hide makes the elements of the series invisible
+func (nks *NumericalKDESeries) Hide() {
+	if nks.ser == nil {
+		return
+	}
+	nks.ser.Hide()
+}
+
+// SetColor changes the color of the line
+func (nks *NumericalKDESeries) SetColor(colName fyne.ThemeColorName) {
+	if nks.ser == nil {
+		return
+	}
+	nks.ser.SetColor(colName)
+}
+
+// SetLineWidth sets the width of the line
+func (nks *NumericalKDESeries) SetLineWidth(lw float32) {
+	if nks.ser == nil {
+		return
+	}
+	nks.ser.SetLineWidth(lw)
+}
+
+// SetBandwidth sets the bandwidth of the gaussian kernel.
+// A bandwidth of 0 (default) selects the bandwidth automatically using Silverman's rule of thumb.
+// An error is returned if the bandwidth is negative
+func (nks *NumericalKDESeries) SetBandwidth(bw float64) (err error) {
+	if nks.ser == nil {
+		return
+	}
+	err = nks.ser.SetBandwidth(bw)
+	return
+}
+
+// AddData adds samples to the series
+// An error is returned if a sample is NaN or infinite
+func (nks *NumericalKDESeries) AddData(samples []float64) (err error) {
+	if nks.ser == nil {
+		return
+	}
+	err = nks.ser.AddData(samples)
+	return
+}
+
+// Data returns a copy of the samples of the series
+func (nks *NumericalKDESeries) Data() (d []float64) {
+	if nks.ser == nil {
+		return
+	}
+	d = seriesData(nks.ser, false, nks.ser.Data)
+	return
+}
+
+// VisibleData returns a copy of the samples within the visible range of the x-axis of the chart
+// Nothing is returned if the series has not been added to a chart
+func (nks *NumericalKDESeries) VisibleData() (d []float64) {
+	if nks.ser == nil {
+		return
+	}
+	d = seriesData(nks.ser, true, nks.ser.Data)
+	return
+}
+
+// Clear deletes all samples
+func (nks *NumericalKDESeries) Clear() {
+	if nks.ser == nil {
+		return
+	}
+	nks.ser.Clear()
+}
@@ -17,6 +17,13 @@ type CategoricalBox struct {
 	Outlier       []float64
 }
 
+// CategoricalSamples represents a set of raw samples at a categorical coordinate
+// It is used by violin series to estimate the distribution of the samples
+type CategoricalSamples struct {
+	C       string
+	Samples []float64
+}
+
 // CategoricalTick represents one tick on a categorical axis
 type CategoricalTick struct {
 	C           string
@@ -0,0 +1,198 @@
+package io
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ReadCSV reads the rows of a CSV document from r, e.g. ReadCSV[data.NumericalPoint](r, DefaultOptions())
+// An error of type *ParseError is returned for invalid rows
+func ReadCSV[T Record](r io.Reader, opts Options) (rs []T, err error) {
+	opts = opts.withDefaults()
+	err = checkMapping[T](opts)
+	if err != nil {
+		return
+	}
+	cr := csv.NewReader(r)
+	cr.Comma = opts.Delimiter
+	cr.Comment = opts.Comment
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+
+	var cols []int
+	var names []string
+	if opts.Header {
+		var header []string
+		header, err = cr.Read()
+		if err == io.EOF {
+			err = nil
+			return
+		}
+		if err != nil {
+			err = csvError(err)
+			return
+		}
+		cols, names, err = csvColumns[T](opts, header)
+		if err != nil {
+			line, _ := cr.FieldPos(0)
+			err = &ParseError{Row: line, Err: err}
+			return
+		}
+	} else {
+		cols, names = csvIndices[T](opts)
+	}
+
+	fs := recordFields[T]()
+	for {
+		var row []string
+		row, err = cr.Read()
+		if err == io.EOF {
+			err = nil
+			return
+		}
+		if err != nil {
+			err = csvError(err)
+			return
+		}
+		var rec T
+		v := reflect.ValueOf(&rec).Elem()
+		for i, f := range fs {
+			if cols[i] < 0 {
+				continue
+			}
+			line, _ := cr.FieldPos(0)
+			if cols[i] >= len(row) {
+				if f.optional {
+					continue
+				}
+				err = &ParseError{Row: line, Column: names[i], Err: errors.New("missing column")}
+				return
+			}
+			err = opts.setText(v, f, row[cols[i]])
+			if err != nil {
+				err = &ParseError{Row: line, Column: names[i], Err: err}
+				return
+			}
+		}
+		rs = append(rs, rec)
+	}
+}
+
+// ReadCSVFile reads the rows of the CSV file at path like ReadCSV
+func ReadCSVFile[T Record](path string, opts Options) (rs []T, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	rs, err = ReadCSV[T](f, opts)
+	return
+}
+
+// WriteCSV writes rs as CSV document to w, e.g. WriteCSV(w, ser.Data(), DefaultOptions())
+// The columns are written in the order of the fields of the data type and named like the fields or as mapped by
+// Columns, so that the document can be read again by ReadCSV with the same options
+// Comment and Indices of opts are not used
+func WriteCSV[T Record](w io.Writer, rs []T, opts Options) (err error) {
+	opts = opts.withDefaults()
+	err = checkMapping[T](opts)
+	if err != nil {
+		return
+	}
+	cw := csv.NewWriter(w)
+	cw.Comma = opts.Delimiter
+	fs := recordFields[T]()
+	row := make([]string, len(fs))
+	if opts.Header {
+		for i, f := range fs {
+			row[i] = opts.columnName(f.name)
+		}
+		err = cw.Write(row)
+		if err != nil {
+			return
+		}
+	}
+	for i := range rs {
+		v := reflect.ValueOf(rs[i])
+		for j, f := range fs {
+			row[j] = opts.text(v, f)
+		}
+		err = cw.Write(row)
+		if err != nil {
+			return
+		}
+	}
+	cw.Flush()
+	err = cw.Error()
+	return
+}
+
+// WriteCSVFile creates or truncates the file at path and writes rs to it like WriteCSV
+func WriteCSVFile[T Record](path string, rs []T, opts Options) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return
+	}
+	err = WriteCSV(f, rs, opts)
+	if cErr := f.Close(); err == nil {
+		err = cErr
+	}
+	return
+}
+
+// csvColumns gives the column index and the column description of each field, the index is -1 if an optional
+// column is missing in header
+func csvColumns[T Record](opts Options, header []string) (cols []int, names []string, err error) {
+	for _, f := range recordFields[T]() {
+		if i, ok := opts.Indices[f.name]; ok {
+			cols = append(cols, i)
+			names = append(names, strconv.Itoa(i+1))
+			continue
+		}
+		name := opts.columnName(f.name)
+		col := -1
+		for i := range header {
+			if strings.EqualFold(strings.TrimSpace(header[i]), name) {
+				col = i
+				name = strings.TrimSpace(header[i])
+				break
+			}
+		}
+		if col < 0 && !f.optional {
+			err = fmt.Errorf("missing column %s", name)
+			return
+		}
+		cols = append(cols, col)
+		names = append(names, name)
+	}
+	return
+}
+
+// csvIndices gives the column index and the column number of each field of a document without header
+func csvIndices[T Record](opts Options) (cols []int, names []string) {
+	for i, f := range recordFields[T]() {
+		col := i
+		if c, ok := opts.Indices[f.name]; ok {
+			col = c
+		}
+		cols = append(cols, col)
+		names = append(names, strconv.Itoa(col+1))
+	}
+	return
+}
+
+// csvError converts syntax errors of the CSV reader to a ParseError
+func csvError(err error) (pErr error) {
+	pErr = err
+	var cErr *csv.ParseError
+	if errors.As(err, &cErr) {
+		pErr = &ParseError{Row: cErr.Line, Err: cErr.Err}
+	}
+	return
+}
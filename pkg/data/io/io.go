@@ -0,0 +1,255 @@
+package io
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+// LayoutUnix is a time layout for times given as seconds since January 1, 1970 UTC
+const LayoutUnix = "unix"
+
+// Record is a data type that can be read from and written to CSV and JSON documents
+type Record interface {
+	data.NumericalPoint | data.TemporalPoint | data.CategoricalPoint |
+		data.NumericalCandleStick | data.TemporalCandleStick |
+		data.NumericalBox | data.TemporalBox | data.CategoricalBox |
+		data.NumericalSamples | data.CategoricalSamples |
+		data.GanttTask | data.GanttMilestone | data.GanttDependency |
+		data.ProportionalPoint
+}
+
+// Options defines how CSV and JSON documents are read and written
+// Fields are named like the fields of the data types, e.g. N, T, Val or Outlier
+type Options struct {
+	Delimiter        rune              // field delimiter of CSV documents
+	Comment          rune              // lines of CSV documents starting with this character are skipped; 0 disables comments
+	Header           bool              // the first row of CSV documents contains the column names
+	Columns          map[string]string // column names of CSV documents with header or keys in JSON documents by field
+	Indices          map[string]int    // zero-based column indices of CSV documents by field; precede Columns
+	TimeLayout       string            // layout of times as defined by the time package or LayoutUnix
+	Location         *time.Location    // location of times without time zone
+	OutlierSeparator string            // separator of the outliers of boxes or the samples of violins in one CSV field
+}
+
+// DefaultOptions gives options for comma separated documents with header and RFC 3339 times
+// Without mapping, columns and keys are matched with the field names, ignoring case
+// Without header, the columns are expected in the order of the fields of the data type
+func DefaultOptions() (opts Options) {
+	opts = Options{
+		Delimiter:        ',',
+		Header:           true,
+		TimeLayout:       time.RFC3339,
+		Location:         time.UTC,
+		OutlierSeparator: ";",
+	}
+	return
+}
+
+// ParseError reports an invalid row of a document
+type ParseError struct {
+	Row    int    // line of the CSV document or position of the element in the JSON array, starting at 1
+	Column string // name or index of the CSV column or key of the JSON element
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("row %d: %s", e.Row, e.Err)
+	}
+	return fmt.Sprintf("row %d, column %s: %s", e.Row, e.Column, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// recordField is a field of a data type that is read from a document
+type recordField struct {
+	name     string
+	index    int
+	optional bool
+}
+
+// recordFields gives the fields of the data type of T that are read
+// Volume, Outlier and ColName are optional
+func recordFields[T Record]() (fs []recordField) {
+	typ := reflect.TypeFor[T]()
+	for i := 0; i < typ.NumField(); i++ {
+		name := typ.Field(i).Name
+		fs = append(fs, recordField{
+			name:     name,
+			index:    i,
+			optional: name == "Volume" || name == "Outlier" || name == "ColName",
+		})
+	}
+	return
+}
+
+// checkMapping returns an error if the options map fields that the data type of T does not have
+func checkMapping[T Record](opts Options) (err error) {
+	fs := recordFields[T]()
+	known := func(name string) (b bool) {
+		for _, f := range fs {
+			if f.name == name {
+				b = true
+				return
+			}
+		}
+		return
+	}
+	for name := range opts.Columns {
+		if !known(name) {
+			err = fmt.Errorf("unknown field %s", name)
+			return
+		}
+	}
+	for name, i := range opts.Indices {
+		if !known(name) {
+			err = fmt.Errorf("unknown field %s", name)
+			return
+		}
+		if i < 0 {
+			err = fmt.Errorf("invalid index of field %s", name)
+			return
+		}
+	}
+	return
+}
+
+// columnName gives the column name or key of field name
+func (opts Options) columnName(name string) (col string) {
+	col = name
+	if c, ok := opts.Columns[name]; ok {
+		col = c
+	}
+	return
+}
+
+func (opts Options) withDefaults() (o Options) {
+	o = opts
+	def := DefaultOptions()
+	if o.Delimiter == 0 {
+		o.Delimiter = def.Delimiter
+	}
+	if o.TimeLayout == "" {
+		o.TimeLayout = def.TimeLayout
+	}
+	if o.Location == nil {
+		o.Location = def.Location
+	}
+	if o.OutlierSeparator == "" {
+		o.OutlierSeparator = def.OutlierSeparator
+	}
+	return
+}
+
+// setText parses s and assigns it to field f of the record v
+func (opts Options) setText(v reflect.Value, f recordField, s string) (err error) {
+	s = strings.TrimSpace(s)
+	field := v.Field(f.index)
+	if s == "" {
+		if !f.optional {
+			err = errors.New("missing value")
+		}
+		return
+	}
+	switch {
+	case field.Type() == timeType:
+		var t time.Time
+		t, err = opts.parseTime(s)
+		if err == nil {
+			field.Set(reflect.ValueOf(t))
+		}
+	case field.Kind() == reflect.Float64:
+		var val float64
+		val, err = parseFloat(s)
+		if err == nil {
+			field.SetFloat(val)
+		}
+	case field.Kind() == reflect.String:
+		field.SetString(s)
+	case field.Kind() == reflect.Slice:
+		var vals []float64
+		for _, part := range strings.Split(s, opts.OutlierSeparator) {
+			var val float64
+			val, err = parseFloat(strings.TrimSpace(part))
+			if err != nil {
+				return
+			}
+			vals = append(vals, val)
+		}
+		field.Set(reflect.ValueOf(vals))
+	}
+	return
+}
+
+func (opts Options) parseTime(s string) (t time.Time, err error) {
+	if opts.TimeLayout == LayoutUnix {
+		var sec float64
+		sec, err = strconv.ParseFloat(s, 64)
+		if err != nil {
+			err = fmt.Errorf("invalid unix time %q", s)
+			return
+		}
+		t = time.Unix(0, int64(sec*1e9)).In(opts.Location)
+		return
+	}
+	t, err = time.ParseInLocation(opts.TimeLayout, s, opts.Location)
+	if err != nil {
+		err = fmt.Errorf("invalid time %q, expected layout %q", s, opts.TimeLayout)
+	}
+	return
+}
+
+func parseFloat(s string) (val float64, err error) {
+	val, err = strconv.ParseFloat(s, 64)
+	if err != nil {
+		err = fmt.Errorf("invalid number %q", s)
+	}
+	return
+}
+
+// text formats field f of the record v as it is read by setText
+func (opts Options) text(v reflect.Value, f recordField) (s string) {
+	field := v.Field(f.index)
+	switch {
+	case field.Type() == timeType:
+		s = opts.formatTime(field.Interface().(time.Time))
+	case field.Kind() == reflect.Float64:
+		s = formatFloat(field.Float())
+	case field.Kind() == reflect.String:
+		s = field.String()
+	case field.Kind() == reflect.Slice:
+		parts := make([]string, field.Len())
+		for i := range parts {
+			parts[i] = formatFloat(field.Index(i).Float())
+		}
+		s = strings.Join(parts, opts.OutlierSeparator)
+	}
+	return
+}
+
+func (opts Options) formatTime(t time.Time) (s string) {
+	if opts.TimeLayout == LayoutUnix {
+		s = strconv.FormatInt(t.Unix(), 10)
+		if t.Nanosecond() != 0 {
+			s = strconv.FormatFloat(float64(t.UnixNano())/1e9, 'f', -1, 64)
+		}
+		return
+	}
+	s = t.In(opts.Location).Format(opts.TimeLayout)
+	return
+}
+
+func formatFloat(val float64) (s string) {
+	s = strconv.FormatFloat(val, 'g', -1, 64)
+	return
+}
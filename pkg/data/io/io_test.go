@@ -0,0 +1,347 @@
+package io
+
+import (
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+func TestReadCSVNumerical(t *testing.T) {
+	noHeader := DefaultOptions()
+	noHeader.Header = false
+	semicolon := DefaultOptions()
+	semicolon.Delimiter = ';'
+	semicolon.Comment = '#'
+	mapped := DefaultOptions()
+	mapped.Columns = map[string]string{"N": "x", "Val": "y"}
+	indices := DefaultOptions()
+	indices.Header = false
+	indices.Indices = map[string]int{"N": 2, "Val": 0}
+	var tests = []struct {
+		doc    string
+		opts   Options
+		exp    []data.NumericalPoint
+		expErr bool
+		row    int
+		col    string
+	}{
+		{"n,val\n1,2\n3.5,-4\n", DefaultOptions(), []data.NumericalPoint{{N: 1, Val: 2}, {N: 3.5, Val: -4}}, false, 0, ""},
+		{"Val , N\n2,1\n", DefaultOptions(), []data.NumericalPoint{{N: 1, Val: 2}}, false, 0, ""},
+		{"1,2\n", noHeader, []data.NumericalPoint{{N: 1, Val: 2}}, false, 0, ""},
+		{"# comment\nn;val\n1;2\n", semicolon, []data.NumericalPoint{{N: 1, Val: 2}}, false, 0, ""},
+		{"x,y\n1,2\n", mapped, []data.NumericalPoint{{N: 1, Val: 2}}, false, 0, ""},
+		{"2,ignored,1\n", indices, []data.NumericalPoint{{N: 1, Val: 2}}, false, 0, ""},
+		{"", DefaultOptions(), nil, false, 0, ""},
+		{"n,val\n1,2\n3,abc\n", DefaultOptions(), nil, true, 3, "val"},
+		{"n,val\n1,2\n,4\n", DefaultOptions(), nil, true, 3, "n"},
+		{"n,val\n1\n", DefaultOptions(), nil, true, 2, "val"},
+		{"n,value\n1,2\n", DefaultOptions(), nil, true, 1, ""},
+		{"1,x\n", noHeader, nil, true, 1, "2"},
+		{"n,val\n1,\"2\n", DefaultOptions(), nil, true, 2, ""},
+	}
+	for i, tt := range tests {
+		ps, err := ReadCSV[data.NumericalPoint](strings.NewReader(tt.doc), tt.opts)
+		if tt.expErr {
+			var pErr *ParseError
+			if !errors.As(err, &pErr) {
+				t.Errorf("expected parse error, set %d, have %v", i, err)
+				continue
+			}
+			if pErr.Row != tt.row || pErr.Column != tt.col {
+				t.Errorf("wrong position, set %d, exp %d/%s, have %d/%s", i, tt.row, tt.col, pErr.Row, pErr.Column)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error, set %d: %s", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(ps, tt.exp) {
+			t.Errorf("wrong data, set %d, exp %v, have %v", i, tt.exp, ps)
+		}
+	}
+}
+
+func TestReadCSVTemporal(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("no time zone database")
+	}
+	layout := DefaultOptions()
+	layout.TimeLayout = "2006-01-02 15:04"
+	layout.Location = berlin
+	unix := DefaultOptions()
+	unix.TimeLayout = LayoutUnix
+	var tests = []struct {
+		doc  string
+		opts Options
+		exp  time.Time
+	}{
+		{"t,val\n2024-03-01T12:00:00+01:00,1\n", DefaultOptions(), time.Date(2024, 3, 1, 11, 0, 0, 0, time.UTC)},
+		{"t,val\n2024-03-01 12:00,1\n", layout, time.Date(2024, 3, 1, 11, 0, 0, 0, time.UTC)},
+		{"t,val\n1709294400,1\n", unix, time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)},
+	}
+	for i, tt := range tests {
+		ps, err := ReadCSV[data.TemporalPoint](strings.NewReader(tt.doc), tt.opts)
+		if err != nil {
+			t.Errorf("unexpected error, set %d: %s", i, err)
+			continue
+		}
+		if len(ps) != 1 || !ps[0].T.Equal(tt.exp) || ps[0].Val != 1 {
+			t.Errorf("wrong data, set %d, exp %v, have %v", i, tt.exp, ps)
+		}
+	}
+}
+
+func TestReadCSVBoxAndCandle(t *testing.T) {
+	boxes, err := ReadCSV[data.CategoricalBox](strings.NewReader(
+		"c,minimum,firstquartile,median,thirdquartile,maximum,outlier\na,1,2,3,4,5,0.5;7\nb,1,2,3,4,5,\n"),
+		DefaultOptions())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	exp := []data.CategoricalBox{
+		{C: "a", Minimum: 1, FirstQuartile: 2, Median: 3, ThirdQuartile: 4, Maximum: 5, Outlier: []float64{0.5, 7}},
+		{C: "b", Minimum: 1, FirstQuartile: 2, Median: 3, ThirdQuartile: 4, Maximum: 5},
+	}
+	if !reflect.DeepEqual(boxes, exp) {
+		t.Errorf("wrong boxes, exp %v, have %v", exp, boxes)
+	}
+	// volume is optional
+	candles, err := ReadCSV[data.NumericalCandleStick](strings.NewReader(
+		"nstart,nend,open,close,low,high\n0,1,10,12,9,13\n"), DefaultOptions())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expCandle := data.NumericalCandleStick{NStart: 0, NEnd: 1, Open: 10, Close: 12, Low: 9, High: 13}
+	if len(candles) != 1 || candles[0] != expCandle {
+		t.Errorf("wrong candles, exp %v, have %v", expCandle, candles)
+	}
+}
+
+func TestReadJSON(t *testing.T) {
+	mapped := DefaultOptions()
+	mapped.Columns = map[string]string{"C": "name"}
+	var tests = []struct {
+		doc    string
+		opts   Options
+		exp    []data.ProportionalPoint
+		expErr bool
+		row    int
+		col    string
+	}{
+		{`[{"c": "a", "val": 2, "colName": "primary"}, {"C": "b", "Val": "3.5"}]`, DefaultOptions(),
+			[]data.ProportionalPoint{{C: "a", Val: 2, ColName: theme.ColorNamePrimary}, {C: "b", Val: 3.5}}, false, 0, ""},
+		{`[{"name": 2024, "val": 1}]`, mapped, []data.ProportionalPoint{{C: "2024", Val: 1}}, false, 0, ""},
+		{`[]`, DefaultOptions(), nil, false, 0, ""},
+		{`[{"c": "a", "val": 1}, {"c": "b", "val": "x"}]`, DefaultOptions(), nil, true, 2, "val"},
+		{`[{"c": "a", "val": null}]`, DefaultOptions(), nil, true, 1, "val"},
+		{`[{"c": "a", "val": true}]`, DefaultOptions(), nil, true, 1, "val"},
+	}
+	for i, tt := range tests {
+		ps, err := ReadJSON[data.ProportionalPoint](strings.NewReader(tt.doc), tt.opts)
+		if tt.expErr {
+			var pErr *ParseError
+			if !errors.As(err, &pErr) {
+				t.Errorf("expected parse error, set %d, have %v", i, err)
+				continue
+			}
+			if pErr.Row != tt.row || pErr.Column != tt.col {
+				t.Errorf("wrong position, set %d, exp %d/%s, have %d/%s", i, tt.row, tt.col, pErr.Row, pErr.Column)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error, set %d: %s", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(ps, tt.exp) {
+			t.Errorf("wrong data, set %d, exp %v, have %v", i, tt.exp, ps)
+		}
+	}
+}
+
+func TestReadJSONTemporalBox(t *testing.T) {
+	boxes, err := ReadJSON[data.TemporalBox](strings.NewReader(`[{"t": "2024-03-01T00:00:00Z", "minimum": 1,
+		"firstQuartile": 2, "median": 3, "thirdQuartile": 4, "maximum": 5, "outlier": [9, 10]}]`), DefaultOptions())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(boxes) != 1 || !boxes[0].T.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) ||
+		!reflect.DeepEqual(boxes[0].Outlier, []float64{9, 10}) {
+		t.Errorf("wrong boxes, have %v", boxes)
+	}
+}
+
+func TestReadFiles(t *testing.T) {
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "data.csv")
+	jsonPath := filepath.Join(dir, "data.json")
+	err := os.WriteFile(csvPath, []byte("c,val\na,1\n"), 0o644)
+	if err == nil {
+		err = os.WriteFile(jsonPath, []byte(`[{"c": "a", "val": 1}]`), 0o644)
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	exp := []data.CategoricalPoint{{C: "a", Val: 1}}
+	ps, err := ReadCSVFile[data.CategoricalPoint](csvPath, DefaultOptions())
+	if err != nil || !reflect.DeepEqual(ps, exp) {
+		t.Errorf("wrong csv data, exp %v, have %v, error %v", exp, ps, err)
+	}
+	ps, err = ReadJSONFile[data.CategoricalPoint](jsonPath, DefaultOptions())
+	if err != nil || !reflect.DeepEqual(ps, exp) {
+		t.Errorf("wrong json data, exp %v, have %v, error %v", exp, ps, err)
+	}
+	_, err = ReadCSVFile[data.CategoricalPoint](filepath.Join(dir, "missing.csv"), DefaultOptions())
+	if err == nil {
+		t.Errorf("expected error for missing file")
+	}
+	opts := DefaultOptions()
+	opts.Columns = map[string]string{"X": "x"}
+	_, err = ReadCSVFile[data.CategoricalPoint](csvPath, opts)
+	if err == nil {
+		t.Errorf("expected error for unknown field")
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	semicolon := DefaultOptions()
+	semicolon.Delimiter = ';'
+	semicolon.Header = false
+	semicolon.OutlierSeparator = "|"
+	mapped := DefaultOptions()
+	mapped.Columns = map[string]string{"C": "cat"}
+	boxes := []data.CategoricalBox{
+		{C: "a", Minimum: 1, FirstQuartile: 2, Median: 3, ThirdQuartile: 4, Maximum: 5, Outlier: []float64{0.5, 7}},
+		{C: "b, c", Minimum: 1, FirstQuartile: 2, Median: 3, ThirdQuartile: 4, Maximum: 5},
+	}
+	var tests = []struct {
+		opts Options
+		exp  string
+	}{
+		{DefaultOptions(), "C,Maximum,ThirdQuartile,Median,FirstQuartile,Minimum,Outlier\n" +
+			"a,5,4,3,2,1,0.5;7\n\"b, c\",5,4,3,2,1,\n"},
+		{semicolon, "a;5;4;3;2;1;0.5|7\nb, c;5;4;3;2;1;\n"},
+		{mapped, "cat,Maximum,ThirdQuartile,Median,FirstQuartile,Minimum,Outlier\n" +
+			"a,5,4,3,2,1,0.5;7\n\"b, c\",5,4,3,2,1,\n"},
+	}
+	for i, tt := range tests {
+		var b strings.Builder
+		err := WriteCSV(&b, boxes, tt.opts)
+		if err != nil {
+			t.Errorf("unexpected error, set %d: %s", i, err)
+			continue
+		}
+		if b.String() != tt.exp {
+			t.Errorf("wrong csv, set %d, exp %q, have %q", i, tt.exp, b.String())
+		}
+		rs, err := ReadCSV[data.CategoricalBox](strings.NewReader(b.String()), tt.opts)
+		if err != nil || !reflect.DeepEqual(rs, boxes) {
+			t.Errorf("csv not read back, set %d, have %v, error %v", i, rs, err)
+		}
+	}
+	opts := DefaultOptions()
+	opts.Columns = map[string]string{"X": "x"}
+	err := WriteCSV(&strings.Builder{}, boxes, opts)
+	if err == nil {
+		t.Errorf("expected error for unknown field")
+	}
+}
+
+func TestWriteTemporalCSV(t *testing.T) {
+	unix := DefaultOptions()
+	unix.TimeLayout = LayoutUnix
+	tasks := []data.GanttTask{{Row: "dev", Name: "build", TStart: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+		TEnd: time.Date(2024, 3, 2, 12, 0, 0, 500000000, time.UTC)}}
+	var tests = []struct {
+		opts Options
+		exp  string
+	}{
+		{DefaultOptions(), "Row,Name,TStart,TEnd\ndev,build,2024-03-01T12:00:00Z,2024-03-02T12:00:00Z\n"},
+		{unix, "Row,Name,TStart,TEnd\ndev,build,1709294400,1709380800.5\n"},
+	}
+	for i, tt := range tests {
+		var b strings.Builder
+		err := WriteCSV(&b, tasks, tt.opts)
+		if err != nil {
+			t.Errorf("unexpected error, set %d: %s", i, err)
+			continue
+		}
+		if b.String() != tt.exp {
+			t.Errorf("wrong csv, set %d, exp %q, have %q", i, tt.exp, b.String())
+		}
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	samples := []data.NumericalSamples{{N: 1, Samples: []float64{2, 3.5}}, {N: 2.5, Samples: []float64{}}}
+	var b strings.Builder
+	err := WriteJSON(&b, samples, DefaultOptions())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	exp := "[\n  {\"N\": 1, \"Samples\": [2,3.5]},\n  {\"N\": 2.5, \"Samples\": []}\n]\n"
+	if b.String() != exp {
+		t.Errorf("wrong json, exp %q, have %q", exp, b.String())
+	}
+	rs, err := ReadJSON[data.NumericalSamples](strings.NewReader(b.String()), DefaultOptions())
+	if err != nil || !reflect.DeepEqual(rs, samples) {
+		t.Errorf("json not read back, have %v, error %v", rs, err)
+	}
+
+	b.Reset()
+	err = WriteJSON(&b, []data.TemporalPoint{}, DefaultOptions())
+	if err != nil || b.String() != "[]\n" {
+		t.Errorf("wrong empty json %q, error %v", b.String(), err)
+	}
+
+	unix := DefaultOptions()
+	unix.TimeLayout = LayoutUnix
+	unix.Columns = map[string]string{"T": "time"}
+	b.Reset()
+	err = WriteJSON(&b, []data.TemporalPoint{{T: time.Unix(1709294400, 0), Val: 2}}, unix)
+	exp = "[\n  {\"time\": 1709294400, \"Val\": 2}\n]\n"
+	if err != nil || b.String() != exp {
+		t.Errorf("wrong json, exp %q, have %q, error %v", exp, b.String(), err)
+	}
+
+	err = WriteJSON(&strings.Builder{}, []data.NumericalPoint{{N: 1, Val: math.NaN()}}, DefaultOptions())
+	if err == nil {
+		t.Errorf("expected error for NaN")
+	}
+}
+
+func TestWriteFiles(t *testing.T) {
+	dir := t.TempDir()
+	ps := []data.ProportionalPoint{{C: "a", Val: 1, ColName: theme.ColorNamePrimary}, {C: "b", Val: 2}}
+	csvPath := filepath.Join(dir, "data.csv")
+	jsonPath := filepath.Join(dir, "data.json")
+	err := WriteCSVFile(csvPath, ps, DefaultOptions())
+	if err == nil {
+		err = WriteJSONFile(jsonPath, ps, DefaultOptions())
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	rs, err := ReadCSVFile[data.ProportionalPoint](csvPath, DefaultOptions())
+	if err != nil || !reflect.DeepEqual(rs, ps) {
+		t.Errorf("wrong csv data, exp %v, have %v, error %v", ps, rs, err)
+	}
+	rs, err = ReadJSONFile[data.ProportionalPoint](jsonPath, DefaultOptions())
+	if err != nil || !reflect.DeepEqual(rs, ps) {
+		t.Errorf("wrong json data, exp %v, have %v, error %v", ps, rs, err)
+	}
+	err = WriteCSVFile(filepath.Join(dir, "missing", "data.csv"), ps, DefaultOptions())
+	if err == nil {
+		t.Errorf("expected error for missing directory")
+	}
+}
@@ -0,0 +1,184 @@
+package io
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ReadJSON reads a JSON array of objects from r, e.g. ReadJSON[data.TemporalPoint](r, DefaultOptions())
+// Values are given as numbers or strings, times as strings in the time layout of opts and outliers or samples as array
+// Delimiter, Comment, Header and Indices of opts are not used
+// An error of type *ParseError is returned for invalid elements
+func ReadJSON[T Record](r io.Reader, opts Options) (rs []T, err error) {
+	opts = opts.withDefaults()
+	err = checkMapping[T](opts)
+	if err != nil {
+		return
+	}
+	var elems []map[string]json.RawMessage
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	err = dec.Decode(&elems)
+	if err != nil {
+		return
+	}
+	fs := recordFields[T]()
+	for i, elem := range elems {
+		var rec T
+		v := reflect.ValueOf(&rec).Elem()
+		for _, f := range fs {
+			key, raw := jsonValue(elem, opts.columnName(f.name))
+			err = opts.setJSON(v, f, raw)
+			if err != nil {
+				err = &ParseError{Row: i + 1, Column: key, Err: err}
+				return
+			}
+		}
+		rs = append(rs, rec)
+	}
+	return
+}
+
+// ReadJSONFile reads the JSON file at path like ReadJSON
+func ReadJSONFile[T Record](path string, opts Options) (rs []T, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	rs, err = ReadJSON[T](f, opts)
+	return
+}
+
+// WriteJSON writes rs as JSON array of objects to w with one element per line
+// The keys are named like the fields or as mapped by Columns, so that the document can be read again by ReadJSON
+// with the same options; times are written as strings in the time layout of opts or as numbers for LayoutUnix
+// An error is returned for values that cannot be represented in JSON (NaN and infinite numbers)
+// Delimiter, Comment, Header, Indices and OutlierSeparator of opts are not used
+func WriteJSON[T Record](w io.Writer, rs []T, opts Options) (err error) {
+	opts = opts.withDefaults()
+	err = checkMapping[T](opts)
+	if err != nil {
+		return
+	}
+	fs := recordFields[T]()
+	var b bytes.Buffer
+	b.WriteString("[")
+	for i := range rs {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n  {")
+		v := reflect.ValueOf(rs[i])
+		for j, f := range fs {
+			if j > 0 {
+				b.WriteString(", ")
+			}
+			key, _ := json.Marshal(opts.columnName(f.name))
+			var raw []byte
+			raw, err = opts.marshalJSON(v, f)
+			if err != nil {
+				err = fmt.Errorf("element %d, key %s: %w", i+1, key, err)
+				return
+			}
+			b.Write(key)
+			b.WriteString(": ")
+			b.Write(raw)
+		}
+		b.WriteString("}")
+	}
+	if len(rs) > 0 {
+		b.WriteString("\n")
+	}
+	b.WriteString("]\n")
+	_, err = b.WriteTo(w)
+	return
+}
+
+// WriteJSONFile creates or truncates the file at path and writes rs to it like WriteJSON
+func WriteJSONFile[T Record](path string, rs []T, opts Options) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return
+	}
+	err = WriteJSON(f, rs, opts)
+	if cErr := f.Close(); err == nil {
+		err = cErr
+	}
+	return
+}
+
+// jsonValue gives the value of key in elem, ignoring case if there is no exact match
+func jsonValue(elem map[string]json.RawMessage, key string) (k string, raw json.RawMessage) {
+	k = key
+	if r, ok := elem[key]; ok {
+		raw = r
+		return
+	}
+	for kElem, rawElem := range elem {
+		if strings.EqualFold(kElem, key) {
+			k, raw = kElem, rawElem
+			return
+		}
+	}
+	return
+}
+
+// setJSON assigns the JSON value raw to field f of the record v
+func (opts Options) setJSON(v reflect.Value, f recordField, raw json.RawMessage) (err error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || string(raw) == "null" {
+		err = opts.setText(v, f, "")
+		return
+	}
+	switch raw[0] {
+	case '"':
+		var s string
+		err = json.Unmarshal(raw, &s)
+		if err == nil {
+			err = opts.setText(v, f, s)
+		}
+	case '[':
+		field := v.Field(f.index)
+		if field.Kind() != reflect.Slice {
+			err = errors.New("unexpected array")
+			return
+		}
+		var vals []float64
+		err = json.Unmarshal(raw, &vals)
+		if err != nil {
+			err = errors.New("invalid array of numbers")
+			return
+		}
+		field.Set(reflect.ValueOf(vals))
+	case '{', 't', 'f':
+		err = errors.New("unexpected value " + string(raw))
+	default:
+		// numbers are parsed like text, so that they can be used for categories and unix times
+		err = opts.setText(v, f, string(raw))
+	}
+	return
+}
+
+// marshalJSON encodes field f of the record v as it is read by setJSON
+func (opts Options) marshalJSON(v reflect.Value, f recordField) (raw []byte, err error) {
+	field := v.Field(f.index)
+	if field.Type() != timeType {
+		raw, err = json.Marshal(field.Interface())
+		return
+	}
+	s := opts.formatTime(field.Interface().(time.Time))
+	if opts.TimeLayout == LayoutUnix {
+		raw = []byte(s)
+		return
+	}
+	raw, err = json.Marshal(s)
+	return
+}
@@ -0,0 +1,12 @@
+package data
+
+import (
+	"fyne.io/fyne/v2"
+)
+
+// ValueRange represents a colored range of values, e.g. a threshold band of a gauge or a qualitative range of a bullet chart
+type ValueRange struct {
+	Min     float64
+	Max     float64
+	ColName fyne.ThemeColorName
+}
@@ -26,6 +26,7 @@ type NumericalCandleStick struct {
 	Close  float64
 	Low    float64
 	High   float64
+	Volume float64
 }
 
 // NumericalBox represents one box in a box series with a numerical coordinate
@@ -39,6 +40,13 @@ type NumericalBox struct {
 	Outlier       []float64
 }
 
+// NumericalSamples represents a set of raw samples at a numerical coordinate
+// It is used by violin series to estimate the distribution of the samples
+type NumericalSamples struct {
+	N       float64
+	Samples []float64
+}
+
 // NumericalTick represents one tick on a numerical axis
 type NumericalTick struct {
 	N           float64
@@ -10,3 +10,13 @@ type ProportionalPoint struct {
 	Val     float64
 	ColName fyne.ThemeColorName
 }
+
+// ProportionalNode represents one node of a hierarchical proportional data set
+// The value of a node with children is the sum of the values of its children.
+// A node without ColName uses the color of its parent.
+type ProportionalNode struct {
+	C        string
+	Val      float64
+	ColName  fyne.ThemeColorName
+	Children []ProportionalNode
+}
@@ -30,6 +30,7 @@ type TemporalCandleStick struct {
 	Close  float64
 	Low    float64
 	High   float64
+	Volume float64
 }
 
 // TemporalBox represents one box in a box series with a temporal coordinate
@@ -48,3 +49,25 @@ type TemporalTick struct {
 	T           time.Time
 	SupportLine bool
 }
+
+// GanttTask represents one bar in a gantt series from TStart to TEnd in the row Row.
+// Name is displayed inside the bar and used to reference the task in dependencies
+type GanttTask struct {
+	Row    string
+	Name   string
+	TStart time.Time
+	TEnd   time.Time
+}
+
+// GanttMilestone represents a single point in time in the row Row of a gantt series
+type GanttMilestone struct {
+	Row  string
+	Name string
+	T    time.Time
+}
+
+// GanttDependency represents an arrow from the end of the task named From to the start of the task named To
+type GanttDependency struct {
+	From string
+	To   string
+}
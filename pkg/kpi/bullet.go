@@ -0,0 +1,77 @@
+package kpi
+
+import (
+	"fyne.io/fyne/v2"
+	"github.com/s-daehling/fyne-charts/internal/kpi"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+	"github.com/s-daehling/fyne-charts/pkg/style"
+)
+
+// BulletChart implements a horizontal bullet chart with a value bar, a target marker and qualitative ranges
+type BulletChart struct {
+	kpiChart
+	bullet *kpi.Bullet
+}
+
+// NewBulletChart returns an initialized BulletChart
+func NewBulletChart(title string) (bulletChart *BulletChart) {
+	b := kpi.EmptyBullet()
+	bulletChart = &BulletChart{
+		kpiChart: kpiChart{base: b},
+		bullet:   b,
+	}
+	bulletChart.ExtendBaseWidget(bulletChart)
+	bulletChart.SetTitle(title)
+	return
+}
+
+// SetQualitativeRanges sets the ranges drawn behind the value bar (e.g. poor, satisfactory, good).
+// Later ranges are drawn on top of earlier ones.
+// An error is returned if Min >= Max for one of the ranges or one of its bounds is NaN or infinite
+func (bulletChart *BulletChart) SetQualitativeRanges(ranges []data.ValueRange) (err error) {
+	if bulletChart.base == nil {
+		return
+	}
+	err = bulletChart.base.SetRanges(ranges)
+	return
+}
+
+// SetTarget sets the value at which the target marker is drawn
+func (bulletChart *BulletChart) SetTarget(t float64) {
+	if bulletChart.bullet == nil {
+		return
+	}
+	bulletChart.bullet.SetTarget(t)
+}
+
+// HideTarget removes the target marker
+func (bulletChart *BulletChart) HideTarget() {
+	if bulletChart.bullet == nil {
+		return
+	}
+	bulletChart.bullet.HideTarget()
+}
+
+// SetBarColor changes the color of the value bar
+func (bulletChart *BulletChart) SetBarColor(colName fyne.ThemeColorName) {
+	if bulletChart.bullet == nil {
+		return
+	}
+	bulletChart.bullet.SetBarColor(colName)
+}
+
+// SetTargetColor changes the color of the target marker
+func (bulletChart *BulletChart) SetTargetColor(colName fyne.ThemeColorName) {
+	if bulletChart.bullet == nil {
+		return
+	}
+	bulletChart.bullet.SetTargetColor(colName)
+}
+
+// SetAxisStyle changes the style of the value axis
+func (bulletChart *BulletChart) SetAxisStyle(axisStyle style.AxisStyle) {
+	if bulletChart.bullet == nil {
+		return
+	}
+	bulletChart.bullet.SetAxisStyle(axisStyle)
+}
@@ -0,0 +1,45 @@
+package kpi
+
+import (
+	"github.com/s-daehling/fyne-charts/internal/kpi"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+)
+
+// GaugeChart implements a radial gauge with colored threshold bands and a needle
+type GaugeChart struct {
+	kpiChart
+	gauge *kpi.Gauge
+}
+
+// NewGaugeChart returns an initialized GaugeChart
+func NewGaugeChart(title string) (gaugeChart *GaugeChart) {
+	g := kpi.EmptyGauge()
+	gaugeChart = &GaugeChart{
+		kpiChart: kpiChart{base: g},
+		gauge:    g,
+	}
+	gaugeChart.ExtendBaseWidget(gaugeChart)
+	gaugeChart.SetTitle(title)
+	return
+}
+
+// SetThresholds sets the colored bands of the gauge arc.
+// Bands may overlap; later bands are drawn on top of earlier ones. Parts of the arc without band are drawn in a neutral color.
+// An error is returned if Min >= Max for one of the bands or one of its bounds is NaN or infinite
+func (gaugeChart *GaugeChart) SetThresholds(bands []data.ValueRange) (err error) {
+	if gaugeChart.base == nil {
+		return
+	}
+	err = gaugeChart.base.SetRanges(bands)
+	return
+}
+
+// SetArcAngles sets the angles (in radians, counterclockwise from 3 o'clock) at which the min and max of the range are drawn.
+// The arc runs clockwise from minAngle to maxAngle; the default is 5pi/4 to -pi/4.
+// Equal angles result in a full circle
+func (gaugeChart *GaugeChart) SetArcAngles(minAngle float64, maxAngle float64) {
+	if gaugeChart.gauge == nil {
+		return
+	}
+	gaugeChart.gauge.SetArcAngles(minAngle, maxAngle)
+}
@@ -0,0 +1,118 @@
+package kpi
+
+import (
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+	"github.com/s-daehling/fyne-charts/pkg/style"
+)
+
+type kpiBase interface {
+	MainContainer() (cont *fyne.Container)
+	Refresh()
+	SetTitle(l string)
+	SetTitleStyle(ts style.ChartTextStyle)
+	SetValueTextStyle(ts style.ChartTextStyle)
+	SetValuePrecision(prec int)
+	SetRange(min float64, max float64) (err error)
+	SetRanges(rs []data.ValueRange) (err error)
+	SetAnimationDuration(d time.Duration)
+	SetValue(val float64) (err error)
+	Value() (val float64)
+}
+
+type kpiChart struct {
+	base kpiBase
+	widget.BaseWidget
+}
+
+func (chart *kpiChart) CreateRenderer() (r fyne.WidgetRenderer) {
+	if chart.base == nil {
+		r = widget.NewSimpleRenderer(widget.NewLabel("not initialized"))
+		return
+	}
+	r = widget.NewSimpleRenderer(chart.base.MainContainer())
+	return
+}
+
+// Refresh chart
+// chart is automatically refreshed after data changes
+func (chart *kpiChart) Refresh() {
+	if chart.base == nil {
+		return
+	}
+	chart.base.Refresh()
+}
+
+// SetTitle sets the title of the chart, which will be displayed at the top
+func (chart *kpiChart) SetTitle(l string) {
+	if chart.base == nil {
+		return
+	}
+	chart.base.SetTitle(l)
+}
+
+// SetTitleStyle changes the style of the chart title
+func (chart *kpiChart) SetTitleStyle(titleStyle style.ChartTextStyle) {
+	if chart.base == nil {
+		return
+	}
+	chart.base.SetTitleStyle(titleStyle)
+}
+
+// SetValueTextStyle changes the style of the value label
+func (chart *kpiChart) SetValueTextStyle(textStyle style.ChartTextStyle) {
+	if chart.base == nil {
+		return
+	}
+	chart.base.SetValueTextStyle(textStyle)
+}
+
+// SetValuePrecision sets the number of decimal places of the value label (default 0)
+func (chart *kpiChart) SetValuePrecision(prec int) {
+	if chart.base == nil {
+		return
+	}
+	chart.base.SetValuePrecision(prec)
+}
+
+// SetRange sets the range of values covered by the chart (default 0 to 100).
+// Values outside the range are drawn at min or max.
+// An error is returned if min >= max
+func (chart *kpiChart) SetRange(min float64, max float64) (err error) {
+	if chart.base == nil {
+		return
+	}
+	err = chart.base.SetRange(min, max)
+	return
+}
+
+// SetAnimationDuration sets the duration of the transition from the old to a new value (default 500ms).
+// A duration of 0 disables the animation
+func (chart *kpiChart) SetAnimationDuration(d time.Duration) {
+	if chart.base == nil {
+		return
+	}
+	chart.base.SetAnimationDuration(d)
+}
+
+// SetValue sets the value displayed by the chart; the chart animates from the old value to the new one.
+// An error is returned if the value is NaN or infinite
+func (chart *kpiChart) SetValue(val float64) (err error) {
+	if chart.base == nil {
+		return
+	}
+	err = chart.base.SetValue(val)
+	return
+}
+
+// Value returns the current value
+func (chart *kpiChart) Value() (val float64) {
+	if chart.base == nil {
+		return
+	}
+	val = chart.base.Value()
+	return
+}
@@ -4,6 +4,18 @@ import (
 	"github.com/s-daehling/fyne-charts/internal/prop"
 )
 
+// BarMode defines how the series of a BarChart are scaled
+type BarMode string
+
+const (
+	// BarModeNormalized scales every series to a 100% bar (default)
+	BarModeNormalized BarMode = "normalized"
+	// BarModeAbsolute stacks the values of every series; the value axis shows the totals
+	BarModeAbsolute BarMode = "absolute"
+	// BarModeGrouped draws one bar per category, grouped by series
+	BarModeGrouped BarMode = "grouped"
+)
+
 // BarChart implements a cartesian plane with one proportional axis
 type BarChart struct {
 	propChart
@@ -14,6 +26,7 @@ func NewBarChart(title string) (barChart *BarChart) {
 	barChart = &BarChart{
 		propChart: emptyPropChart(prop.CartesianPlane),
 	}
+	barChart.base.SetSharedCategories(true)
 	barChart.ExtendBaseWidget(barChart)
 	barChart.SetTitle(title)
 	return
@@ -25,3 +38,19 @@ func NewBarChart(title string) (barChart *BarChart) {
 func (barChart *BarChart) SetOrientation(transposed bool) {
 	barChart.base.SetCartesianOrientantion(transposed)
 }
+
+// SetMode defines how the series are scaled
+// Categories with the same name use the color of their first occurrence in all series and are listed once in the legend
+func (barChart *BarChart) SetMode(mode BarMode) {
+	if barChart.base == nil {
+		return
+	}
+	switch mode {
+	case BarModeAbsolute:
+		barChart.base.SetBarMode(prop.BarModeAbsolute)
+	case BarModeGrouped:
+		barChart.base.SetBarMode(prop.BarModeGrouped)
+	default:
+		barChart.base.SetBarMode(prop.BarModeNormalized)
+	}
+}
@@ -0,0 +1,22 @@
+package prop
+
+import (
+	"github.com/s-daehling/fyne-charts/internal/prop"
+)
+
+// FunnelChart implements a cartesian plane with centered bars for each stage of a proportional series
+// Stages are drawn from top to bottom in the order of the data, each labeled with its percentage of the first and of the previous stage.
+type FunnelChart struct {
+	propChart
+}
+
+// NewFunnelChart returns an initialized FunnelChart
+func NewFunnelChart(title string) (funnelChart *FunnelChart) {
+	funnelChart = &FunnelChart{
+		propChart: emptyPropChart(prop.CartesianPlane),
+	}
+	funnelChart.base.SetLayout(prop.FunnelLayout)
+	funnelChart.ExtendBaseWidget(funnelChart)
+	funnelChart.SetTitle(title)
+	return
+}
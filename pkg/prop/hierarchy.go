@@ -0,0 +1,202 @@
+package prop
+
+import (
+	"errors"
+	"image"
+	"io"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+	"github.com/s-daehling/fyne-charts/internal/prop"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+	"github.com/s-daehling/fyne-charts/pkg/style"
+)
+
+type hierarchyChart struct {
+	base *prop.BaseChart
+	widget.BaseWidget
+}
+
+func emptyHierarchyChart(planeType prop.PlaneType) (chart hierarchyChart) {
+	chart.base = prop.EmptyBaseChart(planeType)
+	chart.base.MakeHierarchy()
+	return
+}
+
+func (chart *hierarchyChart) CreateRenderer() (r fyne.WidgetRenderer) {
+	if chart.base == nil {
+		r = widget.NewSimpleRenderer(widget.NewLabel("not initialized"))
+		return
+	}
+	r = widget.NewSimpleRenderer(chart.base.MainContainer())
+	return
+}
+
+// Refresh chart
+// chart is automatically refreshed after data changes
+func (chart *hierarchyChart) Refresh() {
+	if chart.base == nil {
+		return
+	}
+	chart.base.Refresh()
+}
+
+// SetData replaces the data of the chart by the tree below root
+// The value of a node with children is the sum of the values of its children.
+// An error is returned, if the value of a leaf is negative or not finite
+func (chart *hierarchyChart) SetData(root data.ProportionalNode) (err error) {
+	if chart.base == nil {
+		return
+	}
+	err = chart.base.SetHierarchy(root)
+	return
+}
+
+// DrillUp shows the parent of the currently shown node
+func (chart *hierarchyChart) DrillUp() {
+	if chart.base == nil {
+		return
+	}
+	chart.base.DrillUp()
+}
+
+// DrillToRoot shows the complete tree
+func (chart *hierarchyChart) DrillToRoot() {
+	if chart.base == nil {
+		return
+	}
+	chart.base.DrillToRoot()
+}
+
+// SetValTextStyle changes the style of the node labels
+func (chart *hierarchyChart) SetValTextStyle(ts style.ChartTextStyle) {
+	if chart.base == nil {
+		return
+	}
+	chart.base.SetHierarchyValTextStyle(ts)
+}
+
+// ExportImage renders the chart offscreen with the software driver of Fyne and returns the image
+// size is given in device independent units and scale is the number of pixels per unit, e.g. 2 for printed reports
+// An app must exist; on systems without display test.NewApp can be used
+// An error is returned if size or scale are not positive
+func (chart *hierarchyChart) ExportImage(size fyne.Size, scale float32) (img image.Image, err error) {
+	if chart.base == nil {
+		err = errors.New("chart not initialized")
+		return
+	}
+	img, err = chart.base.ExportImage(size, scale)
+	return
+}
+
+// WritePNG renders the chart offscreen like ExportImage and writes the image as PNG file to path
+func (chart *hierarchyChart) WritePNG(path string, size fyne.Size, scale float32) (err error) {
+	if chart.base == nil {
+		err = errors.New("chart not initialized")
+		return
+	}
+	err = chart.base.WritePNG(path, size, scale)
+	return
+}
+
+// WriteSVG renders the chart offscreen and writes it as SVG document to w
+// Rectangles, labels, legend and title are written as vector elements and text with the colors of the theme;
+// the segments of sunburst charts, which are drawn as raster, are embedded as image
+// size is given in device independent units, which are the user units of the SVG document
+// An app must exist; on systems without display test.NewApp can be used
+func (chart *hierarchyChart) WriteSVG(w io.Writer, size fyne.Size) (err error) {
+	if chart.base == nil {
+		err = errors.New("chart not initialized")
+		return
+	}
+	err = chart.base.WriteSVG(w, size)
+	return
+}
+
+// WritePDF renders the chart offscreen like WriteSVG and writes it as single page PDF document to w
+// One device independent unit is one point on the page; the glyphs of the fonts of the theme are embedded
+func (chart *hierarchyChart) WritePDF(w io.Writer, size fyne.Size) (err error) {
+	if chart.base == nil {
+		err = errors.New("chart not initialized")
+		return
+	}
+	err = chart.base.WritePDF(w, size)
+	return
+}
+
+// SetExportThemeVariant selects the light (theme.VariantLight) or dark (theme.VariantDark) variant of the theme
+// for ExportImage, WritePNG, WriteSVG and WritePDF; by default the variant of the app is used
+func (chart *hierarchyChart) SetExportThemeVariant(variant fyne.ThemeVariant) {
+	if chart.base == nil {
+		return
+	}
+	chart.base.SetExportThemeVariant(variant)
+}
+
+// SetContextMenu enables or disables the menu that is shown on right-click or long-press on the chart
+// It offers to copy the chart, save it as PNG or SVG file, copy the data as CSV, return to the root of the tree
+// and show or hide the legend, followed by the items added with AddContextMenuItem
+func (chart *hierarchyChart) SetContextMenu(enabled bool) {
+	if chart.base == nil {
+		return
+	}
+	chart.base.SetContextMenu(enabled)
+}
+
+// AddContextMenuItem appends an item of the app to the context menu
+func (chart *hierarchyChart) AddContextMenuItem(item *fyne.MenuItem) {
+	if chart.base == nil || item == nil {
+		return
+	}
+	chart.base.AddContextMenuItem(item)
+}
+
+// SetContextMenuImageCopier adds a copy image action to the context menu that puts the image on the clipboard with f
+// The clipboard of Fyne only supports text, so without it the menu offers to copy the chart as SVG document text
+func (chart *hierarchyChart) SetContextMenuImageCopier(f func(img image.Image) (err error)) {
+	if chart.base == nil {
+		return
+	}
+	chart.base.SetContextMenuImageCopier(f)
+}
+
+// SetTitle sets the title of the chart, which will be displayed at the top
+func (chart *hierarchyChart) SetTitle(l string) {
+	if chart.base == nil {
+		return
+	}
+	chart.base.SetTitle(l)
+}
+
+// SetTitleStyle changes the style of the chart title
+func (chart *hierarchyChart) SetTitleStyle(titleStyle style.ChartTextStyle) {
+	if chart.base == nil {
+		return
+	}
+	chart.base.SetTitleStyle(titleStyle)
+}
+
+// HideLegend hides the legend and uses the full space for the chart
+func (chart *hierarchyChart) HideLegend() {
+	if chart.base == nil {
+		return
+	}
+	chart.base.HideLegend()
+}
+
+// ShowLegend shows the legend
+func (chart *hierarchyChart) ShowLegend() {
+	if chart.base == nil {
+		return
+	}
+	chart.base.ShowLegend()
+}
+
+// SetLegendStyle changes the style of the chart legend
+// if interactive is true, tapping a legend entry drills down into the respective node
+func (chart *hierarchyChart) SetLegendStyle(loc style.LegendLocation, labelStyle style.ChartTextStyle, interactive bool) {
+	if chart.base == nil {
+		return
+	}
+	chart.base.SetLegendStyle(loc, labelStyle, interactive)
+}
@@ -2,6 +2,7 @@ package prop
 
 import (
 	"github.com/s-daehling/fyne-charts/internal/prop"
+	"github.com/s-daehling/fyne-charts/pkg/style"
 )
 
 // PieChart implements a polar plane with one proportional axis
@@ -18,3 +19,57 @@ func NewPieChart(title string) (pieChart *PieChart) {
 	pieChart.SetTitle(title)
 	return
 }
+
+// SetInnerRadius sets the radius of the hole in the center as ratio of the outer radius
+// A ratio of 0 draws a pie, a ratio between 0 and 1 a doughnut. An error is returned for ratios outside [0,1)
+func (pieChart *PieChart) SetInnerRadius(ratio float64) (err error) {
+	if pieChart.base == nil {
+		return
+	}
+	err = pieChart.base.SetInnerRadius(ratio)
+	return
+}
+
+// SetStartAngle sets the angle (radian) at which the first slice starts
+// The angle is measured counter-clockwise from the 3 o'clock position
+func (pieChart *PieChart) SetStartAngle(angle float64) {
+	if pieChart.base == nil {
+		return
+	}
+	pieChart.base.SetStartAngle(angle)
+}
+
+// SetClockwise defines whether the slices are arranged clockwise or counter-clockwise (default)
+func (pieChart *PieChart) SetClockwise(clockwise bool) {
+	if pieChart.base == nil {
+		return
+	}
+	pieChart.base.SetClockwise(clockwise)
+}
+
+// SetExplode pulls the slices of category c out of the pie by offset (ratio of the radius)
+// An offset of 0 moves the slices back. An error is returned for offsets outside [0,1]
+func (pieChart *PieChart) SetExplode(c string, offset float64) (err error) {
+	if pieChart.base == nil {
+		return
+	}
+	err = pieChart.base.SetExplode(c, offset)
+	return
+}
+
+// SetCenterText sets a text that is displayed in the center of the chart, e.g. the total of a doughnut
+// An empty string removes the text
+func (pieChart *PieChart) SetCenterText(t string) {
+	if pieChart.base == nil {
+		return
+	}
+	pieChart.base.SetCenterText(t)
+}
+
+// SetCenterTextStyle changes the style of the text in the center of the chart
+func (pieChart *PieChart) SetCenterTextStyle(ts style.ChartTextStyle) {
+	if pieChart.base == nil {
+		return
+	}
+	pieChart.base.SetCenterTextStyle(ts)
+}
@@ -2,10 +2,13 @@ package prop
 
 import (
 	"errors"
+	"image"
+	"io"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/widget"
 	"github.com/s-daehling/fyne-charts/internal/prop"
+	"github.com/s-daehling/fyne-charts/internal/spec"
 	"github.com/s-daehling/fyne-charts/pkg/style"
 )
 
@@ -52,6 +55,42 @@ func (chart *propChart) AddSeries(ps *Series) (err error) {
 	return
 }
 
+// BeginUpdate defers all updates of the chart until the matching EndUpdate
+// Use it to configure a chart or add many series without a relayout after every change
+// Calls can be nested; the chart is updated once when the outermost EndUpdate is called
+func (chart *propChart) BeginUpdate() {
+	if chart.base == nil {
+		return
+	}
+	chart.base.BeginUpdate()
+}
+
+// EndUpdate ends a batch started with BeginUpdate and applies all deferred updates at once
+func (chart *propChart) EndUpdate() {
+	if chart.base == nil {
+		return
+	}
+	chart.base.EndUpdate()
+}
+
+// Batch calls f and updates the chart once afterwards
+func (chart *propChart) Batch(f func()) {
+	chart.BeginUpdate()
+	defer chart.EndUpdate()
+	f()
+}
+
+// SetMaxRefreshRate limits the updates of the chart to fps per second
+// Bursts of data changes are merged into one update per frame. fps = 0 removes the limit (default)
+// An error is returned if fps is negative
+func (chart *propChart) SetMaxRefreshRate(fps float64) (err error) {
+	if chart.base == nil {
+		return
+	}
+	err = chart.base.SetMaxRefreshRate(fps)
+	return
+}
+
 // RemoveSeries deletes the series with the specified name if it exists
 func (chart *propChart) RemoveSeries(name string) {
 	if chart.base == nil {
@@ -60,6 +99,90 @@ func (chart *propChart) RemoveSeries(name string) {
 	chart.base.RemoveSeries(name)
 }
 
+// ExportImage renders the chart offscreen with the software driver of Fyne and returns the image
+// size is given in device independent units and scale is the number of pixels per unit, e.g. 2 for printed reports
+// An app must exist; on systems without display test.NewApp can be used
+// An error is returned if size or scale are not positive
+func (chart *propChart) ExportImage(size fyne.Size, scale float32) (img image.Image, err error) {
+	if chart.base == nil {
+		err = errors.New("chart not initialized")
+		return
+	}
+	img, err = chart.base.ExportImage(size, scale)
+	return
+}
+
+// WritePNG renders the chart offscreen like ExportImage and writes the image as PNG file to path
+func (chart *propChart) WritePNG(path string, size fyne.Size, scale float32) (err error) {
+	if chart.base == nil {
+		err = errors.New("chart not initialized")
+		return
+	}
+	err = chart.base.WritePNG(path, size, scale)
+	return
+}
+
+// WriteSVG renders the chart offscreen and writes it as SVG document to w
+// Lines, dots, bars, axes, legend and title are written as vector elements and text with the colors of the theme;
+// the sectors of pie and doughnut charts are written as filled paths
+// size is given in device independent units, which are the user units of the SVG document
+// An app must exist; on systems without display test.NewApp can be used
+func (chart *propChart) WriteSVG(w io.Writer, size fyne.Size) (err error) {
+	if chart.base == nil {
+		err = errors.New("chart not initialized")
+		return
+	}
+	err = chart.base.WriteSVG(w, size)
+	return
+}
+
+// WritePDF renders the chart offscreen like WriteSVG and writes it as single page PDF document to w
+// One device independent unit is one point on the page; the glyphs of the fonts of the theme are embedded
+func (chart *propChart) WritePDF(w io.Writer, size fyne.Size) (err error) {
+	if chart.base == nil {
+		err = errors.New("chart not initialized")
+		return
+	}
+	err = chart.base.WritePDF(w, size)
+	return
+}
+
+// SetExportThemeVariant selects the light (theme.VariantLight) or dark (theme.VariantDark) variant of the theme
+// for ExportImage, WritePNG, WriteSVG and WritePDF; by default the variant of the app is used
+func (chart *propChart) SetExportThemeVariant(variant fyne.ThemeVariant) {
+	if chart.base == nil {
+		return
+	}
+	chart.base.SetExportThemeVariant(variant)
+}
+
+// SetContextMenu enables or disables the menu that is shown on right-click or long-press on the chart
+// It offers to copy the chart, save it as PNG or SVG file, copy the data as CSV, reset the zoom
+// and show or hide the legend, followed by the items added with AddContextMenuItem
+func (chart *propChart) SetContextMenu(enabled bool) {
+	if chart.base == nil {
+		return
+	}
+	chart.base.SetContextMenu(enabled)
+}
+
+// AddContextMenuItem appends an item of the app to the context menu
+func (chart *propChart) AddContextMenuItem(item *fyne.MenuItem) {
+	if chart.base == nil || item == nil {
+		return
+	}
+	chart.base.AddContextMenuItem(item)
+}
+
+// SetContextMenuImageCopier adds a copy image action to the context menu that puts the image on the clipboard with f
+// The clipboard of Fyne only supports text, so without it the menu offers to copy the chart as SVG document text
+func (chart *propChart) SetContextMenuImageCopier(f func(img image.Image) (err error)) {
+	if chart.base == nil {
+		return
+	}
+	chart.base.SetContextMenuImageCopier(f)
+}
+
 // SetTitle sets the title of the chart, which will be displayed at the top
 func (chart *propChart) SetTitle(l string) {
 	if chart.base == nil {
@@ -99,3 +222,14 @@ func (chart *propChart) SetLegendStyle(loc style.LegendLocation, labelStyle styl
 	}
 	chart.base.SetLegendStyle(loc, labelStyle, interactive)
 }
+
+// Spec describes the configuration and the data of the chart as chart specification, see package spec
+// Treemap and sunburst charts cannot be described and return an error
+func (chart *propChart) Spec() (s spec.Chart, err error) {
+	if chart.base == nil {
+		err = errors.New("chart not initialized")
+		return
+	}
+	s, err = chart.base.Spec()
+	return
+}
@@ -1,6 +1,11 @@
 package prop
 
 import (
+	"errors"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"github.com/s-daehling/fyne-charts/internal/bind"
 	"github.com/s-daehling/fyne-charts/internal/prop"
 	"github.com/s-daehling/fyne-charts/pkg/data"
 	"github.com/s-daehling/fyne-charts/pkg/style"
@@ -9,6 +14,7 @@ import (
 // Series represents a proportional series over a proportional axis
 type Series struct {
 	ser *prop.Series
+	bnd *bind.ListBinding
 }
 
 // NewSeries creates a new Series and populates it with input data
@@ -27,6 +33,63 @@ func NewSeries(name string, input []data.ProportionalPoint) (ps *Series, err err
 	return
 }
 
+// NewSeriesWithData creates a new Series that is populated with the items of a data binding list
+// The items must be of type data.ProportionalPoint or *data.ProportionalPoint.
+// Items with a C that already exists are ignored. Val is restricted to Val>=0
+// The series is updated every time the list changes. Appended items are added to the series, all other changes replace the data.
+// A change that contains invalid items is ignored.
+// The series stops listening to the list if it is removed from its chart or Release is called
+// An error is returned if the current items of the list are invalid
+func NewSeriesWithData(name string, list binding.UntypedList) (ps *Series, err error) {
+	ps = &Series{
+		ser: prop.EmptyProportionalSeries(name),
+	}
+	ps.bnd, err = bind.NewListBinding(list, proportionalPointItem, func(vals []any, reset bool) (err error) {
+		input := make([]data.ProportionalPoint, len(vals))
+		for i := range vals {
+			input[i] = vals[i].(data.ProportionalPoint)
+		}
+		if reset {
+			err = ps.ser.ReplaceData(input)
+			return
+		}
+		err = ps.ser.AddData(input)
+		return
+	})
+	if err != nil {
+		ps = nil
+		return
+	}
+	ps.ser.SetReleaseFunc(ps.Release)
+	return
+}
+
+func proportionalPointItem(item any) (v any, err error) {
+	switch p := item.(type) {
+	case data.ProportionalPoint:
+		v = p
+	case *data.ProportionalPoint:
+		if p == nil {
+			err = errors.New("invalid list item")
+			return
+		}
+		v = *p
+	default:
+		err = errors.New("invalid list item")
+	}
+	return
+}
+
+// Release stops listening to the bound data list
+// The series is released automatically if it is removed from its chart with RemoveSeries
+func (ps *Series) Release() {
+	if ps.bnd == nil {
+		return
+	}
+	ps.bnd.Unbind()
+	ps.bnd = nil
+}
+
 // Name returns the name of the series
 func (ps *Series) Name() (n string) {
 	if ps.ser == nil {
@@ -41,6 +104,54 @@ func (ps *Series) SetValueTextStyle(textStyle style.ChartTextStyle) {
 	ps.ser.SetValTextStyle(textStyle)
 }
 
+// SetLabelOptions defines content and placement of value labels
+// Labels can show the percentage (default), the value, the category or a custom text created by a format function.
+// On pie charts labels can be placed outside of the pie with leader lines; overlapping labels are moved apart
+func (ps *Series) SetLabelOptions(opts style.LabelOptions) {
+	if ps.ser == nil {
+		return
+	}
+	ps.ser.SetLabelOptions(opts)
+}
+
+// SetSortByValue defines whether the points are displayed in order of descending value
+func (ps *Series) SetSortByValue(sortByValue bool) {
+	if ps.ser == nil {
+		return
+	}
+	ps.ser.SetSortByValue(sortByValue)
+}
+
+// SetTopN limits the display to the n points with the largest values
+// All other points are aggregated into the other category. n = 0 removes the limit.
+// An error is returned if n is negative
+func (ps *Series) SetTopN(n int) (err error) {
+	if ps.ser == nil {
+		return
+	}
+	err = ps.ser.SetTopN(n)
+	return
+}
+
+// SetThreshold aggregates all points with a share of the total below threshold (e.g. 0.02 for 2%) into the other category
+// threshold = 0 removes the limit. An error is returned if threshold is outside [0,1]
+func (ps *Series) SetThreshold(threshold float64) (err error) {
+	if ps.ser == nil {
+		return
+	}
+	err = ps.ser.SetThreshold(threshold)
+	return
+}
+
+// SetOtherCategory changes name (default "Other") and color of the category that aggregates collapsed points
+// The tooltip of the other category lists all collapsed categories
+func (ps *Series) SetOtherCategory(name string, colName fyne.ThemeColorName) {
+	if ps.ser == nil {
+		return
+	}
+	ps.ser.SetOtherCategory(name, colName)
+}
+
 // Show makes the elements of the series visible
 func (ps *Series) Show() {
 	if ps.ser == nil {
@@ -75,6 +186,17 @@ func (ps *Series) DeleteDataInRange(cat []string) (c int) {
 	return
 }
 
+// Data returns a copy of the data points of the series
+func (ps *Series) Data() (d []data.ProportionalPoint) {
+	if ps.ser == nil {
+		return
+	}
+	ps.ser.Lock()
+	d = ps.ser.Data()
+	ps.ser.Unlock()
+	return
+}
+
 // AddData adds data points to the series.
 // The method checks for duplicates (i.e. data points with same C).
 // Data points with a C that already exists, will be ignored.
@@ -0,0 +1,21 @@
+package prop
+
+import (
+	"github.com/s-daehling/fyne-charts/internal/prop"
+)
+
+// SunburstChart shows hierarchical proportional data as nested rings around the currently shown node
+// Tapping a segment drills down into the respective node; tapping the center or the breadcrumb at the top navigates back up.
+type SunburstChart struct {
+	hierarchyChart
+}
+
+// NewSunburstChart returns an initialized SunburstChart
+func NewSunburstChart(title string) (sunburstChart *SunburstChart) {
+	sunburstChart = &SunburstChart{
+		hierarchyChart: emptyHierarchyChart(prop.PolarPlane),
+	}
+	sunburstChart.ExtendBaseWidget(sunburstChart)
+	sunburstChart.SetTitle(title)
+	return
+}
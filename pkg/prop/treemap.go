@@ -0,0 +1,21 @@
+package prop
+
+import (
+	"github.com/s-daehling/fyne-charts/internal/prop"
+)
+
+// TreemapChart shows hierarchical proportional data as nested rectangles with a squarified layout
+// Tapping a rectangle drills down into the respective node; the breadcrumb at the top navigates back up.
+type TreemapChart struct {
+	hierarchyChart
+}
+
+// NewTreemapChart returns an initialized TreemapChart
+func NewTreemapChart(title string) (treemapChart *TreemapChart) {
+	treemapChart = &TreemapChart{
+		hierarchyChart: emptyHierarchyChart(prop.CartesianPlane),
+	}
+	treemapChart.ExtendBaseWidget(treemapChart)
+	treemapChart.SetTitle(title)
+	return
+}
@@ -0,0 +1,21 @@
+package prop
+
+import (
+	"github.com/s-daehling/fyne-charts/internal/prop"
+)
+
+// WaffleChart implements a 10x10 grid of cells; each point of a proportional series colors a number of cells according to its share
+type WaffleChart struct {
+	propChart
+}
+
+// NewWaffleChart returns an initialized WaffleChart
+func NewWaffleChart(title string) (waffleChart *WaffleChart) {
+	waffleChart = &WaffleChart{
+		propChart: emptyPropChart(prop.CartesianPlane),
+	}
+	waffleChart.base.SetLayout(prop.WaffleLayout)
+	waffleChart.ExtendBaseWidget(waffleChart)
+	waffleChart.SetTitle(title)
+	return
+}
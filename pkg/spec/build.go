@@ -0,0 +1,503 @@
+package spec
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/internal/spec"
+	"github.com/s-daehling/fyne-charts/pkg/coord"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+	"github.com/s-daehling/fyne-charts/pkg/prop"
+	"github.com/s-daehling/fyne-charts/pkg/style"
+)
+
+// legendChart is implemented by all charts that can be built
+type legendChart interface {
+	HideLegend()
+	SetLegendStyle(loc style.LegendLocation, labelStyle style.ChartTextStyle, interactive bool)
+}
+
+type numericalChart interface {
+	AddLineSeries(nps *coord.NumericalPointSeries, showDots bool) (err error)
+	AddAreaSeries(nps *coord.NumericalPointSeries, showDots bool) (err error)
+	AddScatterSeries(nps *coord.NumericalPointSeries) (err error)
+	AddLollipopSeries(nps *coord.NumericalPointSeries) (err error)
+	AddBarSeries(nps *coord.NumericalPointSeries, barWidth float64) (err error)
+}
+
+type numericalStatChart interface {
+	AddCandleStickSeries(ncs *coord.NumericalCandleStickSeries) (err error)
+	AddBoxSeries(nbs *coord.NumericalBoxSeries) (err error)
+}
+
+type temporalChart interface {
+	AddLineSeries(tps *coord.TemporalPointSeries, showDots bool) (err error)
+	AddAreaSeries(tps *coord.TemporalPointSeries, showDots bool) (err error)
+	AddScatterSeries(tps *coord.TemporalPointSeries) (err error)
+	AddLollipopSeries(tps *coord.TemporalPointSeries) (err error)
+	AddBarSeries(tps *coord.TemporalPointSeries, barWidth time.Duration) (err error)
+}
+
+type temporalStatChart interface {
+	AddCandleStickSeries(tcs *coord.TemporalCandleStickSeries) (err error)
+	AddBoxSeries(tbs *coord.TemporalBoxSeries) (err error)
+}
+
+type categoricalChart interface {
+	AddScatterSeries(cps *coord.CategoricalPointSeries) (err error)
+	AddLollipopSeries(cps *coord.CategoricalPointSeries) (err error)
+	AddBarSeries(cps *coord.CategoricalPointSeries) (err error)
+}
+
+// pointStyler is implemented by the point series of all from-axis types
+type pointStyler interface {
+	SetLineWidth(lw float32)
+	SetDotSize(ds float32)
+}
+
+// Build validates s and creates the corresponding chart of the coord or prop package, e.g.
+// *coord.CartesianNumericalChart for cartesian-numerical or *prop.PieChart for pie
+// The data of sources is read while the chart is built
+// An error of type *Error is returned if s is invalid or the chart rejects a setting or series
+func Build(s Chart) (obj fyne.CanvasObject, err error) {
+	err = Validate(s)
+	if err != nil {
+		return
+	}
+	kind := chartKinds[s.Type]
+	switch s.Type {
+	case CartesianNumerical:
+		obj, err = buildCartesianNumerical(s, kind)
+	case CartesianTemporal:
+		obj, err = buildCartesianTemporal(s, kind)
+	case CartesianCategorical:
+		obj, err = buildCartesianCategorical(s, kind)
+	case PolarNumerical:
+		obj, err = buildPolarNumerical(s, kind)
+	case PolarTemporal:
+		obj, err = buildPolarTemporal(s, kind)
+	case PolarCategorical:
+		obj, err = buildPolarCategorical(s, kind)
+	default:
+		obj, err = buildProportional(s, kind)
+	}
+	if err != nil {
+		obj = nil
+	}
+	return
+}
+
+func buildCartesianNumerical(s Chart, kind chartKind) (c *coord.CartesianNumericalChart, err error) {
+	c = coord.NewCartesianNumericalChart(s.Title)
+	c.BeginUpdate()
+	defer c.EndUpdate()
+	applyLegend(c, s.Legend)
+	err = numericalAxis("x", s.X, c.SetXAxisLabel, c.SetXRange, c.SetXTicks, c.SetAutoXTicks)
+	if err == nil {
+		err = numericalAxis("y", s.Y, c.SetYAxisLabel, c.SetYRange, c.SetYTicks, c.SetAutoYTicks)
+	}
+	for i := 0; err == nil && i < len(s.Series); i++ {
+		err = addNumericalSeries(c, s.Series[i], fmt.Sprintf("series[%d]", i), kind)
+	}
+	return
+}
+
+func buildCartesianTemporal(s Chart, kind chartKind) (c *coord.CartesianTemporalChart, err error) {
+	c = coord.NewCartesianTemporalChart(s.Title)
+	c.BeginUpdate()
+	defer c.EndUpdate()
+	applyLegend(c, s.Legend)
+	err = temporalAxis("x", s.X, c.SetTAxisLabel, c.SetTRange, c.SetTTicks, c.SetAutoTTicks)
+	if err == nil {
+		err = numericalAxis("y", s.Y, c.SetYAxisLabel, c.SetYRange, c.SetYTicks, c.SetAutoYTicks)
+	}
+	for i := 0; err == nil && i < len(s.Series); i++ {
+		err = addTemporalSeries(c, s.Series[i], fmt.Sprintf("series[%d]", i), kind)
+	}
+	return
+}
+
+func buildCartesianCategorical(s Chart, kind chartKind) (c *coord.CartesianCategoricalChart, err error) {
+	c = coord.NewCartesianCategoricalChart(s.Title)
+	c.BeginUpdate()
+	defer c.EndUpdate()
+	applyLegend(c, s.Legend)
+	c.SetOrientation(s.Transposed)
+	err = categoricalAxis("x", s.X, c.SetCAxisLabel, c.SetCRange)
+	if err == nil {
+		err = numericalAxis("y", s.Y, c.SetYAxisLabel, c.SetYRange, c.SetYTicks, c.SetAutoYTicks)
+	}
+	for i := 0; err == nil && i < len(s.Series); i++ {
+		err = addCategoricalSeries(c, s.Series[i], fmt.Sprintf("series[%d]", i), kind)
+	}
+	return
+}
+
+func buildPolarNumerical(s Chart, kind chartKind) (c *coord.PolarNumericalChart, err error) {
+	c = coord.NewPolarNumericalChart(s.Title)
+	c.BeginUpdate()
+	defer c.EndUpdate()
+	applyLegend(c, s.Legend)
+	err = numericalAxis("x", s.X, c.SetPhiAxisLabel, nil, c.SetPhiTicks, c.SetAutoPhiTicks)
+	if err == nil {
+		err = numericalAxis("y", s.Y, c.SetRAxisLabel, polarRange(c.SetRRange), c.SetRTicks, c.SetAutoRTicks)
+	}
+	for i := 0; err == nil && i < len(s.Series); i++ {
+		err = addNumericalSeries(c, s.Series[i], fmt.Sprintf("series[%d]", i), kind)
+	}
+	return
+}
+
+func buildPolarTemporal(s Chart, kind chartKind) (c *coord.PolarTemporalChart, err error) {
+	c = coord.NewPolarTemporalChart(s.Title)
+	c.BeginUpdate()
+	defer c.EndUpdate()
+	applyLegend(c, s.Legend)
+	err = temporalAxis("x", s.X, c.SetTAxisLabel, c.SetTRange, c.SetTTicks, c.SetAutoTTicks)
+	if err == nil {
+		err = numericalAxis("y", s.Y, c.SetRAxisLabel, polarRange(c.SetRRange), c.SetRTicks, c.SetAutoRTicks)
+	}
+	for i := 0; err == nil && i < len(s.Series); i++ {
+		err = addTemporalSeries(c, s.Series[i], fmt.Sprintf("series[%d]", i), kind)
+	}
+	return
+}
+
+func buildPolarCategorical(s Chart, kind chartKind) (c *coord.PolarCategoricalChart, err error) {
+	c = coord.NewPolarCategoricalChart(s.Title)
+	c.BeginUpdate()
+	defer c.EndUpdate()
+	applyLegend(c, s.Legend)
+	err = categoricalAxis("x", s.X, c.SetCAxisLabel, c.SetCRange)
+	if err == nil {
+		err = numericalAxis("y", s.Y, c.SetRAxisLabel, polarRange(c.SetRRange), c.SetRTicks, c.SetAutoRTicks)
+	}
+	for i := 0; err == nil && i < len(s.Series); i++ {
+		err = addCategoricalSeries(c, s.Series[i], fmt.Sprintf("series[%d]", i), kind)
+	}
+	return
+}
+
+// proportionalChart is implemented by pie, bar, funnel and waffle charts
+type proportionalChart interface {
+	fyne.CanvasObject
+	legendChart
+	BeginUpdate()
+	EndUpdate()
+	AddSeries(ps *prop.Series) (err error)
+}
+
+func buildProportional(s Chart, kind chartKind) (c proportionalChart, err error) {
+	switch s.Type {
+	case Pie:
+		c = prop.NewPieChart(s.Title)
+	case Funnel:
+		c = prop.NewFunnelChart(s.Title)
+	case Waffle:
+		c = prop.NewWaffleChart(s.Title)
+	default:
+		bc := prop.NewBarChart(s.Title)
+		bc.SetOrientation(s.Transposed)
+		c = bc
+	}
+	c.BeginUpdate()
+	defer c.EndUpdate()
+	applyLegend(c, s.Legend)
+	for i, ser := range s.Series {
+		path := fmt.Sprintf("series[%d]", i)
+		var d any
+		d, err = seriesData(ser, path, kind, true)
+		if err != nil {
+			return
+		}
+		var ps *prop.Series
+		ps, err = prop.NewSeries(ser.Name, d.([]data.ProportionalPoint))
+		if err == nil {
+			err = c.AddSeries(ps)
+		}
+		if err != nil {
+			err = wrapError(path, err)
+			return
+		}
+	}
+	return
+}
+
+func applyLegend(c legendChart, l *Legend) {
+	if l == nil {
+		return
+	}
+	loc := style.LegendLocationRight
+	if l.Location != "" {
+		loc = style.LegendLocation(l.Location)
+	}
+	c.SetLegendStyle(loc, style.DefaultLegendTextStyle(), l.Interactive == nil || *l.Interactive)
+	if l.Visible != nil && !*l.Visible {
+		c.HideLegend()
+	}
+}
+
+// polarRange adapts the range setter of the r-axis, which has no minimum
+func polarRange(setRange func(max float64) (err error)) (f func(min float64, max float64) (err error)) {
+	f = func(min float64, max float64) (err error) {
+		err = setRange(max)
+		return
+	}
+	return
+}
+
+// numericalAxis applies ax with the setters of a numerical axis; setRange is nil for axes without range
+func numericalAxis(path string, ax *Axis, setLabel func(l string), setRange func(min float64, max float64) (err error),
+	setTicks func(ts []data.NumericalTick), setAutoTicks func(autoSupportLine bool)) (err error) {
+	if ax == nil {
+		return
+	}
+	setLabel(ax.Label)
+	if ax.Max != nil && setRange != nil {
+		min := 0.0
+		if ax.Min != nil {
+			min = *ax.Min
+		}
+		err = setRange(min, *ax.Max)
+		if err != nil {
+			err = wrapError(path, err)
+			return
+		}
+	}
+	if len(ax.Ticks) > 0 {
+		ts := make([]data.NumericalTick, len(ax.Ticks))
+		for i, t := range ax.Ticks {
+			ts[i] = data.NumericalTick{N: *t.N, SupportLine: t.SupportLine}
+		}
+		setTicks(ts)
+	} else if ax.SupportLines != nil {
+		setAutoTicks(*ax.SupportLines)
+	}
+	return
+}
+
+// temporalAxis applies ax with the setters of a temporal axis; times have been checked by Validate
+func temporalAxis(path string, ax *Axis, setLabel func(l string), setRange func(min time.Time, max time.Time) (err error),
+	setTicks func(ts []data.TemporalTick, format string), setAutoTicks func(autoSupportLine bool)) (err error) {
+	if ax == nil {
+		return
+	}
+	setLabel(ax.Label)
+	if ax.TMin != "" {
+		min, _ := time.Parse(time.RFC3339, ax.TMin)
+		max, _ := time.Parse(time.RFC3339, ax.TMax)
+		err = setRange(min, max)
+		if err != nil {
+			err = wrapError(path, err)
+			return
+		}
+	}
+	if len(ax.Ticks) > 0 {
+		ts := make([]data.TemporalTick, len(ax.Ticks))
+		for i, t := range ax.Ticks {
+			tt, _ := time.Parse(time.RFC3339, t.T)
+			ts[i] = data.TemporalTick{T: tt, SupportLine: t.SupportLine}
+		}
+		setTicks(ts, ax.TickFormat)
+	} else if ax.SupportLines != nil {
+		setAutoTicks(*ax.SupportLines)
+	}
+	return
+}
+
+func categoricalAxis(path string, ax *Axis, setLabel func(l string), setRange func(cs []string) (err error)) (err error) {
+	if ax == nil {
+		return
+	}
+	setLabel(ax.Label)
+	if ax.Categories != nil {
+		err = setRange(ax.Categories)
+		if err != nil {
+			err = wrapError(path, err)
+		}
+	}
+	return
+}
+
+func addNumericalSeries(c numericalChart, ser Series, path string, kind chartKind) (err error) {
+	d, err := seriesData(ser, path, kind, true)
+	if err != nil {
+		return
+	}
+	switch ser.Type {
+	case CandleStickSeries, BoxSeries:
+		sc, ok := c.(numericalStatChart)
+		if !ok {
+			err = notSupportedBySeries(path+".type", ser.Type)
+			return
+		}
+		if ser.Type == CandleStickSeries {
+			var cs *coord.NumericalCandleStickSeries
+			cs, err = coord.NewNumericalCandleStickSeries(ser.Name, d.([]data.NumericalCandleStick))
+			if err == nil {
+				styleStatSeries(cs, ser)
+				err = sc.AddCandleStickSeries(cs)
+			}
+		} else {
+			var bs *coord.NumericalBoxSeries
+			bs, err = coord.NewNumericalBoxSeries(ser.Name, colorName(ser), d.([]data.NumericalBox))
+			if err == nil {
+				styleStatSeries(bs, ser)
+				err = sc.AddBoxSeries(bs)
+			}
+		}
+	default:
+		var ps *coord.NumericalPointSeries
+		ps, err = coord.NewNumericalPointSeries(ser.Name, colorName(ser), d.([]data.NumericalPoint))
+		if err != nil {
+			break
+		}
+		stylePointSeries(ps, ser)
+		switch ser.Type {
+		case LineSeries:
+			err = c.AddLineSeries(ps, ser.ShowDots)
+		case AreaSeries:
+			err = c.AddAreaSeries(ps, ser.ShowDots)
+		case ScatterSeries:
+			err = c.AddScatterSeries(ps)
+		case LollipopSeries:
+			err = c.AddLollipopSeries(ps)
+		case BarSeries:
+			err = c.AddBarSeries(ps, *ser.BarWidth)
+		}
+	}
+	if err != nil {
+		err = wrapError(path, err)
+	}
+	return
+}
+
+func addTemporalSeries(c temporalChart, ser Series, path string, kind chartKind) (err error) {
+	d, err := seriesData(ser, path, kind, true)
+	if err != nil {
+		return
+	}
+	switch ser.Type {
+	case CandleStickSeries, BoxSeries:
+		sc, ok := c.(temporalStatChart)
+		if !ok {
+			err = notSupportedBySeries(path+".type", ser.Type)
+			return
+		}
+		if ser.Type == CandleStickSeries {
+			var cs *coord.TemporalCandleStickSeries
+			cs, err = coord.NewTemporalCandleStickSeries(ser.Name, d.([]data.TemporalCandleStick))
+			if err == nil {
+				styleStatSeries(cs, ser)
+				err = sc.AddCandleStickSeries(cs)
+			}
+		} else {
+			var bs *coord.TemporalBoxSeries
+			bs, err = coord.NewTemporalBoxSeries(ser.Name, colorName(ser), d.([]data.TemporalBox))
+			if err == nil {
+				styleStatSeries(bs, ser)
+				err = sc.AddBoxSeries(bs)
+			}
+		}
+	default:
+		var ps *coord.TemporalPointSeries
+		ps, err = coord.NewTemporalPointSeries(ser.Name, colorName(ser), d.([]data.TemporalPoint))
+		if err != nil {
+			break
+		}
+		stylePointSeries(ps, ser)
+		switch ser.Type {
+		case LineSeries:
+			err = c.AddLineSeries(ps, ser.ShowDots)
+		case AreaSeries:
+			err = c.AddAreaSeries(ps, ser.ShowDots)
+		case ScatterSeries:
+			err = c.AddScatterSeries(ps)
+		case LollipopSeries:
+			err = c.AddLollipopSeries(ps)
+		case BarSeries:
+			err = c.AddBarSeries(ps, time.Duration(*ser.BarWidth*float64(time.Second)))
+		}
+	}
+	if err != nil {
+		err = wrapError(path, err)
+	}
+	return
+}
+
+func addCategoricalSeries(c categoricalChart, ser Series, path string, kind chartKind) (err error) {
+	d, err := seriesData(ser, path, kind, true)
+	if err != nil {
+		return
+	}
+	if ser.Type == BoxSeries {
+		bc, ok := c.(interface {
+			AddBoxSeries(cbs *coord.CategoricalBoxSeries) (err error)
+		})
+		if !ok {
+			err = notSupportedBySeries(path+".type", ser.Type)
+			return
+		}
+		var bs *coord.CategoricalBoxSeries
+		bs, err = coord.NewCategoricalBoxSeries(ser.Name, colorName(ser), d.([]data.CategoricalBox))
+		if err == nil {
+			styleStatSeries(bs, ser)
+			err = bc.AddBoxSeries(bs)
+		}
+	} else {
+		var ps *coord.CategoricalPointSeries
+		ps, err = coord.NewCategoricalPointSeries(ser.Name, colorName(ser), d.([]data.CategoricalPoint))
+		if err == nil {
+			stylePointSeries(ps, ser)
+			switch ser.Type {
+			case ScatterSeries:
+				err = c.AddScatterSeries(ps)
+			case LollipopSeries:
+				err = c.AddLollipopSeries(ps)
+			case BarSeries:
+				err = c.AddBarSeries(ps)
+			}
+		}
+	}
+	if err != nil {
+		err = wrapError(path, err)
+	}
+	return
+}
+
+func stylePointSeries(ps pointStyler, ser Series) {
+	if ser.LineWidth != nil {
+		ps.SetLineWidth(float32(*ser.LineWidth))
+	}
+	if ser.DotSize != nil {
+		ps.SetDotSize(float32(*ser.DotSize))
+	}
+}
+
+func styleStatSeries(ss interface{ SetLineWidth(lw float32) }, ser Series) {
+	if ser.LineWidth != nil {
+		ss.SetLineWidth(float32(*ser.LineWidth))
+	}
+}
+
+// colorName gives the theme color of ser; primary if no color is given
+func colorName(ser Series) (colName fyne.ThemeColorName) {
+	colName = theme.ColorNamePrimary
+	if ser.Color != "" {
+		colName = fyne.ThemeColorName(ser.Color)
+	}
+	return
+}
+
+// wrapError reports errors of the chart at path unless they already carry a path
+func wrapError(path string, err error) (wErr error) {
+	wErr = err
+	var sErr *spec.Error
+	if !errors.As(err, &sErr) {
+		wErr = &spec.Error{Path: path, Err: err}
+	}
+	return
+}
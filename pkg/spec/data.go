@@ -0,0 +1,97 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/s-daehling/fyne-charts/internal/spec"
+	"github.com/s-daehling/fyne-charts/pkg/data"
+	dataio "github.com/s-daehling/fyne-charts/pkg/data/io"
+)
+
+// seriesData gives the data points of ser as slice of the data type of the series
+// The source of the series is only read if load is set
+func seriesData(ser Series, path string, kind chartKind, load bool) (d any, err error) {
+	switch {
+	case kind.from == proportional:
+		d, err = records[data.ProportionalPoint](ser, path, load)
+	case ser.Type == CandleStickSeries && kind.from == numericalFrom:
+		d, err = records[data.NumericalCandleStick](ser, path, load)
+	case ser.Type == CandleStickSeries:
+		d, err = records[data.TemporalCandleStick](ser, path, load)
+	case ser.Type == BoxSeries && kind.from == numericalFrom:
+		d, err = records[data.NumericalBox](ser, path, load)
+	case ser.Type == BoxSeries && kind.from == temporalFrom:
+		d, err = records[data.TemporalBox](ser, path, load)
+	case ser.Type == BoxSeries:
+		d, err = records[data.CategoricalBox](ser, path, load)
+	case kind.from == numericalFrom:
+		d, err = records[data.NumericalPoint](ser, path, load)
+	case kind.from == temporalFrom:
+		d, err = records[data.TemporalPoint](ser, path, load)
+	default:
+		d, err = records[data.CategoricalPoint](ser, path, load)
+	}
+	return
+}
+
+// records reads the inline data or the source of ser
+func records[T dataio.Record](ser Series, path string, load bool) (rs []T, err error) {
+	if ser.Source != nil {
+		if load {
+			rs, err = readSource[T](*ser.Source, path+".source")
+		}
+		return
+	}
+	b, err := json.Marshal(ser.Data)
+	if err != nil {
+		err = &spec.Error{Path: path + ".data", Err: err}
+		return
+	}
+	rs, err = dataio.ReadJSON[T](bytes.NewReader(b), dataio.DefaultOptions())
+	var pErr *dataio.ParseError
+	if errors.As(err, &pErr) {
+		p := fmt.Sprintf("%s.data[%d]", path, pErr.Row-1)
+		err = &spec.Error{Path: joinPath(p, pErr.Column), Err: pErr.Err}
+	} else if err != nil {
+		err = &spec.Error{Path: path + ".data", Err: err}
+	}
+	return
+}
+
+// readSource reads the data file of src with the default options of the data/io package as overridden by src
+func readSource[T dataio.Record](src Source, path string) (rs []T, err error) {
+	opts := dataio.DefaultOptions()
+	if src.Delimiter != "" {
+		opts.Delimiter = []rune(src.Delimiter)[0]
+	}
+	if src.Header != nil {
+		opts.Header = *src.Header
+	}
+	opts.Columns = src.Columns
+	if src.TimeLayout != "" {
+		opts.TimeLayout = src.TimeLayout
+	}
+	if sourceFormat(src) == "csv" {
+		rs, err = dataio.ReadCSVFile[T](src.Path, opts)
+	} else {
+		rs, err = dataio.ReadJSONFile[T](src.Path, opts)
+	}
+	if err != nil {
+		err = &spec.Error{Path: path, Err: err}
+	}
+	return
+}
+
+// sourceFormat gives the format of src or the file extension if the format is not given
+func sourceFormat(src Source) (f string) {
+	f = strings.ToLower(src.Format)
+	if f == "" {
+		f = strings.TrimPrefix(strings.ToLower(filepath.Ext(src.Path)), ".")
+	}
+	return
+}
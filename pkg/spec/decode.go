@@ -0,0 +1,186 @@
+package spec
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/s-daehling/fyne-charts/internal/spec"
+)
+
+// decode assigns the generic document value in to the value out points to
+// Keys of objects are matched with the JSON names of the fields; unknown keys are reported as error
+func decode(path string, in any, out any) (err error) {
+	err = decodeValue(path, in, reflect.ValueOf(out).Elem())
+	return
+}
+
+func decodeValue(path string, in any, v reflect.Value) (err error) {
+	if in == nil {
+		return
+	}
+	switch v.Kind() {
+	case reflect.Pointer:
+		elem := reflect.New(v.Type().Elem())
+		err = decodeValue(path, in, elem.Elem())
+		if err == nil {
+			v.Set(elem)
+		}
+	case reflect.Struct:
+		obj, ok := in.(map[string]any)
+		if !ok {
+			err = typeError(path, "object", in)
+			return
+		}
+		for _, k := range sortedKeys(obj) {
+			i := fieldIndex(v.Type(), k)
+			if i < 0 {
+				err = &spec.Error{Path: joinPath(path, k), Err: errors.New("unknown key")}
+				return
+			}
+			err = decodeValue(joinPath(path, k), obj[k], v.Field(i))
+			if err != nil {
+				return
+			}
+		}
+	case reflect.Slice:
+		arr, ok := in.([]any)
+		if !ok {
+			err = typeError(path, "array", in)
+			return
+		}
+		s := reflect.MakeSlice(v.Type(), len(arr), len(arr))
+		for i := range arr {
+			err = decodeValue(fmt.Sprintf("%s[%d]", path, i), arr[i], s.Index(i))
+			if err != nil {
+				return
+			}
+		}
+		v.Set(s)
+	case reflect.Map:
+		obj, ok := in.(map[string]any)
+		if !ok {
+			err = typeError(path, "object", in)
+			return
+		}
+		m := reflect.MakeMapWithSize(v.Type(), len(obj))
+		for _, k := range sortedKeys(obj) {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			err = decodeValue(joinPath(path, k), obj[k], elem)
+			if err != nil {
+				return
+			}
+			m.SetMapIndex(reflect.ValueOf(k), elem)
+		}
+		v.Set(m)
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(in))
+	case reflect.String:
+		s, ok := in.(string)
+		if !ok {
+			err = typeError(path, "string", in)
+			return
+		}
+		v.SetString(s)
+	case reflect.Bool:
+		b, ok := in.(bool)
+		if !ok {
+			err = typeError(path, "boolean", in)
+			return
+		}
+		v.SetBool(b)
+	case reflect.Float64:
+		f, ok := in.(float64)
+		if !ok {
+			err = typeError(path, "number", in)
+			return
+		}
+		v.SetFloat(f)
+	}
+	return
+}
+
+// fieldIndex gives the index of the field of typ with the JSON name key or -1
+func fieldIndex(typ reflect.Type, key string) (i int) {
+	for i = 0; i < typ.NumField(); i++ {
+		name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+		if name == key {
+			return
+		}
+	}
+	i = -1
+	return
+}
+
+func sortedKeys(obj map[string]any) (keys []string) {
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return
+}
+
+func joinPath(path string, key string) (p string) {
+	p = key
+	if path != "" {
+		p = path + "." + key
+	}
+	return
+}
+
+func typeError(path string, expected string, in any) (err error) {
+	found := "value"
+	switch in.(type) {
+	case string:
+		found = "string"
+	case float64:
+		found = "number"
+	case bool:
+		found = "boolean"
+	case []any:
+		found = "array"
+	case map[string]any:
+		found = "object"
+	}
+	err = &spec.Error{Path: path, Err: fmt.Errorf("expected %s, found %s", expected, found)}
+	return
+}
+
+// normalizeYAML converts a decoded YAML document to the values of a decoded JSON document
+// Integers become numbers, timestamps RFC 3339 strings and keys of mappings strings
+func normalizeYAML(in any) (out any) {
+	switch in := in.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(in))
+		for k, v := range in {
+			m[k] = normalizeYAML(v)
+		}
+		out = m
+	case map[any]any:
+		m := make(map[string]any, len(in))
+		for k, v := range in {
+			m[fmt.Sprint(k)] = normalizeYAML(v)
+		}
+		out = m
+	case []any:
+		arr := make([]any, len(in))
+		for i := range in {
+			arr[i] = normalizeYAML(in[i])
+		}
+		out = arr
+	case int:
+		out = float64(in)
+	case int64:
+		out = float64(in)
+	case uint64:
+		out = float64(in)
+	case time.Time:
+		out = in.Format(time.RFC3339Nano)
+	default:
+		out = in
+	}
+	return
+}
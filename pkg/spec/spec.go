@@ -0,0 +1,169 @@
+package spec
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/s-daehling/fyne-charts/internal/spec"
+	"gopkg.in/yaml.v3"
+)
+
+// Chart describes a chart with its type, title, axes, legend and series
+// X describes the x, t, c or phi axis and Y the y or r axis of coordinate charts
+type Chart = spec.Chart
+
+// Axis describes the label, range and ticks of an axis
+// Min and Max are used by numerical axes and the value axis, TMin and TMax by temporal axes and Categories
+// by categorical axes; polar value axes only use Max. Times are given in RFC 3339 format
+// Ticks replace the automatic ticks; TickFormat is the time layout of the labels of temporal ticks
+// SupportLines defines whether automatic ticks have support lines (default true)
+type Axis = spec.Axis
+
+// Tick describes a manually placed tick; N is used by numerical axes and T by temporal axes
+type Tick = spec.Tick
+
+// Legend describes the legend; it is visible (default true) at Location top, bottom, left or right (default)
+// and series can be shown and hidden by tapping their entry if Interactive (default true)
+type Legend = spec.Legend
+
+// Series describes a series with its type, style and data
+// Color is the name of a theme color, e.g. primary or foreground (default primary)
+// ShowDots applies to line and area series; BarWidth to bar series of numerical (in units of x) and temporal
+// (in seconds) charts. Data contains the data points in the format of the JSON documents of the data/io package,
+// e.g. {"N": 1, "Val": 2}; alternatively Source references a file with the data
+// Series of proportional charts have no type; their data are proportional points with optional ColName
+type Series = spec.Series
+
+// Source describes a CSV or JSON file with the data of a series
+// Relative paths are resolved against the working directory; Format is csv or json and derived from the file
+// extension if empty. Delimiter, Header, Columns and TimeLayout override the default options of the data/io package
+type Source = spec.Source
+
+// Error reports an invalid element of a specification; Path locates the element, e.g. series[2].color
+type Error = spec.Error
+
+// ChartType names the kind of chart
+type ChartType = spec.ChartType
+
+const (
+	CartesianNumerical   = spec.CartesianNumerical
+	CartesianTemporal    = spec.CartesianTemporal
+	CartesianCategorical = spec.CartesianCategorical
+	PolarNumerical       = spec.PolarNumerical
+	PolarTemporal        = spec.PolarTemporal
+	PolarCategorical     = spec.PolarCategorical
+	Pie                  = spec.Pie
+	Bar                  = spec.Bar
+	Funnel               = spec.Funnel
+	Waffle               = spec.Waffle
+)
+
+// SeriesType names the kind of series of coordinate charts
+type SeriesType = spec.SeriesType
+
+const (
+	LineSeries        = spec.Line
+	AreaSeries        = spec.Area
+	ScatterSeries     = spec.Scatter
+	LollipopSeries    = spec.Lollipop
+	BarSeries         = spec.BarSeries
+	CandleStickSeries = spec.CandleStick
+	BoxSeries         = spec.Box
+)
+
+// ParseJSON reads a JSON chart specification from r and validates it
+// An error of type *Error is returned for unknown keys, values of the wrong type and invalid settings
+func ParseJSON(r io.Reader) (s Chart, err error) {
+	var doc any
+	dec := json.NewDecoder(r)
+	err = dec.Decode(&doc)
+	if err != nil {
+		return
+	}
+	err = decode("", doc, &s)
+	if err != nil {
+		return
+	}
+	err = Validate(s)
+	return
+}
+
+// ParseYAML reads a YAML chart specification from r and validates it like ParseJSON
+func ParseYAML(r io.Reader) (s Chart, err error) {
+	var doc any
+	err = yaml.NewDecoder(r).Decode(&doc)
+	if err != nil {
+		return
+	}
+	err = decode("", normalizeYAML(doc), &s)
+	if err != nil {
+		return
+	}
+	err = Validate(s)
+	return
+}
+
+// ReadFile reads the chart specification file at path like ParseJSON or ParseYAML
+// Files with the extension .yaml or .yml are read as YAML, all other files as JSON
+func ReadFile(path string) (s Chart, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		s, err = ParseYAML(f)
+	default:
+		s, err = ParseJSON(f)
+	}
+	return
+}
+
+// WriteJSON writes s as indented JSON document to w
+func WriteJSON(w io.Writer, s Chart) (err error) {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	err = enc.Encode(s)
+	return
+}
+
+// WriteYAML writes s as YAML document to w
+func WriteYAML(w io.Writer, s Chart) (err error) {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	err = enc.Encode(s)
+	if cErr := enc.Close(); err == nil {
+		err = cErr
+	}
+	return
+}
+
+// Describer is implemented by the charts of the coord and prop packages
+type Describer interface {
+	Spec() (s Chart, err error)
+}
+
+// FromChart describes the configuration and the data of a chart of the coord or prop package as specification
+// Settings that cannot be described, like text styles, are not part of the specification
+// An error is returned for charts and series types that are not supported by specifications and for
+// specifications that do not pass Validate
+func FromChart(chart Describer) (s Chart, err error) {
+	if chart == nil {
+		err = errors.New("chart not initialized")
+		return
+	}
+	s, err = chart.Spec()
+	if err != nil {
+		return
+	}
+	err = Validate(s)
+	if err != nil {
+		s = Chart{}
+	}
+	return
+}
@@ -0,0 +1,295 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"github.com/s-daehling/fyne-charts/pkg/coord"
+	"github.com/s-daehling/fyne-charts/pkg/prop"
+)
+
+const numericalDoc = `{
+  "type": "cartesian-numerical",
+  "title": "Sales",
+  "x": {"label": "week", "min": 0, "max": 10, "ticks": [{"n": 0}, {"n": 5, "supportLine": true}]},
+  "y": {"label": "units", "supportLines": false},
+  "legend": {"location": "bottom", "interactive": false},
+  "series": [
+    {"name": "north", "type": "line", "color": "primary", "showDots": true, "lineWidth": 2,
+     "data": [{"N": 1, "Val": 2}, {"N": 2, "Val": 3}]},
+    {"name": "south", "type": "bar", "color": "error", "barWidth": 0.5, "data": [{"N": 1, "Val": 1}]}
+  ]
+}`
+
+func TestParseErrors(t *testing.T) {
+	var tests = []struct {
+		doc  string
+		path string
+	}{
+		{`{"title": "no type"}`, "type"},
+		{`{"type": "gauge"}`, "type"},
+		{`{"type": "pie", "colour": "red"}`, "colour"},
+		{`{"type": "pie", "title": 3}`, "title"},
+		{`{"type": "pie", "x": {}}`, "x"},
+		{`{"type": "pie", "transposed": true}`, "transposed"},
+		{`{"type": "cartesian-numerical", "x": {"min": 1}}`, "x.max"},
+		{`{"type": "cartesian-numerical", "x": {"min": 2, "max": 1}}`, "x.max"},
+		{`{"type": "cartesian-numerical", "x": {"categories": ["a"]}}`, "x.categories"},
+		{`{"type": "cartesian-numerical", "y": {"ticks": [{"supportLine": true}]}}`, "y.ticks[0].n"},
+		{`{"type": "cartesian-temporal", "x": {"tMin": "2025-01-01", "tMax": "2025-02-01T00:00:00Z"}}`, "x.tMin"},
+		{`{"type": "cartesian-temporal", "x": {"ticks": [{"t": "2025-01-01T00:00:00Z"}]}}`, "x.tickFormat"},
+		{`{"type": "polar-numerical", "x": {"min": 0, "max": 1}}`, "x.min"},
+		{`{"type": "polar-categorical", "y": {"min": 0, "max": 1}}`, "y.min"},
+		{`{"type": "cartesian-numerical", "legend": {"location": "center"}}`, "legend.location"},
+		{`{"type": "cartesian-numerical", "series": [{"type": "line"}]}`, "series[0].name"},
+		{`{"type": "cartesian-numerical", "series": [{"name": "a", "type": "line"}, {"name": "a", "type": "line"}]}`,
+			"series[1].name"},
+		{`{"type": "cartesian-numerical", "series": [{"name": "a", "type": "gantt"}]}`, "series[0].type"},
+		{`{"type": "polar-numerical", "series": [{"name": "a", "type": "candlestick"}]}`, "series[0].type"},
+		{`{"type": "cartesian-numerical", "series": [{"name": "a", "type": "bar"}]}`, "series[0].barWidth"},
+		{`{"type": "cartesian-categorical", "series": [{"name": "a", "type": "bar", "barWidth": 1}]}`,
+			"series[0].barWidth"},
+		{`{"type": "cartesian-numerical", "series": [{"name": "a", "type": "scatter", "showDots": true}]}`,
+			"series[0].showDots"},
+		{`{"type": "cartesian-numerical", "series": [{"name": "a", "type": "line", "lineWidth": -1}]}`,
+			"series[0].lineWidth"},
+		{`{"type": "cartesian-numerical", "series": [{"name": "a", "type": "line", "data": [{"N": 1, "Val": 1}, {"N": 2}]}]}`,
+			"series[0].data[1].Val"},
+		{`{"type": "cartesian-numerical", "series": [{"name": "a", "type": "line", "data": {"N": 1}}]}`, "series[0].data"},
+		{`{"type": "cartesian-numerical", "series": [{"name": "a", "type": "line", "source": {"path": "a.xlsx"}}]}`,
+			"series[0].source.format"},
+		{`{"type": "cartesian-numerical", "series": [{"name": "a", "type": "line", "source": {"path": "a.csv", "sep": ";"}}]}`,
+			"series[0].source.sep"},
+		{`{"type": "pie", "series": [{"name": "a", "type": "bar"}]}`, "series[0].type"},
+		{`{"type": "pie", "series": [{"name": "a", "data": [{"C": "x", "Val": "many"}]}]}`, "series[0].data[0].Val"},
+	}
+	for i, tt := range tests {
+		_, err := ParseJSON(strings.NewReader(tt.doc))
+		var sErr *Error
+		if !errors.As(err, &sErr) {
+			t.Errorf("expected spec error, set %d, have %v", i, err)
+			continue
+		}
+		if sErr.Path != tt.path {
+			t.Errorf("wrong path, set %d, exp %s, have %s (%s)", i, tt.path, sErr.Path, err)
+		}
+	}
+}
+
+func TestParseYAML(t *testing.T) {
+	doc := `
+type: cartesian-temporal
+title: Temperature
+x:
+  tMin: 2025-01-01T00:00:00Z
+  tMax: 2025-01-02T00:00:00Z
+series:
+  - name: outside
+    type: area
+    data:
+      - {T: 2025-01-01T06:00:00Z, Val: -2}
+      - {T: "2025-01-01T12:00:00Z", Val: 4.5}
+`
+	s, err := ParseYAML(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if s.X == nil || s.X.TMin != "2025-01-01T00:00:00Z" {
+		t.Errorf("wrong x axis: %v", s.X)
+	}
+	if len(s.Series) != 1 || len(s.Series[0].Data) != 2 || s.Series[0].Data[0]["Val"] != -2.0 {
+		t.Errorf("wrong series: %v", s.Series)
+	}
+
+	_, err = ParseYAML(strings.NewReader("type: pie\nseries:\n  - name: a\n    dotSize: [1]\n"))
+	var sErr *Error
+	if !errors.As(err, &sErr) || sErr.Path != "series[0].dotSize" {
+		t.Errorf("expected error at series[0].dotSize, have %v", err)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	test.NewTempApp(t)
+	dir := t.TempDir()
+	src := filepath.Join(dir, "shares.csv")
+	err := os.WriteFile(src, []byte("category;share\nA;3\nB;1\n"), 0o600)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var tests = []struct {
+		doc string
+		exp any
+	}{
+		{numericalDoc, &coord.CartesianNumericalChart{}},
+		{`{"type": "cartesian-categorical", "transposed": true, "x": {"categories": ["a", "b"]},
+			"series": [{"name": "s", "type": "box", "data": [{"C": "a", "Minimum": 1, "FirstQuartile": 2,
+			"Median": 3, "ThirdQuartile": 4, "Maximum": 5, "Outlier": [9]}]}]}`, &coord.CartesianCategoricalChart{}},
+		{`{"type": "polar-temporal", "y": {"max": 10}, "series": [{"name": "s", "type": "bar", "barWidth": 3600,
+			"data": [{"T": "2025-01-01T00:00:00Z", "Val": 4}]}]}`, &coord.PolarTemporalChart{}},
+		{`{"type": "pie", "legend": {"visible": false}, "series": [{"name": "s", "source": {"path": "` +
+			filepath.ToSlash(src) + `", "delimiter": ";", "columns": {"C": "category", "Val": "share"}}}]}`,
+			&prop.PieChart{}},
+	}
+	for i, tt := range tests {
+		s, err := ParseJSON(strings.NewReader(tt.doc))
+		if err != nil {
+			t.Errorf("unexpected error, set %d: %s", i, err)
+			continue
+		}
+		obj, err := Build(s)
+		if err != nil {
+			t.Errorf("unexpected error, set %d: %s", i, err)
+			continue
+		}
+		if reflect.TypeOf(obj) != reflect.TypeOf(tt.exp) {
+			t.Errorf("wrong chart, set %d, exp %T, have %T", i, tt.exp, obj)
+		}
+	}
+
+	// sources are read while the chart is built
+	s := Chart{Type: Pie, Series: []Series{{Name: "s", Source: &Source{Path: filepath.Join(dir, "missing.json")}}}}
+	_, err = Build(s)
+	var sErr *Error
+	if !errors.As(err, &sErr) || sErr.Path != "series[0].source" {
+		t.Errorf("expected error at series[0].source, have %v", err)
+	}
+}
+
+func TestFromChart(t *testing.T) {
+	test.NewTempApp(t)
+	s, err := ParseJSON(strings.NewReader(numericalDoc))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	obj, err := Build(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	back, err := FromChart(obj.(*coord.CartesianNumericalChart))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = Validate(back)
+	if err != nil {
+		t.Errorf("invalid specification of chart: %s", err)
+	}
+	if back.Title != "Sales" || back.X.Label != "week" || *back.X.Min != 0 || *back.X.Max != 10 ||
+		len(back.X.Ticks) != 2 || !back.X.Ticks[1].SupportLine {
+		t.Errorf("wrong x axis: %+v", back.X)
+	}
+	if back.Y == nil || back.Y.SupportLines == nil || *back.Y.SupportLines {
+		t.Errorf("wrong y axis: %+v", back.Y)
+	}
+	if back.Legend == nil || back.Legend.Location != "bottom" || back.Legend.Interactive == nil ||
+		*back.Legend.Interactive || back.Legend.Visible != nil {
+		t.Errorf("wrong legend: %+v", back.Legend)
+	}
+	if len(back.Series) != 2 {
+		t.Fatalf("wrong number of series, exp 2, have %d", len(back.Series))
+	}
+	line, bar := back.Series[0], back.Series[1]
+	if line.Type != LineSeries || line.Color != "primary" || !line.ShowDots || *line.LineWidth != 2 ||
+		!reflect.DeepEqual(line.Data, s.Series[0].Data) {
+		t.Errorf("wrong line series: %+v", line)
+	}
+	if bar.Type != BarSeries || bar.Color != "error" || *bar.BarWidth != 0.5 || len(bar.Data) != 1 {
+		t.Errorf("wrong bar series: %+v", bar)
+	}
+
+	// the specification can be written and read again
+	var b bytes.Buffer
+	err = WriteYAML(&b, back)
+	if err == nil {
+		_, err = ParseYAML(&b)
+	}
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	b.Reset()
+	err = WriteJSON(&b, back)
+	if err == nil {
+		_, err = ParseJSON(&b)
+	}
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	pie := prop.NewPieChart("shares")
+	ps, err := prop.NewSeries("s", nil)
+	if err == nil {
+		err = pie.AddSeries(ps)
+	}
+	if err == nil {
+		back, err = FromChart(pie)
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if back.Type != Pie || back.Title != "shares" || len(back.Series) != 1 || back.Series[0].Type != "" {
+		t.Errorf("wrong pie chart: %+v", back)
+	}
+
+	radar := coord.NewRadarChart("radar")
+	_, err = FromChart(radar)
+	if err == nil {
+		t.Errorf("expected error for radar chart")
+	}
+
+	cat := coord.NewCartesianCategoricalChart("stacked")
+	a, err := coord.NewCategoricalPointSeries("a", theme.ColorNamePrimary, nil)
+	var stack *coord.CategoricalStackedSeries
+	if err == nil {
+		stack, err = coord.NewCategoricalStackedSeries("stack", []*coord.CategoricalPointSeries{a})
+	}
+	if err == nil {
+		err = cat.AddStackedBarSeries(stack)
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	_, err = FromChart(cat)
+	if err == nil {
+		t.Errorf("expected error for stacked series")
+	}
+
+	// the specification given by the chart is validated
+	var specErr *Error
+	_, err = FromChart(describer{Chart{Type: Pie, Series: []Series{{Name: "s", Type: LineSeries}}}})
+	if !errors.As(err, &specErr) {
+		t.Errorf("expected specification error, have %v", err)
+	}
+}
+
+// describer gives a fixed specification
+type describer struct {
+	s Chart
+}
+
+func (d describer) Spec() (s Chart, err error) {
+	s = d.s
+	return
+}
+
+func TestChartNotMarshaledAsSpec(t *testing.T) {
+	test.NewTempApp(t)
+	// structs that embed a chart are marshaled with their own fields only
+	type view struct {
+		*coord.CartesianNumericalChart
+		Name string
+	}
+	b, err := json.Marshal(view{CartesianNumericalChart: coord.NewCartesianNumericalChart("t"), Name: "v"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if strings.Contains(string(b), "cartesian-numerical") {
+		t.Errorf("chart marshaled as specification: %s", b)
+	}
+}
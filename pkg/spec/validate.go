@@ -0,0 +1,342 @@
+package spec
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/s-daehling/fyne-charts/internal/spec"
+	"github.com/s-daehling/fyne-charts/pkg/style"
+)
+
+type fromKind string
+
+const (
+	numericalFrom   fromKind = "numerical"
+	temporalFrom    fromKind = "temporal"
+	categoricalFrom fromKind = "categorical"
+	proportional    fromKind = "proportional"
+)
+
+// chartKind defines the axes and series of a chart type
+type chartKind struct {
+	from       fromKind
+	polar      bool
+	transposed bool
+	series     []SeriesType
+}
+
+var chartKinds = map[ChartType]chartKind{
+	CartesianNumerical: {from: numericalFrom, series: []SeriesType{LineSeries, AreaSeries, ScatterSeries,
+		LollipopSeries, BarSeries, CandleStickSeries, BoxSeries}},
+	CartesianTemporal: {from: temporalFrom, series: []SeriesType{LineSeries, AreaSeries, ScatterSeries,
+		LollipopSeries, BarSeries, CandleStickSeries, BoxSeries}},
+	CartesianCategorical: {from: categoricalFrom, transposed: true, series: []SeriesType{ScatterSeries,
+		LollipopSeries, BarSeries, BoxSeries}},
+	PolarNumerical: {from: numericalFrom, polar: true, series: []SeriesType{LineSeries, AreaSeries,
+		ScatterSeries, LollipopSeries, BarSeries}},
+	PolarTemporal: {from: temporalFrom, polar: true, series: []SeriesType{LineSeries, AreaSeries,
+		ScatterSeries, LollipopSeries, BarSeries}},
+	PolarCategorical: {from: categoricalFrom, polar: true, series: []SeriesType{ScatterSeries,
+		LollipopSeries, BarSeries}},
+	Pie:    {from: proportional, polar: true},
+	Bar:    {from: proportional, transposed: true},
+	Funnel: {from: proportional},
+	Waffle: {from: proportional},
+}
+
+// Validate checks that s describes a chart that can be built
+// Data of sources is not read; an error of type *Error is returned for the first invalid element
+func Validate(s Chart) (err error) {
+	kind, ok := chartKinds[s.Type]
+	if s.Type == "" {
+		err = specError("type", "missing")
+		return
+	} else if !ok {
+		err = specError("type", fmt.Sprintf("unknown chart type %q", s.Type))
+		return
+	}
+	if s.Transposed && !kind.transposed {
+		err = notSupported("transposed", s.Type)
+		return
+	}
+	if kind.from == proportional {
+		if s.X != nil {
+			err = notSupported("x", s.Type)
+		} else if s.Y != nil {
+			err = notSupported("y", s.Type)
+		}
+	} else {
+		err = validateFromAxis(s.X, kind, s.Type)
+		if err == nil {
+			err = validateValueAxis(s.Y, kind, s.Type)
+		}
+	}
+	if err != nil {
+		return
+	}
+	if s.Legend != nil {
+		switch style.LegendLocation(s.Legend.Location) {
+		case "", style.LegendLocationTop, style.LegendLocationBottom, style.LegendLocationLeft,
+			style.LegendLocationRight:
+		default:
+			err = specError("legend.location", fmt.Sprintf("unknown location %q", s.Legend.Location))
+			return
+		}
+	}
+	for i := range s.Series {
+		path := fmt.Sprintf("series[%d]", i)
+		for j := 0; j < i; j++ {
+			if s.Series[j].Name == s.Series[i].Name {
+				err = specError(path+".name", fmt.Sprintf("duplicate name %q", s.Series[i].Name))
+				return
+			}
+		}
+		err = validateSeries(s.Series[i], path, kind, s.Type)
+		if err != nil {
+			return
+		}
+		_, err = seriesData(s.Series[i], path, kind, false)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func validateFromAxis(ax *Axis, kind chartKind, typ ChartType) (err error) {
+	if ax == nil {
+		return
+	}
+	switch {
+	case kind.from != temporalFrom && ax.TMin != "":
+		err = notSupported("x.tMin", typ)
+	case kind.from != temporalFrom && ax.TMax != "":
+		err = notSupported("x.tMax", typ)
+	case kind.from != temporalFrom && ax.TickFormat != "":
+		err = notSupported("x.tickFormat", typ)
+	case kind.from != categoricalFrom && ax.Categories != nil:
+		err = notSupported("x.categories", typ)
+	case (kind.from != numericalFrom || kind.polar) && ax.Min != nil:
+		err = notSupported("x.min", typ)
+	case (kind.from != numericalFrom || kind.polar) && ax.Max != nil:
+		err = notSupported("x.max", typ)
+	case kind.from == categoricalFrom && ax.Ticks != nil:
+		err = notSupported("x.ticks", typ)
+	case kind.from == categoricalFrom && ax.SupportLines != nil:
+		err = notSupported("x.supportLines", typ)
+	}
+	if err != nil {
+		return
+	}
+	switch kind.from {
+	case numericalFrom:
+		err = validateRange("x", ax.Min, ax.Max)
+		if err == nil {
+			err = validateTicks("x", ax, false)
+		}
+	case temporalFrom:
+		err = validateTRange("x", ax.TMin, ax.TMax)
+		if err == nil {
+			err = validateTicks("x", ax, true)
+		}
+		if err == nil && len(ax.Ticks) > 0 && ax.TickFormat == "" {
+			err = specError("x.tickFormat", "missing, required for manual ticks")
+		}
+	case categoricalFrom:
+		if ax.Categories != nil && len(ax.Categories) == 0 {
+			err = specError("x.categories", "empty")
+		}
+	}
+	return
+}
+
+func validateValueAxis(ax *Axis, kind chartKind, typ ChartType) (err error) {
+	if ax == nil {
+		return
+	}
+	switch {
+	case ax.TMin != "":
+		err = notSupported("y.tMin", typ)
+	case ax.TMax != "":
+		err = notSupported("y.tMax", typ)
+	case ax.TickFormat != "":
+		err = notSupported("y.tickFormat", typ)
+	case ax.Categories != nil:
+		err = notSupported("y.categories", typ)
+	case kind.polar && ax.Min != nil:
+		err = notSupported("y.min", typ)
+	}
+	if err != nil {
+		return
+	}
+	if !kind.polar {
+		err = validateRange("y", ax.Min, ax.Max)
+	} else if ax.Max != nil && *ax.Max <= 0 {
+		err = specError("y.max", "must be positive")
+	}
+	if err == nil {
+		err = validateTicks("y", ax, false)
+	}
+	return
+}
+
+func validateRange(path string, min *float64, max *float64) (err error) {
+	switch {
+	case min != nil && max == nil:
+		err = specError(path+".max", "missing, min and max are given together")
+	case min == nil && max != nil:
+		err = specError(path+".min", "missing, min and max are given together")
+	case min != nil && *min > *max:
+		err = specError(path+".max", "less than min")
+	}
+	return
+}
+
+func validateTRange(path string, tMin string, tMax string) (err error) {
+	switch {
+	case tMin != "" && tMax == "":
+		err = specError(path+".tMax", "missing, tMin and tMax are given together")
+		return
+	case tMin == "" && tMax != "":
+		err = specError(path+".tMin", "missing, tMin and tMax are given together")
+		return
+	case tMin == "":
+		return
+	}
+	min, err := parseTime(path+".tMin", tMin)
+	if err != nil {
+		return
+	}
+	max, err := parseTime(path+".tMax", tMax)
+	if err == nil && min.After(max) {
+		err = specError(path+".tMax", "before tMin")
+	}
+	return
+}
+
+func validateTicks(path string, ax *Axis, temporal bool) (err error) {
+	if len(ax.Ticks) > 0 && ax.SupportLines != nil {
+		err = specError(path+".supportLines", "only used by automatic ticks")
+		return
+	}
+	for i, t := range ax.Ticks {
+		tPath := fmt.Sprintf("%s.ticks[%d]", path, i)
+		switch {
+		case temporal && t.N != nil:
+			err = specError(tPath+".n", "not supported by temporal axes")
+		case temporal && t.T == "":
+			err = specError(tPath+".t", "missing")
+		case temporal:
+			_, err = parseTime(tPath+".t", t.T)
+		case t.T != "":
+			err = specError(tPath+".t", "not supported by numerical axes")
+		case t.N == nil:
+			err = specError(tPath+".n", "missing")
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func validateSeries(ser Series, path string, kind chartKind, typ ChartType) (err error) {
+	if ser.Name == "" {
+		err = specError(path+".name", "missing")
+		return
+	}
+	if kind.from == proportional {
+		switch {
+		case ser.Type != "":
+			err = notSupported(path+".type", typ)
+		case ser.Color != "":
+			err = notSupported(path+".color", typ)
+		case ser.ShowDots:
+			err = notSupported(path+".showDots", typ)
+		case ser.LineWidth != nil:
+			err = notSupported(path+".lineWidth", typ)
+		case ser.DotSize != nil:
+			err = notSupported(path+".dotSize", typ)
+		case ser.BarWidth != nil:
+			err = notSupported(path+".barWidth", typ)
+		}
+	} else {
+		switch {
+		case ser.Type == "":
+			err = specError(path+".type", "missing")
+		case !slices.Contains(kind.series, ser.Type):
+			err = specError(path+".type", fmt.Sprintf("series type %q not supported by %s charts", ser.Type, typ))
+		case ser.Type == CandleStickSeries && ser.Color != "":
+			err = notSupportedBySeries(path+".color", ser.Type)
+		case ser.ShowDots && ser.Type != LineSeries && ser.Type != AreaSeries:
+			err = notSupportedBySeries(path+".showDots", ser.Type)
+		case ser.DotSize != nil && (ser.Type == CandleStickSeries || ser.Type == BoxSeries):
+			err = notSupportedBySeries(path+".dotSize", ser.Type)
+		case ser.BarWidth != nil && (ser.Type != BarSeries || kind.from == categoricalFrom):
+			err = notSupportedBySeries(path+".barWidth", ser.Type)
+		case ser.BarWidth == nil && ser.Type == BarSeries && kind.from != categoricalFrom:
+			err = specError(path+".barWidth", "missing")
+		case ser.LineWidth != nil && *ser.LineWidth < 0:
+			err = specError(path+".lineWidth", "negative")
+		case ser.DotSize != nil && *ser.DotSize < 0:
+			err = specError(path+".dotSize", "negative")
+		case ser.BarWidth != nil && *ser.BarWidth < 0:
+			err = specError(path+".barWidth", "negative")
+		}
+	}
+	if err != nil {
+		return
+	}
+	if ser.Source != nil {
+		err = validateSource(*ser.Source, path+".source")
+		if err == nil && ser.Data != nil {
+			err = specError(path+".data", "data and source are mutually exclusive")
+		}
+	}
+	return
+}
+
+func validateSource(src Source, path string) (err error) {
+	switch {
+	case src.Path == "":
+		err = specError(path+".path", "missing")
+	case len([]rune(src.Delimiter)) > 1:
+		err = specError(path+".delimiter", "more than one character")
+	}
+	if err != nil {
+		return
+	}
+	switch format := sourceFormat(src); {
+	case format == "csv" || format == "json":
+	case src.Format == "":
+		err = specError(path+".format", "missing, unknown file extension")
+	default:
+		err = specError(path+".format", fmt.Sprintf("unknown format %q", src.Format))
+	}
+	return
+}
+
+func parseTime(path string, s string) (t time.Time, err error) {
+	t, err = time.Parse(time.RFC3339, s)
+	if err != nil {
+		err = specError(path, fmt.Sprintf("invalid time %q, expected RFC 3339 format", s))
+	}
+	return
+}
+
+func specError(path string, msg string) (err error) {
+	err = &spec.Error{Path: path, Err: errors.New(msg)}
+	return
+}
+
+func notSupported(path string, typ ChartType) (err error) {
+	err = specError(path, fmt.Sprintf("not supported by %s charts", typ))
+	return
+}
+
+func notSupportedBySeries(path string, typ SeriesType) (err error) {
+	err = specError(path, fmt.Sprintf("not supported by %s series", typ))
+	return
+}
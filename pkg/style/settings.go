@@ -14,6 +14,25 @@ const (
 	LegendLocationRight  LegendLocation = "right"
 )
 
+// LabelContent defines the content of the value labels of proportional charts
+type LabelContent string
+
+const (
+	LabelContentPercentage         LabelContent = "percentage"
+	LabelContentValue              LabelContent = "value"
+	LabelContentCategory           LabelContent = "category"
+	LabelContentCategoryPercentage LabelContent = "category and percentage"
+)
+
+// LabelOptions defines content and placement of the value labels of proportional charts
+// If Format is not nil, it is used instead of Content; share is the fraction of the total in [0,1].
+// Outside only applies to pie charts: labels are placed around the pie and connected to their slice by a leader line
+type LabelOptions struct {
+	Outside bool
+	Content LabelContent
+	Format  func(c string, val float64, share float64) (text string)
+}
+
 type ChartTextStyle struct {
 	Alignment fyne.TextAlign
 	ColorName fyne.ThemeColorName
@@ -53,6 +72,13 @@ func DefaultValueTextStyle() (valueTextStyle ChartTextStyle) {
 	return
 }
 
+func DefaultLabelOptions() (labelOptions LabelOptions) {
+	labelOptions.Outside = false
+	labelOptions.Content = LabelContentPercentage
+	labelOptions.Format = nil
+	return
+}
+
 type AxisStyle struct {
 	LineColorName        fyne.ThemeColorName
 	LineWidth            float32